@@ -26,6 +26,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/flags"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -34,7 +35,6 @@ import (
 var (
 	quietFlag       bool
 	buildFormatFlag = flags.NewTemplateFlag("{{json .}}", flags.BuildOutput{})
-	buildOutputFlag string
 )
 
 // NewCmdBuild describes the CLI command to build artifacts.
@@ -52,7 +52,7 @@ func NewCmdBuild() *cobra.Command {
 		WithFlags([]*Flag{
 			{Value: &quietFlag, Name: "quiet", Shorthand: "q", DefValue: false, Usage: "Suppress the build output and print image built on success. See --output to format output.", IsEnum: true},
 			{Value: buildFormatFlag, Name: "output", Shorthand: "o", Usage: "Used in conjunction with --quiet flag. " + buildFormatFlag.Usage()},
-			{Value: &buildOutputFlag, Name: "file-output", DefValue: "", Usage: "Filename to write build images to"},
+			{Value: &opts.BuildOutputFile, Name: "file-output", DefValue: "", Usage: "Filename to write build images to"},
 			{Value: &opts.DryRun, Name: "dry-run", DefValue: false, Usage: "Don't build images, just compute the tag for each artifact.", IsEnum: true},
 		}).
 		WithHouseKeepingMessages().
@@ -68,7 +68,7 @@ func doBuild(ctx context.Context, out io.Writer) error {
 	return withRunner(ctx, out, func(r runner.Runner, configs []*latest.SkaffoldConfig) error {
 		bRes, err := r.Build(ctx, buildOut, targetArtifacts(opts, configs))
 
-		if quietFlag || buildOutputFlag != "" {
+		if quietFlag || opts.BuildOutputFile != "" {
 			cmdOut := flags.BuildOutput{Builds: bRes}
 			var buildOutput bytes.Buffer
 			if err := buildFormatFlag.Template().Execute(&buildOutput, cmdOut); err != nil {
@@ -81,8 +81,8 @@ func doBuild(ctx context.Context, out io.Writer) error {
 				}
 			}
 
-			if buildOutputFlag != "" {
-				if err := ioutil.WriteFile(buildOutputFlag, buildOutput.Bytes(), 0644); err != nil {
+			if opts.BuildOutputFile != "" {
+				if err := ioutil.WriteFile(opts.BuildOutputFile, buildOutput.Bytes(), 0644); err != nil {
 					return fmt.Errorf("writing build output to file: %w", err)
 				}
 			}
@@ -93,12 +93,17 @@ func doBuild(ctx context.Context, out io.Writer) error {
 }
 
 func targetArtifacts(opts config.SkaffoldOptions, configs []*latest.SkaffoldConfig) []*latest.Artifact {
-	var targetArtifacts []*latest.Artifact
+	var artifacts []*latest.Artifact
 	for _, cfg := range configs {
-		for _, artifact := range cfg.Build.Artifacts {
-			if opts.IsTargetImage(artifact) {
-				targetArtifacts = append(targetArtifacts, artifact)
-			}
+		artifacts = append(artifacts, cfg.Build.Artifacts...)
+	}
+	// `--build-image <image>` selects all the variants of an artifact.
+	opts.TargetImages = misc.VariantTargets(opts.TargetImages, artifacts)
+
+	var targetArtifacts []*latest.Artifact
+	for _, artifact := range misc.ExpandVariants(artifacts) {
+		if opts.IsTargetImage(artifact) {
+			targetArtifacts = append(targetArtifacts, artifact)
 		}
 	}
 	return targetArtifacts
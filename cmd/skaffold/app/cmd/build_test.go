@@ -152,7 +152,7 @@ func TestFileOutputFlag(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&quietFlag, test.quietFlag)
-			t.Override(&buildOutputFlag, test.filename)
+			t.Override(&opts.BuildOutputFile, test.filename)
 			t.Override(&createRunner, mockCreateRunner)
 			if test.template != "" {
 				t.Override(&buildFormatFlag, flags.NewTemplateFlag(test.template, flags.BuildOutput{}))
@@ -210,3 +210,54 @@ func TestRunBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestTargetArtifacts(t *testing.T) {
+	configs := []*latest.SkaffoldConfig{{
+		Pipeline: latest.Pipeline{
+			Build: latest.BuildConfig{Artifacts: []*latest.Artifact{
+				{
+					ImageName:    "gcr.io/project/app",
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+					Variants:     []*latest.ArtifactVariant{{Name: "debug"}, {Name: "release", ImageName: "gcr.io/project/release"}},
+				},
+				{ImageName: "gcr.io/project/other"},
+			}},
+		},
+	}}
+
+	tests := []struct {
+		description string
+		targets     []string
+		expected    []string
+	}{
+		{
+			description: "all artifacts",
+			expected:    []string{"gcr.io/project/app-debug", "gcr.io/project/release", "gcr.io/project/other"},
+		},
+		{
+			description: "artifact with variants",
+			targets:     []string{"gcr.io/project/app"},
+			expected:    []string{"gcr.io/project/app-debug", "gcr.io/project/release"},
+		},
+		{
+			description: "single variant",
+			targets:     []string{"release"},
+			expected:    []string{"gcr.io/project/release"},
+		},
+		{
+			description: "artifact without variants",
+			targets:     []string{"other"},
+			expected:    []string{"gcr.io/project/other"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var imageNames []string
+			for _, a := range targetArtifacts(config.SkaffoldOptions{TargetImages: test.targets}, configs) {
+				imageNames = append(imageNames, a.ImageName)
+			}
+
+			t.CheckDeepEqual(test.expected, imageNames)
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/flags"
 	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app/tips"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
@@ -57,7 +58,7 @@ func doDeploy(ctx context.Context, out io.Writer) error {
 		}
 		var artifacts []*latest.Artifact
 		for _, cfg := range configs {
-			artifacts = append(artifacts, cfg.Build.Artifacts...)
+			artifacts = append(artifacts, misc.ExpandVariants(cfg.Build.Artifacts)...)
 		}
 		buildArtifacts, err := getBuildArtifactsAndSetTags(artifacts, r.ApplyDefaultRepo)
 		if err != nil {
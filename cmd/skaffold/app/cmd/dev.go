@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
@@ -63,7 +64,7 @@ func runDev(ctx context.Context, out io.Writer) error {
 			err := withRunner(ctx, out, func(r runner.Runner, configs []*latest.SkaffoldConfig) error {
 				var artifacts []*latest.Artifact
 				for _, cfg := range configs {
-					artifacts = append(artifacts, cfg.Build.Artifacts...)
+					artifacts = append(artifacts, misc.ExpandVariants(cfg.Build.Artifacts)...)
 				}
 				err := r.Dev(ctx, out, artifacts)
 
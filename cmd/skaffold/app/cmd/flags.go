@@ -83,7 +83,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.Profiles,
 		DefValue:      []string{},
 		FlagAddMethod: "StringSliceVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "prune"},
 	},
 	{
 		Name:          "namespace",
@@ -120,6 +120,14 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug"},
 	},
+	{
+		Name:          "cache-url",
+		Usage:         "Specify the URL of a shared HTTP artifact cache, used in addition to the local cache file",
+		Value:         &opts.CacheURL,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
 	{
 		Name:          "remote-cache-dir",
 		Usage:         "Specify the location of the git repositories cache (default $HOME/.skaffold/repos)",
@@ -222,6 +230,22 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run", "debug", "build"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "test-report-dir",
+		Usage:         "Directory where JUnit XML and JSON reports of the test results are written",
+		Value:         &opts.TestReportDir,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "build", "test"},
+	},
+	{
+		Name:          "test-filter",
+		Usage:         "Only run the tests whose name matches this regular expression",
+		Value:         &opts.TestFilter,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "build", "test"},
+	},
 	{
 		Name:          "cleanup",
 		Usage:         "Delete deployments after dev or debug mode is interrupted",
@@ -336,7 +360,7 @@ var flagRegistry = []Flag{
 		Value:         &opts.ProfileAutoActivation,
 		DefValue:      true,
 		FlagAddMethod: "BoolVar",
-		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose"},
+		DefinedOn:     []string{"dev", "run", "debug", "deploy", "render", "build", "delete", "diagnose", "prune"},
 		IsEnum:        true,
 	},
 	{
@@ -41,6 +41,7 @@ var (
 	enableJibInit            bool
 	enableJibGradleInit      bool
 	enableBuildpacksInit     bool
+	enableKoInit             bool
 	enableNewInitFormat      bool
 	enableManifestGeneration bool
 )
@@ -66,6 +67,7 @@ func NewCmdInit() *cobra.Command {
 			{Value: &enableJibInit, Name: "XXenableJibInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
 			{Value: &enableJibGradleInit, Name: "XXenableJibGradleInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
 			{Value: &enableBuildpacksInit, Name: "XXenableBuildpacksInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
+			{Value: &enableKoInit, Name: "XXenableKoInit", DefValue: false, Usage: "", Hidden: true, IsEnum: true},
 			{Value: &buildpacksBuilder, Name: "XXdefaultBuildpacksBuilder", DefValue: "gcr.io/buildpacks/builder:v1", Usage: "", Hidden: true},
 			{Value: &enableManifestGeneration, Name: "generate-manifests", DefValue: false, Usage: "Allows skaffold to try and generate basic kubernetes resources to get your project started", IsEnum: true},
 		}).
@@ -86,7 +88,8 @@ func doInit(ctx context.Context, out io.Writer) error {
 		EnableJibInit:            enableJibInit,
 		EnableJibGradleInit:      enableJibGradleInit,
 		EnableBuildpacksInit:     enableBuildpacksInit,
-		EnableNewInitFormat:      enableNewInitFormat || enableBuildpacksInit || enableJibInit,
+		EnableKoInit:             enableKoInit,
+		EnableNewInitFormat:      enableNewInitFormat || enableBuildpacksInit || enableJibInit || enableKoInit,
 		EnableManifestGeneration: enableManifestGeneration,
 		Opts:                     opts,
 		MaxFileSize:              maxFileSize,
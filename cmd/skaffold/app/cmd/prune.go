@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/local"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+var (
+	pruneDryRun       bool
+	pruneKeepLast     int
+	pruneMaxAge       string
+	pruneMaxDiskUsage string
+)
+
+// NewCmdPrune describes the CLI command to remove the images built by Skaffold.
+func NewCmdPrune() *cobra.Command {
+	return NewCmd("prune").
+		WithDescription("Remove the images built by Skaffold on the local Docker daemon").
+		WithLongDescription("Remove the images built by Skaffold on the local Docker daemon, or in the image layout of daemonless builds, that break the retention policy of the local builder. Flags override the policy of the configuration.").
+		WithExample("List the images that would be removed", "prune --dry-run").
+		WithExample("Remove the images older than a week", "prune --max-age 168h").
+		WithExample("Keep only the last 3 images of each artifact", "prune --keep-last 3").
+		WithCommonFlags().
+		WithFlags([]*Flag{
+			{Value: &pruneDryRun, Name: "dry-run", DefValue: false, Usage: "Only list the images that would be removed.", IsEnum: true},
+			{Value: &pruneKeepLast, Name: "keep-last", DefValue: -1, FlagAddMethod: "IntVar", Usage: "Number of most recent images kept for each artifact (default 1)"},
+			{Value: &pruneMaxAge, Name: "max-age", DefValue: "", Usage: "Remove the images older than the given duration, e.g. 72h"},
+			{Value: &pruneMaxDiskUsage, Name: "max-disk-usage", DefValue: "", Usage: "Remove the oldest images until the images built by Skaffold use less than the given size, e.g. 10GB"},
+		}).
+		NoArgs(doPrune)
+}
+
+func doPrune(ctx context.Context, out io.Writer) error {
+	runCtx, _, err := runContext(out, opts)
+	if err != nil {
+		return err
+	}
+
+	return pruneImages(ctx, out, runCtx, runCtx.GetPipelines())
+}
+
+// pruneImages removes the images from the image store of the local builder: the Docker daemon, or the image layout when daemonless.
+func pruneImages(ctx context.Context, out io.Writer, cfg docker.Config, pipelines []latest.Pipeline) error {
+	retention := imageRetention(pipelines)
+	if pruneKeepLast >= 0 {
+		retention.KeepLast = &pruneKeepLast
+	}
+	if pruneMaxAge != "" {
+		retention.MaxAge = pruneMaxAge
+	}
+	if pruneMaxDiskUsage != "" {
+		retention.MaxDiskUsage = pruneMaxDiskUsage
+	}
+
+	localDocker, err := local.NewImageStore(cfg, localBuild(pipelines))
+	if err != nil {
+		return err
+	}
+	defer localDocker.Close()
+
+	return local.PruneImages(ctx, out, localDocker, &retention, true, pruneDryRun)
+}
+
+// localBuild returns the configuration of the first pipeline that uses the local builder.
+// All of them agree on whether to use a Docker daemon.
+func localBuild(pipelines []latest.Pipeline) *latest.LocalBuild {
+	for _, p := range pipelines {
+		if p.Build.LocalBuild != nil {
+			return p.Build.LocalBuild
+		}
+	}
+	return &latest.LocalBuild{}
+}
+
+// imageRetention returns the retention policy of the first pipeline that configures one for the local builder.
+func imageRetention(pipelines []latest.Pipeline) latest.ImageRetention {
+	for _, p := range pipelines {
+		if p.Build.LocalBuild != nil && p.Build.LocalBuild.Retention != nil {
+			return *p.Build.LocalBuild.Retention
+		}
+	}
+	return latest.ImageRetention{}
+}
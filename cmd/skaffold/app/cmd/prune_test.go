@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPruneImages(t *testing.T) {
+	daemonImage := types.ImageSummary{ID: "sha256:1", RepoTags: []string{"daemon:v1"}, Labels: map[string]string{docker.ArtifactLabel: "daemon"}}
+	layoutImage := types.ImageSummary{ID: "sha256:2", RepoTags: []string{"layout:v1"}, Labels: map[string]string{docker.ArtifactLabel: "layout"}}
+
+	tests := []struct {
+		description string
+		local       *latest.LocalBuild
+		expected    string
+	}{
+		{
+			description: "docker daemon",
+			local:       &latest.LocalBuild{},
+			expected:    " - daemon:v1 (daemon",
+		},
+		{
+			description: "daemonless",
+			local:       &latest.LocalBuild{Daemonless: true},
+			expected:    " - layout:v1 (layout",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&pruneDryRun, true)
+			t.Override(&pruneKeepLast, 0)
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				if test.local.Daemonless {
+					return nil, errors.New("the docker daemon shouldn't be used")
+				}
+				return docker.NewLocalDaemon(&testutil.FakeAPIClient{LabeledImages: []types.ImageSummary{daemonImage}}, nil, false, nil), nil
+			})
+			t.Override(&docker.NewLayoutDaemon, func(docker.Config) (docker.LocalDaemon, error) {
+				if !test.local.Daemonless {
+					return nil, errors.New("the image layout shouldn't be used")
+				}
+				return docker.NewLocalDaemon(&testutil.FakeAPIClient{LabeledImages: []types.ImageSummary{layoutImage}}, nil, false, nil), nil
+			})
+
+			pipelines := []latest.Pipeline{{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: test.local}}}}
+			var out bytes.Buffer
+			err := pruneImages(context.Background(), &out, &runcontext.RunContext{}, pipelines)
+
+			t.CheckNoError(err)
+			t.CheckContains(test.expected, out.String())
+		})
+	}
+}
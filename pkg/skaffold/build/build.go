@@ -27,7 +27,13 @@ import (
 // Artifact is the result corresponding to each successful build.
 type Artifact struct {
 	ImageName string `json:"imageName"`
-	Tag       string `json:"tag"`
+
+	// Tag is the built image reference. For multi-platform images,
+	// it points to the digest of the manifest list.
+	Tag string `json:"tag"`
+
+	// AdditionalTags are other tags given to the same image by its builder.
+	AdditionalTags []string `json:"additionalTags,omitempty"`
 }
 
 // ArtifactGraph is a map of [artifact image : artifact definition]
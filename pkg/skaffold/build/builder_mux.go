@@ -30,6 +30,7 @@ type BuilderMux struct {
 	builders    []PipelineBuilder
 	byImageName map[string]PipelineBuilder
 	store       ArtifactStore
+	timings     BuildTimings
 	concurrency int
 }
 
@@ -39,7 +40,7 @@ type Config interface {
 }
 
 // NewBuilderMux returns an implementation of `build.BuilderMux`.
-func NewBuilderMux(cfg Config, store ArtifactStore, builder func(p latest.Pipeline) (PipelineBuilder, error)) (*BuilderMux, error) {
+func NewBuilderMux(cfg Config, store ArtifactStore, timings BuildTimings, builder func(p latest.Pipeline) (PipelineBuilder, error)) (*BuilderMux, error) {
 	pipelines := cfg.GetPipelines()
 	m := make(map[string]PipelineBuilder)
 	var pb []PipelineBuilder
@@ -62,7 +63,7 @@ func NewBuilderMux(cfg Config, store ArtifactStore, builder func(p latest.Pipeli
 		}
 	}
 
-	return &BuilderMux{builders: pb, byImageName: m, store: store, concurrency: minConcurrency}, nil
+	return &BuilderMux{builders: pb, byImageName: m, store: store, timings: timings, concurrency: minConcurrency}, nil
 }
 
 // Build executes the specific image builder for each artifact in the given artifact slice.
@@ -83,7 +84,7 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 		artifactBuilder := p.Build(ctx, out, artifact)
 		return artifactBuilder(ctx, out, artifact, tag)
 	}
-	ar, err := InOrder(ctx, out, tags, artifacts, builder, b.concurrency, b.store)
+	ar, err := InOrder(ctx, out, tags, artifacts, builder, b.concurrency, b.store, b.timings)
 	if err != nil {
 		return nil, err
 	}
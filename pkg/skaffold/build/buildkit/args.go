@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Args returns `buildctl` command arguments to build a docker artifact, whose sources are in the `context` directory,
+// with the BuildKit daemon at `addr`. The client certificates are read from `certsDir`, if any.
+// Secrets and SSH keys are read from `secretsDir`.
+func Args(artifact *latest.DockerArtifact, tag, addr, certsDir, context, secretsDir string, platforms []string, insecure bool) ([]string, error) {
+	dockerfileDir, dockerfileName := path.Split(filepath.ToSlash(artifact.DockerfilePath))
+	args := []string{"--addr", addr}
+	if certsDir != "" {
+		args = append(args,
+			"--tlscacert", path.Join(certsDir, CACertFile),
+			"--tlscert", path.Join(certsDir, ClientCertFile),
+			"--tlskey", path.Join(certsDir, ClientKeyFile),
+		)
+	}
+	args = append(args,
+		"build",
+		"--frontend", "dockerfile.v0",
+		"--local", "context="+context,
+		"--local", "dockerfile="+path.Join(context, dockerfileDir),
+		"--opt", "filename="+dockerfileName,
+	)
+
+	if artifact.Target != "" {
+		args = append(args, "--opt", "target="+artifact.Target)
+	}
+
+	buildArgs, err := util.EvaluateEnvTemplateMap(artifact.BuildArgs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	var keys []string
+	for k, v := range buildArgs {
+		if v != nil {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "--opt", fmt.Sprintf("build-arg:%s=%s", k, *buildArgs[k]))
+	}
+
+	if len(platforms) > 0 {
+		args = append(args, "--opt", "platform="+strings.Join(platforms, ","))
+	}
+
+	for _, from := range artifact.CacheFrom {
+		args = append(args, "--import-cache", "type=registry,ref="+from)
+	}
+
+	if artifact.NoCache {
+		args = append(args, "--no-cache")
+	}
+
+	if artifact.Secret != nil {
+		args = append(args, "--secret", fmt.Sprintf("id=%s,src=%s", artifact.Secret.ID, SecretPath(secretsDir, artifact.Secret.ID)))
+	}
+
+	if artifact.SSH != "" {
+		keys, err := SSHKeys(artifact.SSH, secretsDir)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--ssh", sshFlag(artifact.SSH, keys))
+	}
+
+	output := fmt.Sprintf("type=image,name=%s,push=true", tag)
+	if insecure {
+		output += ",registry.insecure=true"
+	}
+	return append(args, "--output", output), nil
+}
+
+// SecretPath returns where the secret with the given id is uploaded to in the build pod.
+func SecretPath(secretsDir, id string) string {
+	return path.Join(secretsDir, "secret-"+id)
+}
+
+// SSHKey is a local SSH key uploaded to the build pod.
+type SSHKey struct {
+	Local  string
+	Remote string
+}
+
+// SSHKeys parses an `ssh` setting in the "<id>=<key>[,<key>]" format and returns where
+// each key is uploaded to in the build pod. Forwarding the local SSH agent isn't supported.
+func SSHKeys(ssh, secretsDir string) ([]SSHKey, error) {
+	parts := strings.SplitN(ssh, "=", 2)
+	if len(parts) < 2 || parts[1] == "" {
+		return nil, fmt.Errorf("ssh %q: forwarding the SSH agent isn't supported with BuildKit in the cluster, list the keys to use instead, for example 'default=~/.ssh/id_rsa'", ssh)
+	}
+
+	var keys []SSHKey
+	for i, local := range strings.Split(parts[1], ",") {
+		keys = append(keys, SSHKey{
+			Local:  local,
+			Remote: path.Join(secretsDir, fmt.Sprintf("ssh-%s-%d", parts[0], i)),
+		})
+	}
+	return keys, nil
+}
+
+func sshFlag(ssh string, keys []SSHKey) string {
+	var remote []string
+	for _, k := range keys {
+		remote = append(remote, k.Remote)
+	}
+	return strings.SplitN(ssh, "=", 2)[0] + "=" + strings.Join(remote, ",")
+}
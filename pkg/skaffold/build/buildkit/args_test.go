@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestArgs(t *testing.T) {
+	tests := []struct {
+		description  string
+		artifact     *latest.DockerArtifact
+		platforms    []string
+		insecure     bool
+		expectedArgs []string
+		shouldErr    bool
+	}{
+		{
+			description: "defaults",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+		},
+		{
+			description: "dockerfile in sub directory",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "docker/Dockerfile.prod"},
+		},
+		{
+			description: "target and build args",
+			artifact: &latest.DockerArtifact{
+				DockerfilePath: "Dockerfile",
+				Target:         "release",
+				BuildArgs: map[string]*string{
+					"nil_key":   nil,
+					"value_key": util.StringPtr("value"),
+					"empty_key": util.StringPtr(""),
+				},
+			},
+			expectedArgs: []string{
+				"--opt", "target=release",
+				"--opt", "build-arg:empty_key=",
+				"--opt", "build-arg:value_key=value",
+			},
+		},
+		{
+			description: "cache, secret and ssh",
+			artifact: &latest.DockerArtifact{
+				DockerfilePath: "Dockerfile",
+				CacheFrom:      []string{"gcr.io/cache:latest"},
+				NoCache:        true,
+				Secret:         &latest.DockerSecret{ID: "mysecret", Source: "secret.txt"},
+				SSH:            "default=~/.ssh/id_rsa,~/.ssh/id_ed25519",
+			},
+			expectedArgs: []string{
+				"--import-cache", "type=registry,ref=gcr.io/cache:latest",
+				"--no-cache",
+				"--secret", "id=mysecret,src=/secrets/secret-mysecret",
+				"--ssh", "default=/secrets/ssh-default-0,/secrets/ssh-default-1",
+			},
+		},
+		{
+			description: "platforms",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			expectedArgs: []string{
+				"--opt", "platform=linux/amd64,linux/arm64",
+			},
+		},
+		{
+			description: "ssh agent isn't supported",
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile", SSH: "default"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			args, err := Args(test.artifact, "gcr.io/image:tag", "tcp://buildkitd:1234", "", "/context", "/secrets", test.platforms, test.insecure)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+			t.CheckDeepEqual([]string{"--addr", "tcp://buildkitd:1234", "build", "--frontend", "dockerfile.v0", "--local", "context=/context"}, args[:7])
+			t.CheckDeepEqual(test.expectedArgs, args[11:len(args)-2], cmpopts.EquateEmpty())
+			t.CheckDeepEqual([]string{"--output", "type=image,name=gcr.io/image:tag,push=true"}, args[len(args)-2:])
+		})
+	}
+}
+
+func TestArgsDockerfile(t *testing.T) {
+	args, err := Args(&latest.DockerArtifact{DockerfilePath: "docker/Dockerfile.prod"}, "localhost:5000/image:tag", "tcp://buildkitd:1234", "/certs", "/context", "/secrets", nil, true)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, []string{
+		"--addr", "tcp://buildkitd:1234",
+		"--tlscacert", "/certs/ca.pem",
+		"--tlscert", "/certs/client.pem",
+		"--tlskey", "/certs/client-key.pem",
+		"build",
+		"--frontend", "dockerfile.v0",
+		"--local", "context=/context",
+		"--local", "dockerfile=/context/docker",
+		"--opt", "filename=Dockerfile.prod",
+		"--output", "type=image,name=localhost:5000/image:tag,push=true,registry.insecure=true",
+	}, args)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Files of the certificates in the certificates Secret.
+const (
+	CACertFile     = "ca.pem"
+	ServerCertFile = "server.pem"
+	ServerKeyFile  = "server-key.pem"
+	ClientCertFile = "client.pem"
+	ClientKeyFile  = "client-key.pem"
+)
+
+const certsValidity = 10 * 365 * 24 * time.Hour
+
+// GenerateCerts creates a certificate authority and the certificates it signs for the BuildKit daemon,
+// valid for the given host names, and for its clients.
+// The BuildKit daemon only accepts connections from clients with a certificate signed by this authority.
+// It returns the PEM encoded certificates and keys, keyed by their file name.
+func GenerateCerts(hosts []string) (map[string][]byte, error) {
+	now := time.Now()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating key: %w", err)
+	}
+	ca := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "skaffold-buildkit-ca"},
+		NotBefore:             now,
+		NotAfter:              now.Add(certsValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caCert, err := signCert(ca, ca, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := map[string][]byte{CACertFile: caCert}
+	for _, c := range []struct {
+		template          *x509.Certificate
+		certFile, keyFile string
+	}{
+		{
+			template: &x509.Certificate{
+				Subject:     pkix.Name{CommonName: DefaultDaemonName},
+				DNSNames:    hosts,
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			},
+			certFile: ServerCertFile,
+			keyFile:  ServerKeyFile,
+		},
+		{
+			template: &x509.Certificate{
+				Subject:     pkix.Name{CommonName: DefaultContainerName},
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+			certFile: ClientCertFile,
+			keyFile:  ClientKeyFile,
+		},
+	} {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generating key: %w", err)
+		}
+		c.template.NotBefore = now
+		c.template.NotAfter = now.Add(certsValidity)
+		c.template.KeyUsage = x509.KeyUsageDigitalSignature
+
+		cert, err := signCert(c.template, ca, &key.PublicKey, caKey)
+		if err != nil {
+			return nil, err
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("encoding key: %w", err)
+		}
+
+		certs[c.certFile] = cert
+		certs[c.keyFile] = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	}
+	return certs, nil
+}
+
+// signCert signs a certificate with a random serial number, and returns it PEM encoded.
+func signCert(template, parent *x509.Certificate, pub *ecdsa.PublicKey, key *ecdsa.PrivateKey) ([]byte, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("generating serial number: %w", err)
+	}
+	template.SerialNumber = serial
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, key)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGenerateCerts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		certs, err := GenerateCerts([]string{"buildkitd.ns", "buildkitd.ns.svc"})
+		t.CheckNoError(err)
+
+		roots := x509.NewCertPool()
+		t.CheckTrue(roots.AppendCertsFromPEM(certs[CACertFile]))
+
+		server, err := tls.X509KeyPair(certs[ServerCertFile], certs[ServerKeyFile])
+		t.CheckNoError(err)
+		serverCert, err := x509.ParseCertificate(server.Certificate[0])
+		t.CheckNoError(err)
+		_, err = serverCert.Verify(x509.VerifyOptions{DNSName: "buildkitd.ns.svc", Roots: roots})
+		t.CheckNoError(err)
+		_, err = serverCert.Verify(x509.VerifyOptions{DNSName: "buildkitd.other", Roots: roots})
+		t.CheckError(true, err)
+
+		client, err := tls.X509KeyPair(certs[ClientCertFile], certs[ClientKeyFile])
+		t.CheckNoError(err)
+		clientCert, err := x509.ParseCertificate(client.Certificate[0])
+		t.CheckNoError(err)
+		_, err = clientCert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		t.CheckNoError(err)
+
+		// Certificates of another authority aren't trusted.
+		other, err := GenerateCerts([]string{"buildkitd.ns"})
+		t.CheckNoError(err)
+		otherClient, err := tls.X509KeyPair(other[ClientCertFile], other[ClientKeyFile])
+		t.CheckNoError(err)
+		otherCert, err := x509.ParseCertificate(otherClient.Certificate[0])
+		t.CheckNoError(err)
+		_, err = otherCert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		t.CheckError(true, err)
+	})
+}
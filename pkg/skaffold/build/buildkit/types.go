@@ -0,0 +1,40 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package buildkit
+
+const (
+	// DefaultImage is the image running the BuildKit daemon and the `buildctl` client by default
+	DefaultImage = "moby/buildkit:v0.8.3"
+	// DefaultDaemonName is the name of the BuildKit daemon Deployment and Service
+	DefaultDaemonName = "buildkitd"
+	// DefaultDaemonPort is the port the BuildKit daemon listens on
+	DefaultDaemonPort = 1234
+	// DefaultContainerName for buildkit pod
+	DefaultContainerName = "buildkit"
+	// DefaultSecretsDirName for buildkit pod
+	DefaultSecretsDirName = "buildkit-secrets"
+	// DefaultSecretsDirMountPath for buildkit pod
+	DefaultSecretsDirMountPath = "/buildkit/secrets"
+	// DefaultCacheDirMountPath for the BuildKit daemon
+	DefaultCacheDirMountPath = "/var/lib/buildkit"
+	// DefaultCertsSecretName is the name of the Secret holding the TLS certificates of the BuildKit daemon and its clients
+	DefaultCertsSecretName = "buildkitd-certs"
+	// DefaultCertsDirName for the BuildKit daemon and the buildkit pod
+	DefaultCertsDirName = "buildkit-certs"
+	// DefaultCertsDirMountPath for the BuildKit daemon and the buildkit pod
+	DefaultCertsDirMountPath = "/buildkit/certs"
+)
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+)
+
+// applyAdditionalTags applies the tags generated by multiTag policies, besides the canonical one, to the built images.
+// Pushed images are tagged in their registry, other images are tagged in the local image store.
+func (c *cache) applyAdditionalTags(ctx context.Context, out io.Writer, artifacts []build.Artifact, additionalTags tag.AdditionalTags) error {
+	if len(additionalTags) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintln(out, "Applying additional tags...")
+	for _, artifact := range artifacts {
+		tags := additionalTags[artifact.ImageName]
+		if len(tags) == 0 {
+			continue
+		}
+
+		isLocal, err := c.isLocalImage(artifact.ImageName)
+		if err != nil {
+			return err
+		}
+
+		var imageID, digest string
+		if isLocal {
+			if imageID, err = c.localImageID(ctx, artifact.Tag); err != nil {
+				return err
+			}
+		} else {
+			digest = artifact.Tag[strings.LastIndex(artifact.Tag, "@")+1:]
+		}
+
+		for _, t := range tags {
+			color.Default.Fprintf(out, " - %s -> %s\n", artifact.Tag, t)
+
+			var details needsTagging = needsRemoteTagging{tag: t, digest: digest}
+			if isLocal {
+				details = needsLocalTagging{tag: t, imageID: imageID}
+			}
+			if err := details.Tag(ctx, c); err != nil {
+				return fmt.Errorf("tagging %q as %q: %w", artifact.Tag, t, err)
+			}
+		}
+	}
+	return nil
+}
+
+// localImageID returns the ID of a local image. The local image store is only opened when the cache is disabled
+// and an image gets additional tags.
+func (c *cache) localImageID(ctx context.Context, ref string) (string, error) {
+	if c.client == nil {
+		client, err := localImageStore(c.cfg)
+		if err != nil {
+			return "", fmt.Errorf("getting local Docker client: %w", err)
+		}
+		c.client = client
+	}
+
+	imageID, err := c.client.ImageID(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	if imageID == "" {
+		return "", fmt.Errorf("image %q not found", ref)
+	}
+	return imageID, nil
+}
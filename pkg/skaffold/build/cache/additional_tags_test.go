@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestApplyAdditionalTags(t *testing.T) {
+	tests := []struct {
+		description    string
+		artifacts      []build.Artifact
+		additionalTags tag.AdditionalTags
+		local          bool
+		tagErr         error
+		expectedLocal  []string
+		expectedRemote []string
+		shouldErr      bool
+	}{
+		{
+			description: "no additional tags",
+			artifacts:   []build.Artifact{{ImageName: "image", Tag: "image:v1@sha256:abac"}},
+		},
+		{
+			description:    "pushed image",
+			artifacts:      []build.Artifact{{ImageName: "image", Tag: "image:v1@sha256:abac"}, {ImageName: "other", Tag: "other:v1@sha256:abac"}},
+			additionalTags: tag.AdditionalTags{"image": {"image:main", "image:latest"}},
+			expectedRemote: []string{"image:main@sha256:abac -> image:main", "image:latest@sha256:abac -> image:latest"},
+		},
+		{
+			description:    "local image",
+			artifacts:      []build.Artifact{{ImageName: "image", Tag: "image:v1"}},
+			additionalTags: tag.AdditionalTags{"image": {"image:latest"}},
+			local:          true,
+			expectedLocal:  []string{"image:latest"},
+		},
+		{
+			description:    "unknown local image",
+			artifacts:      []build.Artifact{{ImageName: "image", Tag: "image:unknown"}},
+			additionalTags: tag.AdditionalTags{"image": {"image:latest"}},
+			local:          true,
+			shouldErr:      true,
+		},
+		{
+			description:    "tagging error",
+			artifacts:      []build.Artifact{{ImageName: "image", Tag: "image:v1@sha256:abac"}},
+			additionalTags: tag.AdditionalTags{"image": {"image:latest"}},
+			tagErr:         errors.New("unauthorized"),
+			expectedRemote: []string{"image:latest@sha256:abac -> image:latest"},
+			shouldErr:      true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var remote []string
+			t.Override(&addRemoteTag, func(src, target string, _ docker.Config) error {
+				remote = append(remote, src+" -> "+target)
+				return test.tagErr
+			})
+			api := (&testutil.FakeAPIClient{}).Add("image:v1", "sha256:123")
+			c := &cache{
+				client:       fakeLocalDaemon(api),
+				isLocalImage: func(string) (bool, error) { return test.local, nil },
+			}
+
+			err := c.applyAdditionalTags(context.Background(), ioutil.Discard, test.artifacts, test.additionalTags)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedRemote, remote)
+			for _, ref := range test.expectedLocal {
+				id, err := c.client.ImageID(context.Background(), ref)
+				t.CheckNoError(err)
+				t.CheckDeepEqual("sha256:123", id)
+			}
+		})
+	}
+}
@@ -19,26 +19,20 @@ package cache
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
-	"path/filepath"
 	"sync"
 
-	homedir "github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
 )
 
 // ImageDetails holds the Digest and ID of an image
 type ImageDetails struct {
-	Digest string `yaml:"digest,omitempty"`
-	ID     string `yaml:"id,omitempty"`
+	Digest string `yaml:"digest,omitempty" json:"digest,omitempty"`
+	ID     string `yaml:"id,omitempty" json:"id,omitempty"`
 }
 
 // ArtifactCache is a map of [artifact dependencies hash : ImageDetails]
@@ -47,15 +41,15 @@ type ArtifactCache map[string]ImageDetails
 // cache holds any data necessary for accessing the cache
 type cache struct {
 	artifactCache      ArtifactCache
-	artifactGraph      build.ArtifactGraph
+	storedCache        ArtifactCache
 	artifactStore      build.ArtifactStore
+	hasher             *ArtifactHasher
 	cacheMutex         sync.RWMutex
 	client             docker.LocalDaemon
 	cfg                Config
-	cacheFile          string
+	store              Store
 	isLocalImage       func(imageName string) (bool, error)
 	importMissingImage func(imageName string) (bool, error)
-	lister             DependencyLister
 }
 
 // DependencyLister fetches a list of dependencies for an artifact
@@ -69,28 +63,23 @@ type Config interface {
 	GetCluster() config.Cluster
 	CacheArtifacts() bool
 	CacheFile() string
+	CacheURL() string
 	Mode() config.RunMode
 }
 
 // NewCache returns the current state of the cache
-func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), dependencies DependencyLister, graph build.ArtifactGraph, store build.ArtifactStore) (Cache, error) {
+func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), hasher *ArtifactHasher, artifactStore build.ArtifactStore) (Cache, error) {
 	if !cfg.CacheArtifacts() {
-		return &noCache{}, nil
+		return &noCache{c: &cache{cfg: cfg, isLocalImage: isLocalImage}}, nil
 	}
 
-	cacheFile, err := resolveCacheFile(cfg.CacheFile())
+	store, err := newStore(cfg)
 	if err != nil {
-		logrus.Warnf("Error resolving cache file, not using skaffold cache: %v", err)
-		return &noCache{}, nil
+		logrus.Warnf("Error initializing artifact cache, not using skaffold cache: %v", err)
+		return &noCache{c: &cache{cfg: cfg, isLocalImage: isLocalImage}}, nil
 	}
 
-	artifactCache, err := retrieveArtifactCache(cacheFile)
-	if err != nil {
-		logrus.Warnf("Error retrieving artifact cache, not using skaffold cache: %v", err)
-		return &noCache{}, nil
-	}
-
-	client, err := docker.NewAPIClient(cfg)
+	client, err := localImageStore(cfg)
 	if err != nil {
 		// error only if any pipeline is local.
 		for _, p := range cfg.GetPipelines() {
@@ -115,48 +104,24 @@ func NewCache(cfg Config, isLocalImage func(imageName string) (bool, error), dep
 	}
 
 	return &cache{
-		artifactCache:      artifactCache,
-		artifactGraph:      graph,
-		artifactStore:      store,
+		artifactCache:      ArtifactCache{},
+		storedCache:        ArtifactCache{},
+		artifactStore:      artifactStore,
+		hasher:             hasher,
 		client:             client,
 		cfg:                cfg,
-		cacheFile:          cacheFile,
+		store:              store,
 		isLocalImage:       isLocalImage,
 		importMissingImage: importMissingImage,
-		lister:             dependencies,
 	}, nil
 }
 
-// resolveCacheFile makes sure that either a passed in cache file or the default cache file exists
-func resolveCacheFile(cacheFile string) (string, error) {
-	if cacheFile != "" {
-		return cacheFile, util.VerifyOrCreateFile(cacheFile)
-	}
-	home, err := homedir.Dir()
-	if err != nil {
-		return "", fmt.Errorf("retrieving home directory: %w", err)
-	}
-	defaultFile := filepath.Join(home, constants.DefaultSkaffoldDir, constants.DefaultCacheFile)
-	return defaultFile, util.VerifyOrCreateFile(defaultFile)
-}
-
-func retrieveArtifactCache(cacheFile string) (ArtifactCache, error) {
-	cache := ArtifactCache{}
-	contents, err := ioutil.ReadFile(cacheFile)
-	if err != nil {
-		return nil, err
-	}
-	if err := yaml.Unmarshal(contents, &cache); err != nil {
-		return nil, err
-	}
-	return cache, nil
-}
-
-func saveArtifactCache(cacheFile string, contents ArtifactCache) error {
-	data, err := yaml.Marshal(contents)
-	if err != nil {
-		return err
+// localImageStore returns the Docker daemon, or the OCI image layout if local builds are daemonless.
+func localImageStore(cfg Config) (docker.LocalDaemon, error) {
+	for _, p := range cfg.GetPipelines() {
+		if p.Build.LocalBuild != nil && p.Build.LocalBuild.Daemonless {
+			return docker.NewLayoutDaemon(cfg)
+		}
 	}
-
-	return ioutil.WriteFile(cacheFile, data, 0755)
+	return docker.NewAPIClient(cfg)
 }
@@ -23,6 +23,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 )
 
+// For testing
+var addRemoteTag = docker.AddRemoteTag
+
 type cacheDetails interface {
 	Hash() string
 }
@@ -86,7 +89,7 @@ func (d needsRemoteTagging) Hash() string {
 
 func (d needsRemoteTagging) Tag(ctx context.Context, c *cache) error {
 	fqn := d.tag + "@" + d.digest // Tag is not important. We just need the registry and the digest to locate the image.
-	return docker.AddRemoteTag(fqn, d.tag, c.cfg)
+	return addRemoteTag(fqn, d.tag, c.cfg)
 }
 
 // Found locally. Needs pushing
@@ -26,11 +26,13 @@ import (
 	"io"
 	"os"
 	"sort"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -49,22 +51,70 @@ type artifactHasher interface {
 }
 
 type artifactHasherImpl struct {
-	artifacts build.ArtifactGraph
-	lister    DependencyLister
-	mode      config.RunMode
-	syncStore *util.SyncStore
+	artifacts    build.ArtifactGraph
+	outputs      fileOutputs
+	lister       DependencyLister
+	mode         config.RunMode
+	syncStore    *util.SyncStore
+	outputHashes *util.SyncStore
 }
 
 // newArtifactHasher returns a new instance of an artifactHasher. Use newArtifactHasherFunc instead of calling this function directly.
-func newArtifactHasher(artifacts build.ArtifactGraph, lister DependencyLister, mode config.RunMode) artifactHasher {
+func newArtifactHasher(artifacts build.ArtifactGraph, outputs fileOutputs, lister DependencyLister, mode config.RunMode) artifactHasher {
 	return &artifactHasherImpl{
-		artifacts: artifacts,
-		lister:    lister,
-		mode:      mode,
-		syncStore: util.NewSyncStore(),
+		artifacts:    artifacts,
+		outputs:      outputs,
+		lister:       lister,
+		mode:         mode,
+		syncStore:    util.NewSyncStore(),
+		outputHashes: util.NewSyncStore(),
 	}
 }
 
+// ArtifactHasher computes the hash of an artifact's inputs, used as the key of the artifact cache and by the `inputDigest` tagger.
+// Each hash is computed at most once until Reset is called, which happens at the start of every dev loop.
+type ArtifactHasher struct {
+	graph   build.ArtifactGraph
+	outputs fileOutputs
+	lister  DependencyLister
+	mode    config.RunMode
+
+	mutex   sync.Mutex
+	current artifactHasher
+}
+
+// NewArtifactHasher returns an ArtifactHasher shared by the artifact cache and the taggers.
+func NewArtifactHasher(cfg Config, lister DependencyLister, graph build.ArtifactGraph) *ArtifactHasher {
+	return &ArtifactHasher{
+		graph:   graph,
+		outputs: newFileOutputs(cfg.GetPipelines()),
+		lister:  lister,
+		mode:    cfg.Mode(),
+	}
+}
+
+// Hash returns the hash of the artifact's inputs.
+func (h *ArtifactHasher) Hash(ctx context.Context, a *latest.Artifact) (string, error) {
+	return h.hasher().hash(ctx, a)
+}
+
+// Reset forgets the hashes computed so far, so that they reflect the current state of the sources.
+func (h *ArtifactHasher) Reset() {
+	h.mutex.Lock()
+	h.current = nil
+	h.mutex.Unlock()
+}
+
+func (h *ArtifactHasher) hasher() artifactHasher {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.current == nil {
+		h.current = newArtifactHasherFunc(h.graph, h.outputs, h.lister, h.mode)
+	}
+	return h.current
+}
+
 func (h *artifactHasherImpl) hash(ctx context.Context, a *latest.Artifact) (string, error) {
 	hash, err := h.safeHash(ctx, a)
 	if err != nil {
@@ -78,6 +128,13 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latest.Artifact) (stri
 		}
 		hashes = append(hashes, depHash)
 	}
+	for _, d := range a.OutputDependencies {
+		outputHash, err := h.outputHash(d.Name)
+		if err != nil {
+			return "", err
+		}
+		hashes = append(hashes, d.Path, outputHash)
+	}
 
 	if len(hashes) == 1 {
 		return hashes[0], nil
@@ -104,6 +161,30 @@ func (h *artifactHasherImpl) safeHash(ctx context.Context, a *latest.Artifact) (
 	}
 }
 
+// outputHash calculates the hash of the inputs of a file output at most once.
+func (h *artifactHasherImpl) outputHash(name string) (string, error) {
+	val := h.outputHashes.Exec(name,
+		func() interface{} {
+			o, found := h.outputs[name]
+			if !found {
+				return fmt.Errorf("unknown file output %q", name)
+			}
+			hash, err := outputHash(o)
+			if err != nil {
+				return fmt.Errorf("hashing inputs of file output %q: %w", name, err)
+			}
+			return hash
+		})
+	switch t := val.(type) {
+	case error:
+		return "", t
+	case string:
+		return t, nil
+	default:
+		return "", fmt.Errorf("internal error when retrieving cache result of type %T", t)
+	}
+}
+
 // singleArtifactHash calculates the hash for a single artifact, and ignores its required artifacts.
 func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latest.Artifact, mode config.RunMode) (string, error) {
 	var inputs []string
@@ -143,6 +224,13 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 	if args != nil {
 		inputs = append(inputs, args...)
 	}
+
+	// add target platforms for the artifact if specified
+	if len(a.Platforms) > 0 {
+		platforms := append([]string{}, a.Platforms...)
+		sort.Strings(platforms)
+		inputs = append(inputs, platforms...)
+	}
 	return encode(inputs)
 }
 
@@ -177,6 +265,8 @@ func hashBuildArgs(artifact *latest.Artifact, mode config.RunMode) ([]string, er
 		args, err = docker.EvalBuildArgs(mode, artifact.Workspace, artifact.KanikoArtifact.DockerfilePath, artifact.KanikoArtifact.BuildArgs, nil)
 	case artifact.BuildpackArtifact != nil:
 		env, err = buildpacks.GetEnv(artifact, mode)
+	case artifact.KoArtifact != nil:
+		env, err = ko.GetEnv(artifact.KoArtifact, mode)
 	case artifact.CustomArtifact != nil && artifact.CustomArtifact.Dependencies.Dockerfile != nil:
 		args, err = util.EvaluateEnvTemplateMap(artifact.CustomArtifact.Dependencies.Dockerfile.BuildArgs)
 	default:
@@ -146,6 +146,24 @@ func TestGetHashForArtifact(t *testing.T) {
 			mode:     config.RunModes.Debug,
 			expected: "c3a878f799b2a6532db71683a09771af4f9d20ef5884c57642a272934e5c93ea",
 		},
+		{
+			description:  "platforms",
+			dependencies: []string{"a", "b"},
+			artifact: &latest.Artifact{
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+			mode:     config.RunModes.Dev,
+			expected: "14f85bcfd809f89a140598a6e9fa58d200e0040e2049480f05a4f80fdab04437",
+		},
+		{
+			description:  "platforms in different orders",
+			dependencies: []string{"a", "b"},
+			artifact: &latest.Artifact{
+				Platforms: []string{"linux/arm64", "linux/amd64"},
+			},
+			mode:     config.RunModes.Dev,
+			expected: "14f85bcfd809f89a140598a6e9fa58d200e0040e2049480f05a4f80fdab04437",
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -159,7 +177,7 @@ func TestGetHashForArtifact(t *testing.T) {
 			}
 
 			depLister := stubDependencyLister(test.dependencies)
-			actual, err := newArtifactHasher(nil, depLister, test.mode).hash(context.Background(), test.artifact)
+			actual, err := newArtifactHasher(nil, nil, depLister, test.mode).hash(context.Background(), test.artifact)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
@@ -243,7 +261,7 @@ func TestGetHashForArtifactWithDependencies(t *testing.T) {
 				return test.fileDeps[a.ImageName], nil
 			}
 
-			actual, err := newArtifactHasher(g, depLister, test.mode).hash(context.Background(), test.artifacts[0])
+			actual, err := newArtifactHasher(g, nil, depLister, test.mode).hash(context.Background(), test.artifacts[0])
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
@@ -306,21 +324,21 @@ func TestBuildArgs(t *testing.T) {
 			}
 			t.Override(&fileHasherFunc, mockCacheHasher)
 			t.Override(&artifactConfigFunc, fakeArtifactConfig)
-			actual, err := newArtifactHasher(nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
+			actual, err := newArtifactHasher(nil, nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
 
 			// Change order of buildargs
 			artifact.ArtifactType.DockerArtifact.BuildArgs = map[string]*string{"two": util.StringPtr("2"), "one": util.StringPtr("1")}
-			actual, err = newArtifactHasher(nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
+			actual, err = newArtifactHasher(nil, nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
 
 			// Change build args, get different hash
 			artifact.ArtifactType.DockerArtifact.BuildArgs = map[string]*string{"one": util.StringPtr("1")}
-			actual, err = newArtifactHasher(nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
+			actual, err = newArtifactHasher(nil, nil, stubDependencyLister(nil), test.mode).hash(context.Background(), artifact)
 
 			t.CheckNoError(err)
 			if actual == test.expected {
@@ -353,7 +371,7 @@ func TestBuildArgsEnvSubstitution(t *testing.T) {
 		t.Override(&artifactConfigFunc, fakeArtifactConfig)
 
 		depLister := stubDependencyLister([]string{"dep"})
-		hash1, err := newArtifactHasher(nil, depLister, config.RunModes.Build).hash(context.Background(), artifact)
+		hash1, err := newArtifactHasher(nil, nil, depLister, config.RunModes.Build).hash(context.Background(), artifact)
 
 		t.CheckNoError(err)
 
@@ -363,7 +381,7 @@ func TestBuildArgsEnvSubstitution(t *testing.T) {
 			return []string{"FOO=baz"}
 		}
 
-		hash2, err := newArtifactHasher(nil, depLister, config.RunModes.Build).hash(context.Background(), artifact)
+		hash2, err := newArtifactHasher(nil, nil, depLister, config.RunModes.Build).hash(context.Background(), artifact)
 
 		t.CheckNoError(err)
 		if hash1 == hash2 {
@@ -420,7 +438,7 @@ func TestCacheHasher(t *testing.T) {
 			path := originalFile
 			depLister := stubDependencyLister([]string{tmpDir.Path(originalFile)})
 
-			oldHash, err := newArtifactHasher(nil, depLister, config.RunModes.Build).hash(context.Background(), &latest.Artifact{})
+			oldHash, err := newArtifactHasher(nil, nil, depLister, config.RunModes.Build).hash(context.Background(), &latest.Artifact{})
 			t.CheckNoError(err)
 
 			test.update(originalFile, tmpDir)
@@ -429,7 +447,7 @@ func TestCacheHasher(t *testing.T) {
 			}
 
 			depLister = stubDependencyLister([]string{tmpDir.Path(path)})
-			newHash, err := newArtifactHasher(nil, depLister, config.RunModes.Build).hash(context.Background(), &latest.Artifact{})
+			newHash, err := newArtifactHasher(nil, nil, depLister, config.RunModes.Build).hash(context.Background(), &latest.Artifact{})
 
 			t.CheckNoError(err)
 			t.CheckFalse(test.differentHash && oldHash == newHash)
@@ -569,3 +587,26 @@ func TestHashBuildArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestNewArtifactHasher(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("foo", "contents")
+		artifact := &latest.Artifact{ImageName: "image"}
+		hasher := NewArtifactHasher(&mockConfig{mode: config.RunModes.Build}, stubDependencyLister([]string{tmpDir.Path("foo")}), build.ToArtifactGraph([]*latest.Artifact{artifact}))
+
+		expected, err := newArtifactHasher(nil, nil, stubDependencyLister([]string{tmpDir.Path("foo")}), config.RunModes.Build).hash(context.Background(), artifact)
+		t.CheckNoError(err)
+		hash, err := hasher.Hash(context.Background(), artifact)
+		t.CheckErrorAndDeepEqual(false, err, expected, hash)
+
+		// hashes are kept until the hasher is reset
+		tmpDir.Write("foo", "new contents")
+		sameHash, err := hasher.Hash(context.Background(), artifact)
+		t.CheckErrorAndDeepEqual(false, err, hash, sameHash)
+
+		hasher.Reset()
+		newHash, err := hasher.Hash(context.Background(), artifact)
+		t.CheckNoError(err)
+		t.CheckFalse(hash == newHash)
+	})
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
+)
+
+const httpStoreTimeout = 10 * time.Second
+
+// httpStore shares artifact cache entries through any HTTP server or object store
+// that supports `GET` and `PUT` of objects, one object per artifact hash.
+// Only image digests are shared since image IDs are specific to a Docker daemon.
+type httpStore struct {
+	baseURL string
+	client  *http.Client
+}
+
+func newHTTPStore(baseURL string) (*httpStore, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing cache url %q: %w", baseURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported cache url scheme %q, expected http or https", u.Scheme)
+	}
+
+	return &httpStore{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{Timeout: httpStoreTimeout},
+	}, nil
+}
+
+func (s *httpStore) Get(ctx context.Context, hash string) (ImageDetails, bool, error) {
+	resp, err := s.do(ctx, http.MethodGet, hash, nil)
+	if err != nil {
+		return ImageDetails{}, false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return ImageDetails{}, false, nil
+	default:
+		return ImageDetails{}, false, fmt.Errorf("getting cache entry %s: http %d, error %q", hash, resp.StatusCode, resp.Status)
+	}
+
+	var entry ImageDetails
+	if err := json.NewDecoder(resp.Body).Decode(&entry); err != nil {
+		return ImageDetails{}, false, fmt.Errorf("decoding cache entry %s: %w", hash, err)
+	}
+	if entry.Digest == "" {
+		return ImageDetails{}, false, nil
+	}
+
+	logrus.Debugf("Found artifact cache entry %s in remote cache", hash)
+	return ImageDetails{Digest: entry.Digest}, true, nil
+}
+
+func (s *httpStore) Put(ctx context.Context, entries ArtifactCache) error {
+	for hash, entry := range entries {
+		if entry.Digest == "" {
+			continue
+		}
+
+		buf, err := json.Marshal(ImageDetails{Digest: entry.Digest})
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.do(ctx, http.MethodPut, hash, buf)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("saving cache entry %s: http %d, error %q", hash, resp.StatusCode, resp.Status)
+		}
+	}
+	return nil
+}
+
+func (s *httpStore) do(ctx context.Context, method string, hash string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+"/"+hash, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating http request: %w", err)
+	}
+	req.Header.Set("User-Agent", version.UserAgent())
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return s.client.Do(req)
+}
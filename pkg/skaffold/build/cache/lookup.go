@@ -31,9 +31,9 @@ import (
 
 func (c *cache) lookupArtifacts(ctx context.Context, tags tag.ImageTags, artifacts []*latest.Artifact) []cacheDetails {
 	details := make([]cacheDetails, len(artifacts))
-	// Create a new `artifactHasher` on every new dev loop.
+	// The hasher is reset on every new dev loop, and shared with the taggers.
 	// This way every artifact hash is calculated at most once in a single dev loop, and recalculated on every dev loop.
-	h := newArtifactHasherFunc(c.artifactGraph, c.lister, c.cfg.Mode())
+	h := c.hasher.hasher()
 	var wg sync.WaitGroup
 	for i := range artifacts {
 		wg.Add(1)
@@ -55,9 +55,10 @@ func (c *cache) lookup(ctx context.Context, a *latest.Artifact, tag string, h ar
 		return failed{err: fmt.Errorf("getting hash for artifact %q: %s", a.ImageName, err)}
 	}
 
-	c.cacheMutex.RLock()
-	entry, cacheHit := c.artifactCache[hash]
-	c.cacheMutex.RUnlock()
+	entry, cacheHit, err := c.entry(ctx, hash)
+	if err != nil {
+		logrus.Debugf("Could not retrieve artifact cache entry for %q (%s)", a.ImageName, err)
+	}
 	if !cacheHit {
 		if entry, err = c.tryImport(ctx, a, tag, hash); err != nil {
 			logrus.Debugf("Could not import artifact from Docker, building instead (%s)", err)
@@ -73,6 +74,28 @@ func (c *cache) lookup(ctx context.Context, a *latest.Artifact, tag string, h ar
 	return c.lookupRemote(ctx, hash, tag, entry)
 }
 
+// entry returns the cached details for a given hash, looking them up in the cache store
+// if they were not already retrieved during this run.
+func (c *cache) entry(ctx context.Context, hash string) (ImageDetails, bool, error) {
+	c.cacheMutex.RLock()
+	entry, cacheHit := c.artifactCache[hash]
+	c.cacheMutex.RUnlock()
+	if cacheHit || c.store == nil {
+		return entry, cacheHit, nil
+	}
+
+	entry, cacheHit, err := c.store.Get(ctx, hash)
+	if err != nil || !cacheHit {
+		return ImageDetails{}, false, err
+	}
+
+	c.cacheMutex.Lock()
+	c.artifactCache[hash] = entry
+	c.storedCache[hash] = entry
+	c.cacheMutex.Unlock()
+	return entry, true, nil
+}
+
 func (c *cache) lookupLocal(ctx context.Context, hash, tag string, entry ImageDetails) cacheDetails {
 	if entry.ID == "" {
 		return needsBuilding{hash: hash}
@@ -123,9 +123,12 @@ func TestLookupLocal(t *testing.T) {
 				artifactCache:      test.cache,
 				client:             fakeLocalDaemon(test.api),
 				cfg:                &mockConfig{mode: config.RunModes.Build},
+				hasher:             &ArtifactHasher{},
 			}
 
-			t.Override(&newArtifactHasherFunc, func(_ build.ArtifactGraph, _ DependencyLister, _ config.RunMode) artifactHasher { return test.hasher })
+			t.Override(&newArtifactHasherFunc, func(_ build.ArtifactGraph, _ fileOutputs, _ DependencyLister, _ config.RunMode) artifactHasher {
+				return test.hasher
+			})
 			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latest.Artifact{{
 				ImageName: "artifact",
 			}})
@@ -212,8 +215,11 @@ func TestLookupRemote(t *testing.T) {
 				artifactCache:      test.cache,
 				client:             fakeLocalDaemon(test.api),
 				cfg:                &mockConfig{mode: config.RunModes.Build},
+				hasher:             &ArtifactHasher{},
 			}
-			t.Override(&newArtifactHasherFunc, func(_ build.ArtifactGraph, _ DependencyLister, _ config.RunMode) artifactHasher { return test.hasher })
+			t.Override(&newArtifactHasherFunc, func(_ build.ArtifactGraph, _ fileOutputs, _ DependencyLister, _ config.RunMode) artifactHasher {
+				return test.hasher
+			})
 			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latest.Artifact{{
 				ImageName: "artifact",
 			}})
@@ -242,6 +248,36 @@ func (f failingHasher) hash(context.Context, *latest.Artifact) (string, error) {
 	return "", f.err
 }
 
+func TestLookupSharesHashes(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("dep", "content")
+		artifact := &latest.Artifact{ImageName: "artifact"}
+		listed := 0
+		lister := func(context.Context, *latest.Artifact) ([]string, error) {
+			listed++
+			return []string{tmpDir.Path("dep")}, nil
+		}
+		cfg := &mockConfig{mode: config.RunModes.Build}
+		hasher := NewArtifactHasher(cfg, lister, build.ToArtifactGraph([]*latest.Artifact{artifact}))
+		cache := &cache{
+			isLocalImage:       func(string) (bool, error) { return true, nil },
+			importMissingImage: func(imageName string) (bool, error) { return false, nil },
+			artifactCache:      map[string]ImageDetails{},
+			client:             fakeLocalDaemon(&testutil.FakeAPIClient{}),
+			cfg:                cfg,
+			hasher:             hasher,
+		}
+
+		// The tagger hashes the artifact first, then the cache looks it up with the same hash.
+		hash, err := hasher.Hash(context.Background(), artifact)
+		t.CheckNoError(err)
+		details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, []*latest.Artifact{artifact})
+
+		t.CheckDeepEqual(hash, details[0].Hash())
+		t.CheckDeepEqual(1, listed)
+	})
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	homedir "github.com/mitchellh/go-homedir"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/list"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// OutputConfig is the configuration needed to produce the file outputs.
+type OutputConfig interface {
+	GetPipelines() []latest.Pipeline
+	CacheArtifacts() bool
+	CacheFile() string
+}
+
+// OutputStore produces the file outputs required by artifacts.
+// The files are stored by the hash of the outputs' inputs, so they're only produced again when these inputs change.
+type OutputStore struct {
+	dir      string
+	useCache bool
+	outputs  fileOutputs
+	dirs     map[string]string
+	mutex    sync.RWMutex
+}
+
+// NewOutputStore returns a new OutputStore. The files are kept in a directory next to the artifact cache file.
+func NewOutputStore(cfg OutputConfig) (*OutputStore, error) {
+	dir := filepath.Dir(cfg.CacheFile())
+	if cfg.CacheFile() == "" {
+		home, err := homedir.Dir()
+		if err != nil {
+			return nil, fmt.Errorf("retrieving home directory: %w", err)
+		}
+		dir = filepath.Join(home, constants.DefaultSkaffoldDir)
+	}
+
+	return &OutputStore{
+		dir:      filepath.Join(dir, constants.DefaultOutputsDir),
+		useCache: cfg.CacheArtifacts(),
+		outputs:  newFileOutputs(cfg.GetPipelines()),
+		dirs:     map[string]string{},
+	}, nil
+}
+
+// Produce makes sure the files of the outputs required by the given artifacts exist.
+func (s *OutputStore) Produce(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) error {
+	seen := make(map[string]bool)
+	for _, a := range artifacts {
+		for _, d := range a.OutputDependencies {
+			if seen[d.Name] {
+				continue
+			}
+			seen[d.Name] = true
+
+			o, found := s.outputs[d.Name]
+			if !found {
+				return fmt.Errorf("unknown file output %q required by artifact %q", d.Name, a.ImageName)
+			}
+			if err := s.produce(ctx, out, o); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *OutputStore) produce(ctx context.Context, out io.Writer, o *latest.FileOutput) error {
+	hash, err := outputHash(o)
+	if err != nil {
+		return fmt.Errorf("hashing inputs of file output %q: %w", o.Name, err)
+	}
+	dir := filepath.Join(s.dir, hash)
+
+	color.Default.Fprintf(out, " - file output %s: ", o.Name)
+	if s.useCache && util.IsDir(dir) {
+		color.Green.Fprintln(out, "Found")
+	} else {
+		color.Yellow.Fprintln(out, "Producing")
+		if err := runOutputCommand(ctx, out, o, dir); err != nil {
+			return fmt.Errorf("producing file output %q: %w", o.Name, err)
+		}
+	}
+
+	s.mutex.Lock()
+	s.dirs[o.Name] = dir
+	s.mutex.Unlock()
+	return nil
+}
+
+// GetOutputDir returns the directory holding the files of the given output, once they've been produced.
+func (s *OutputStore) GetOutputDir(name string) (string, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	dir, found := s.dirs[name]
+	return dir, found
+}
+
+// GetOutputDependencies returns the input files of the given output.
+func (s *OutputStore) GetOutputDependencies(name string) ([]string, error) {
+	o, found := s.outputs[name]
+	if !found {
+		return nil, fmt.Errorf("unknown file output %q", name)
+	}
+	return outputDependencies(o)
+}
+
+// runOutputCommand runs the command of a file output, and moves the produced files to the given directory once it succeeds.
+func runOutputCommand(ctx context.Context, out io.Writer, o *latest.FileOutput, dir string) error {
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+	tmpDir, err := ioutil.TempDir(filepath.Dir(dir), "tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", o.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", o.Command)
+	}
+	cmd.Dir = o.Workspace
+	cmd.Env = append(util.OSEnviron(), fmt.Sprintf("%s=%s", constants.OutputDir, tmpDir))
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	return os.Rename(tmpDir, dir)
+}
+
+// fileOutputs indexes the file outputs of all the pipelines by name.
+type fileOutputs map[string]*latest.FileOutput
+
+func newFileOutputs(pipelines []latest.Pipeline) fileOutputs {
+	outputs := fileOutputs{}
+	for _, p := range pipelines {
+		for _, o := range p.Build.Outputs {
+			outputs[o.Name] = o
+		}
+	}
+	return outputs
+}
+
+// outputHash calculates the hash of the inputs of a file output: its command and the contents of its dependencies.
+func outputHash(o *latest.FileOutput) (string, error) {
+	inputs := []string{o.Command}
+
+	deps, err := outputDependencies(o)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range deps {
+		h, err := fileHasherFunc(d)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", fmt.Errorf("getting hash for %q: %w", d, err)
+		}
+		inputs = append(inputs, h)
+	}
+	return encode(inputs)
+}
+
+// outputDependencies lists the input files of a file output.
+func outputDependencies(o *latest.FileOutput) ([]string, error) {
+	patterns := o.Dependencies
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	files, err := list.Files(o.Workspace, patterns, nil)
+	if err != nil {
+		return nil, err
+	}
+	return util.AbsolutePaths(o.Workspace, files), nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockOutputConfig struct {
+	outputs   []*latest.FileOutput
+	cacheFile string
+	useCache  bool
+}
+
+func (c *mockOutputConfig) CacheArtifacts() bool { return c.useCache }
+func (c *mockOutputConfig) CacheFile() string    { return c.cacheFile }
+func (c *mockOutputConfig) GetPipelines() []latest.Pipeline {
+	return []latest.Pipeline{{Build: latest.BuildConfig{Outputs: c.outputs}}}
+}
+
+func TestOutputStoreProduce(t *testing.T) {
+	tests := []struct {
+		description string
+		useCache    bool
+		expectedRun string
+	}{
+		{
+			description: "reuse files when inputs didn't change",
+			useCache:    true,
+			expectedRun: "run\nrun\n",
+		},
+		{
+			description: "always produce files without cache",
+			expectedRun: "run\nrun\nrun\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			cacheDir := t.NewTempDir()
+			workspace := t.NewTempDir().
+				Write("src/app.js", "v1").
+				Write("README.md", "not an input")
+			output := &latest.FileOutput{
+				Name:         "bundle",
+				Workspace:    workspace.Root(),
+				Command:      "cat src/app.js > $OUTPUT_DIR/bundle.js && echo run >> " + cacheDir.Path("runs"),
+				Dependencies: []string{"src"},
+			}
+			artifacts := []*latest.Artifact{
+				{ImageName: "first", OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "dist"}}},
+				{ImageName: "second", OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "static"}}},
+			}
+
+			store, err := NewOutputStore(&mockOutputConfig{
+				outputs:   []*latest.FileOutput{output},
+				cacheFile: cacheDir.Path("cache"),
+				useCache:  test.useCache,
+			})
+			t.CheckNoError(err)
+
+			// produced once for both artifacts
+			t.CheckNoError(store.Produce(context.Background(), ioutil.Discard, artifacts))
+			dir1, found := store.GetOutputDir("bundle")
+			t.CheckTrue(found)
+			checkFileContent(t, filepath.Join(dir1, "bundle.js"), "v1")
+
+			// files that aren't inputs don't change the hash
+			workspace.Write("README.md", "changed")
+			t.CheckNoError(store.Produce(context.Background(), ioutil.Discard, artifacts))
+			dir2, _ := store.GetOutputDir("bundle")
+			t.CheckDeepEqual(dir1, dir2)
+
+			// inputs changed
+			workspace.Write("src/app.js", "v2")
+			t.CheckNoError(store.Produce(context.Background(), ioutil.Discard, artifacts))
+			dir3, _ := store.GetOutputDir("bundle")
+			t.CheckFalse(dir1 == dir3)
+			checkFileContent(t, filepath.Join(dir3, "bundle.js"), "v2")
+
+			checkFileContent(t, cacheDir.Path("runs"), test.expectedRun)
+		})
+	}
+}
+
+func TestOutputStoreProduceFailure(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cacheDir := t.NewTempDir()
+		store, err := NewOutputStore(&mockOutputConfig{
+			outputs:   []*latest.FileOutput{{Name: "bundle", Workspace: t.NewTempDir().Root(), Command: "exit 1"}},
+			cacheFile: cacheDir.Path("cache"),
+			useCache:  true,
+		})
+		t.CheckNoError(err)
+
+		err = store.Produce(context.Background(), ioutil.Discard, []*latest.Artifact{
+			{ImageName: "image", OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "bundle"}}},
+		})
+
+		t.CheckErrorContains(`producing file output "bundle"`, err)
+		_, found := store.GetOutputDir("bundle")
+		t.CheckFalse(found)
+		files, err := ioutil.ReadDir(cacheDir.Path("outputs"))
+		t.CheckNoError(err)
+		t.CheckEmpty(files)
+	})
+}
+
+func TestOutputStoreGetOutputDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		workspace := t.NewTempDir().Touch("src/app.js", "src/lib/util.js", "README.md")
+		store, err := NewOutputStore(&mockOutputConfig{outputs: []*latest.FileOutput{
+			{Name: "bundle", Workspace: workspace.Root(), Command: "true", Dependencies: []string{"src"}},
+			{Name: "all", Workspace: workspace.Root(), Command: "true"},
+		}, cacheFile: "cache"})
+		t.CheckNoError(err)
+
+		deps, err := store.GetOutputDependencies("bundle")
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{workspace.Path("src/app.js"), workspace.Path("src/lib/util.js")}, deps)
+
+		deps, err = store.GetOutputDependencies("all")
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{workspace.Path("README.md"), workspace.Path("src/app.js"), workspace.Path("src/lib/util.js")}, deps)
+
+		_, err = store.GetOutputDependencies("unknown")
+		t.CheckErrorContains(`unknown file output "unknown"`, err)
+	})
+}
+
+func TestHashWithOutputDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&artifactConfigFunc, fakeArtifactConfig)
+		workspace := t.NewTempDir().Write("app.js", "v1")
+		outputs := fileOutputs{"bundle": {Name: "bundle", Workspace: workspace.Root(), Command: "build"}}
+		artifact := &latest.Artifact{
+			ImageName:          "image",
+			OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "dist"}},
+		}
+		hash := func() string {
+			h, err := newArtifactHasher(nil, outputs, stubDependencyLister(nil), config.RunModes.Dev).hash(context.Background(), artifact)
+			t.CheckNoError(err)
+			return h
+		}
+
+		hash1 := hash()
+		t.CheckDeepEqual(hash1, hash())
+
+		workspace.Write("app.js", "v2")
+		hash2 := hash()
+		t.CheckFalse(hash1 == hash2)
+
+		outputs["bundle"].Command = "build --prod"
+		t.CheckFalse(hash2 == hash())
+	})
+}
+
+func checkFileContent(t *testutil.T, path string, expected string) {
+	content, err := ioutil.ReadFile(path)
+	t.CheckNoError(err)
+	t.CheckDeepEqual(expected, string(content))
+}
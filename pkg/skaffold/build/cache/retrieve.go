@@ -33,7 +33,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
-func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, buildAndTest BuildAndTestFn) ([]build.Artifact, error) {
+func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, additionalTags tag.AdditionalTags, artifacts []*latest.Artifact, buildAndTest BuildAndTestFn) ([]build.Artifact, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -127,13 +127,18 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		return nil, err
 	}
 
+	// Images found in the cache get the additional tags the same way as the images that were just built.
+	if err := c.applyAdditionalTags(ctx, out, maintainArtifactOrder(append(bRes, alreadyBuilt...), artifacts), additionalTags); err != nil {
+		return nil, err
+	}
+
 	if err := c.addArtifacts(ctx, bRes, hashByName); err != nil {
 		logrus.Warnf("error adding artifacts to cache; caching may not work as expected: %v", err)
 		return append(bRes, alreadyBuilt...), nil
 	}
 
-	if err := saveArtifactCache(c.cacheFile, c.artifactCache); err != nil {
-		logrus.Warnf("error saving cache file; caching may not work as expected: %v", err)
+	if err := c.saveEntries(ctx, c.entriesFor(results)); err != nil {
+		logrus.Warnf("error saving artifact cache; caching may not work as expected: %v", err)
 		return append(bRes, alreadyBuilt...), nil
 	}
 
@@ -155,6 +160,41 @@ func maintainArtifactOrder(built []build.Artifact, artifacts []*latest.Artifact)
 	return ordered
 }
 
+// entriesFor returns the cache entries corresponding to the given lookup results that were built, imported or changed
+// since they were retrieved from the cache store.
+func (c *cache) entriesFor(results []cacheDetails) ArtifactCache {
+	c.cacheMutex.RLock()
+	defer c.cacheMutex.RUnlock()
+
+	entries := ArtifactCache{}
+	for _, result := range results {
+		entry, found := c.artifactCache[result.Hash()]
+		if !found {
+			continue
+		}
+		if stored, found := c.storedCache[result.Hash()]; found && stored == entry {
+			continue
+		}
+		entries[result.Hash()] = entry
+	}
+	return entries
+}
+
+// saveEntries records the given entries in the cache store.
+// The store is called even without entries so that it can sync its backends.
+func (c *cache) saveEntries(ctx context.Context, entries ArtifactCache) error {
+	if err := c.store.Put(ctx, entries); err != nil {
+		return err
+	}
+
+	c.cacheMutex.Lock()
+	for hash, entry := range entries {
+		c.storedCache[hash] = entry
+	}
+	c.cacheMutex.Unlock()
+	return nil
+}
+
 func (c *cache) addArtifacts(ctx context.Context, bRes []build.Artifact, hashByName map[string]string) error {
 	for _, a := range bRes {
 		entry := ImageDetails{}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/docker/docker/api/types"
@@ -48,6 +49,7 @@ type mockArtifactStore map[string]string
 
 func (m mockArtifactStore) GetImageTag(imageName string) (string, bool) { return m[imageName], true }
 func (m mockArtifactStore) Record(a *latest.Artifact, tag string)       { m[a.ImageName] = tag }
+func (m mockArtifactStore) RecordAdditionalTags(string, []string)       {}
 func (m mockArtifactStore) GetArtifacts([]*latest.Artifact) ([]build.Artifact, error) {
 	return nil, nil
 }
@@ -141,12 +143,13 @@ func TestCacheBuildLocal(t *testing.T) {
 			cacheFile: tmpDir.Path("cache"),
 		}
 		store := make(mockArtifactStore)
-		artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return true, nil }, deps, build.ToArtifactGraph(artifacts), store)
+		hasher := NewArtifactHasher(cfg, deps, build.ToArtifactGraph(artifacts))
+		artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return true, nil }, hasher, store)
 		t.CheckNoError(err)
 
 		// First build: Need to build both artifacts
 		builder := &mockBuilder{dockerDaemon: dockerDaemon, push: false, store: store}
-		bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+		bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
 
 		t.CheckNoError(err)
 		t.CheckDeepEqual(2, len(builder.built))
@@ -154,20 +157,23 @@ func TestCacheBuildLocal(t *testing.T) {
 
 		// Second build: both artifacts are read from cache
 		// Artifacts should always be returned in their original order
+		// Images found in the cache get the additional tags
 		builder = &mockBuilder{dockerDaemon: dockerDaemon, push: false, store: store}
-		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, tag.AdditionalTags{"artifact1": {"artifact1:latest"}}, artifacts, builder.Build)
 
 		t.CheckNoError(err)
 		t.CheckEmpty(builder.built)
 		t.CheckDeepEqual(2, len(bRes))
 		t.CheckDeepEqual("artifact1", bRes[0].ImageName)
 		t.CheckDeepEqual("artifact2", bRes[1].ImageName)
+		t.CheckTrue(dockerDaemon.ImageExists(context.Background(), "artifact1:latest"))
 
 		// Third build: change first artifact's dependency
 		// Artifacts should always be returned in their original order
 		tmpDir.Write("dep1", "new content")
+		hasher.Reset()
 		builder = &mockBuilder{dockerDaemon: dockerDaemon, push: false, store: store}
-		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
 
 		t.CheckNoError(err)
 		t.CheckDeepEqual(1, len(builder.built))
@@ -178,8 +184,9 @@ func TestCacheBuildLocal(t *testing.T) {
 		// Fourth build: change second artifact's dependency
 		// Artifacts should always be returned in their original order
 		tmpDir.Write("dep3", "new content")
+		hasher.Reset()
 		builder = &mockBuilder{dockerDaemon: dockerDaemon, push: false, store: store}
-		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
 
 		t.CheckNoError(err)
 		t.CheckDeepEqual(1, len(builder.built))
@@ -237,12 +244,13 @@ func TestCacheBuildRemote(t *testing.T) {
 			pipeline:  latest.Pipeline{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{TryImportMissing: false}}}},
 			cacheFile: tmpDir.Path("cache"),
 		}
-		artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return false, nil }, deps, build.ToArtifactGraph(artifacts), make(mockArtifactStore))
+		hasher := NewArtifactHasher(cfg, deps, build.ToArtifactGraph(artifacts))
+		artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return false, nil }, hasher, make(mockArtifactStore))
 		t.CheckNoError(err)
 
 		// First build: Need to build both artifacts
 		builder := &mockBuilder{dockerDaemon: dockerDaemon, push: true}
-		bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+		bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
 
 		t.CheckNoError(err)
 		t.CheckDeepEqual(2, len(builder.built))
@@ -253,7 +261,7 @@ func TestCacheBuildRemote(t *testing.T) {
 
 		// Second build: both artifacts are read from cache
 		builder = &mockBuilder{dockerDaemon: dockerDaemon, push: true}
-		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
 
 		t.CheckNoError(err)
 		t.CheckEmpty(builder.built)
@@ -263,8 +271,9 @@ func TestCacheBuildRemote(t *testing.T) {
 
 		// Third build: change one artifact's dependencies
 		tmpDir.Write("dep1", "new content")
+		hasher.Reset()
 		builder = &mockBuilder{dockerDaemon: dockerDaemon, push: true}
-		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
 
 		t.CheckNoError(err)
 		t.CheckDeepEqual(1, len(builder.built))
@@ -274,6 +283,103 @@ func TestCacheBuildRemote(t *testing.T) {
 	})
 }
 
+func TestCacheBuildRemoteShared(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("dep1", "content1").
+			Write("dep2", "content2").
+			Write("dep3", "content3").
+			Chdir()
+
+		tags := map[string]string{
+			"artifact1": "artifact1:tag1",
+			"artifact2": "artifact2:tag2",
+		}
+		artifacts := []*latest.Artifact{
+			{ImageName: "artifact1", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+			{ImageName: "artifact2", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}},
+		}
+		deps := depLister(map[string][]string{
+			"artifact1": {"dep1", "dep2"},
+			"artifact2": {"dep3"},
+		})
+
+		// Mock Docker
+		dockerDaemon := fakeLocalDaemon(&testutil.FakeAPIClient{})
+		t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+			return dockerDaemon, nil
+		})
+		t.Override(&docker.DefaultAuthHelper, stubAuth{})
+		t.Override(&docker.RemoteDigest, func(ref string, _ docker.Config) (string, error) {
+			switch ref {
+			case "artifact1:tag1":
+				return "sha256:51ae7fa00c92525c319404a3a6d400e52ff9372c5a39cb415e0486fe425f3165", nil
+			case "artifact2:tag2":
+				return "sha256:35bdf2619f59e6f2372a92cb5486f4a0bf9b86e0e89ee0672864db6ed9c51539", nil
+			default:
+				return "", errors.New("unknown remote tag")
+			}
+		})
+
+		// Mock args builder
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+			return args, nil
+		})
+
+		// Shared remote cache
+		objectStore := newFakeObjectStore()
+		server := httptest.NewServer(objectStore)
+		defer server.Close()
+
+		// First machine: Need to build both artifacts
+		cfg := &mockConfig{
+			pipeline:  latest.Pipeline{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}},
+			cacheFile: tmpDir.Path("cache1"),
+			cacheURL:  server.URL,
+		}
+		artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return false, nil }, NewArtifactHasher(cfg, deps, build.ToArtifactGraph(artifacts)), make(mockArtifactStore))
+		t.CheckNoError(err)
+
+		builder := &mockBuilder{dockerDaemon: dockerDaemon, push: true}
+		bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(builder.built))
+		t.CheckDeepEqual(2, len(bRes))
+
+		// Second machine: with an empty local cache, both artifacts are found through the shared cache
+		cfg = &mockConfig{
+			pipeline:  latest.Pipeline{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}},
+			cacheFile: tmpDir.Path("cache2"),
+			cacheURL:  server.URL,
+		}
+		artifactCache, err = NewCache(cfg, func(imageName string) (bool, error) { return false, nil }, NewArtifactHasher(cfg, deps, build.ToArtifactGraph(artifacts)), make(mockArtifactStore))
+		t.CheckNoError(err)
+
+		builder = &mockBuilder{dockerDaemon: dockerDaemon, push: true}
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
+
+		t.CheckNoError(err)
+		t.CheckEmpty(builder.built)
+		t.CheckDeepEqual(2, len(bRes))
+		t.CheckDeepEqual("artifact1", bRes[0].ImageName)
+		t.CheckDeepEqual("artifact2", bRes[1].ImageName)
+
+		// Cache hits are not uploaded again: only the two entries of the first build were.
+		bRes, err = artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
+
+		t.CheckNoError(err)
+		t.CheckEmpty(builder.built)
+		t.CheckDeepEqual(2, len(bRes))
+		t.CheckDeepEqual(2, objectStore.puts)
+
+		// The entries found in the shared cache were recorded in the local cache file.
+		local, err := newFileStore(tmpDir.Path("cache2"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(local.entries))
+	})
+}
+
 func TestCacheFindMissing(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().
@@ -322,12 +428,12 @@ func TestCacheFindMissing(t *testing.T) {
 			pipeline:  latest.Pipeline{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{TryImportMissing: true}}}},
 			cacheFile: tmpDir.Path("cache"),
 		}
-		artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return false, nil }, deps, build.ToArtifactGraph(artifacts), make(mockArtifactStore))
+		artifactCache, err := NewCache(cfg, func(imageName string) (bool, error) { return false, nil }, NewArtifactHasher(cfg, deps, build.ToArtifactGraph(artifacts)), make(mockArtifactStore))
 		t.CheckNoError(err)
 
 		// Because the artifacts are in the docker registry, we expect them to be imported correctly.
 		builder := &mockBuilder{dockerDaemon: dockerDaemon, push: false, store: make(mockArtifactStore)}
-		bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, tags, artifacts, builder.Build)
+		bRes, err := artifactCache.Build(context.Background(), ioutil.Discard, tags, nil, artifacts, builder.Build)
 
 		t.CheckNoError(err)
 		t.CheckDeepEqual(0, len(builder.built))
@@ -341,11 +447,13 @@ func TestCacheFindMissing(t *testing.T) {
 type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	cacheFile             string
+	cacheURL              string
 	mode                  config.RunMode
 	pipeline              latest.Pipeline
 }
 
 func (c *mockConfig) CacheArtifacts() bool                            { return true }
 func (c *mockConfig) CacheFile() string                               { return c.cacheFile }
+func (c *mockConfig) CacheURL() string                                { return c.cacheURL }
 func (c *mockConfig) Mode() config.RunMode                            { return c.mode }
 func (c *mockConfig) PipelineForImage(string) (latest.Pipeline, bool) { return c.pipeline, true }
@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// Store is a backend that persists artifact cache entries across Skaffold runs.
+type Store interface {
+	// Get returns the details recorded for the given artifact hash, if any.
+	Get(ctx context.Context, hash string) (ImageDetails, bool, error)
+
+	// Put records the details for each artifact hash in the given entries.
+	Put(ctx context.Context, entries ArtifactCache) error
+}
+
+// newStore returns the store backing the artifact cache: the local cache file,
+// optionally backed by a shared remote cache.
+func newStore(cfg Config) (Store, error) {
+	cacheFile, err := resolveCacheFile(cfg.CacheFile())
+	if err != nil {
+		return nil, fmt.Errorf("resolving cache file: %w", err)
+	}
+
+	local, err := newFileStore(cacheFile)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving artifact cache: %w", err)
+	}
+
+	if cfg.CacheURL() == "" {
+		return local, nil
+	}
+
+	remote, err := newHTTPStore(cfg.CacheURL())
+	if err != nil {
+		return nil, fmt.Errorf("creating remote artifact cache: %w", err)
+	}
+	return newTieredStore(local, remote), nil
+}
+
+// fileStore keeps the artifact cache in a local yaml file.
+type fileStore struct {
+	file    string
+	entries ArtifactCache
+	mutex   sync.RWMutex
+}
+
+func newFileStore(file string) (*fileStore, error) {
+	entries, err := retrieveArtifactCache(file)
+	if err != nil {
+		return nil, err
+	}
+	return &fileStore{file: file, entries: entries}, nil
+}
+
+func (s *fileStore) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	entry, found := s.entries[hash]
+	return entry, found, nil
+}
+
+// Entries returns a copy of all the entries in the cache file.
+func (s *fileStore) Entries() ArtifactCache {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	entries := ArtifactCache{}
+	for hash, entry := range s.entries {
+		entries[hash] = entry
+	}
+	return entries
+}
+
+func (s *fileStore) Put(_ context.Context, entries ArtifactCache) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for hash, entry := range entries {
+		s.entries[hash] = entry
+	}
+	return saveArtifactCache(s.file, s.entries)
+}
+
+// entryLister is implemented by stores that can list all their entries.
+type entryLister interface {
+	Entries() ArtifactCache
+}
+
+// tieredStore looks up entries in each store in order and records entries in all of them.
+// Entries found in a store are copied to the stores that come before it.
+// On the first Put, entries listed by a store are also copied to the stores that come after it
+// when they are missing there, so that a new remote cache is seeded from an existing local cache.
+type tieredStore struct {
+	stores []Store
+	synced sync.Once
+}
+
+func newTieredStore(stores ...Store) *tieredStore {
+	return &tieredStore{stores: stores}
+}
+
+func (s *tieredStore) Get(ctx context.Context, hash string) (ImageDetails, bool, error) {
+	for i, store := range s.stores {
+		entry, found, err := store.Get(ctx, hash)
+		if err != nil {
+			logrus.Debugf("Could not lookup artifact cache entry %s: %v", hash, err)
+			continue
+		}
+		if !found {
+			continue
+		}
+		for _, previous := range s.stores[:i] {
+			if err := previous.Put(ctx, ArtifactCache{hash: entry}); err != nil {
+				logrus.Debugf("Could not record artifact cache entry %s: %v", hash, err)
+			}
+		}
+		return entry, true, nil
+	}
+	return ImageDetails{}, false, nil
+}
+
+func (s *tieredStore) Put(ctx context.Context, entries ArtifactCache) error {
+	s.synced.Do(func() { s.sync(ctx) })
+
+	var firstErr error
+	for _, store := range s.stores {
+		if err := store.Put(ctx, entries); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// sync copies the entries of each store that can list them to the following stores that don't have them yet.
+func (s *tieredStore) sync(ctx context.Context) {
+	for i, store := range s.stores {
+		lister, ok := store.(entryLister)
+		if !ok {
+			continue
+		}
+
+		for _, next := range s.stores[i+1:] {
+			missing := ArtifactCache{}
+			for hash, entry := range lister.Entries() {
+				// Entries without a digest refer to images in a local Docker daemon and are never shared.
+				if entry.Digest == "" {
+					continue
+				}
+				if _, found, err := next.Get(ctx, hash); err == nil && !found {
+					missing[hash] = entry
+				}
+			}
+			if err := next.Put(ctx, missing); err != nil {
+				logrus.Debugf("Could not copy artifact cache entries: %v", err)
+			}
+		}
+	}
+}
+
+// resolveCacheFile makes sure that either a passed in cache file or the default cache file exists
+func resolveCacheFile(cacheFile string) (string, error) {
+	if cacheFile != "" {
+		return cacheFile, util.VerifyOrCreateFile(cacheFile)
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("retrieving home directory: %w", err)
+	}
+	defaultFile := filepath.Join(home, constants.DefaultSkaffoldDir, constants.DefaultCacheFile)
+	return defaultFile, util.VerifyOrCreateFile(defaultFile)
+}
+
+func retrieveArtifactCache(cacheFile string) (ArtifactCache, error) {
+	cache := ArtifactCache{}
+	contents, err := ioutil.ReadFile(cacheFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(contents, &cache); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+func saveArtifactCache(cacheFile string, contents ArtifactCache) error {
+	data, err := yaml.Marshal(contents)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(cacheFile, data, 0755)
+}
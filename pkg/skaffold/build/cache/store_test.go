@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// fakeObjectStore is a local stand-in for an HTTP object store.
+type fakeObjectStore struct {
+	mutex   sync.Mutex
+	objects map[string][]byte
+	puts    int
+}
+
+func newFakeObjectStore() *fakeObjectStore {
+	return &fakeObjectStore{objects: map[string][]byte{}}
+}
+
+func (s *fakeObjectStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodGet:
+		object, found := s.objects[key]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(object)
+	case http.MethodPut:
+		object, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.objects[key] = object
+		s.puts++
+		w.WriteHeader(http.StatusCreated)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		file := t.NewTempDir().Write("cache", "hash1:\n  digest: digest1\n").Path("cache")
+
+		store, err := newFileStore(file)
+		t.CheckNoError(err)
+
+		entry, found, err := store.Get(context.Background(), "hash1")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "digest1"}, entry)
+
+		_, found, err = store.Get(context.Background(), "hash2")
+		t.CheckNoError(err)
+		t.CheckFalse(found)
+
+		err = store.Put(context.Background(), ArtifactCache{"hash2": {ID: "id2"}})
+		t.CheckNoError(err)
+
+		saved, err := retrieveArtifactCache(file)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(ArtifactCache{"hash1": {Digest: "digest1"}, "hash2": {ID: "id2"}}, saved)
+	})
+}
+
+func TestHTTPStore(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		server := httptest.NewServer(newFakeObjectStore())
+		defer server.Close()
+
+		store, err := newHTTPStore(server.URL + "/")
+		t.CheckNoError(err)
+
+		_, found, err := store.Get(context.Background(), "hash1")
+		t.CheckNoError(err)
+		t.CheckFalse(found)
+
+		err = store.Put(context.Background(), ArtifactCache{
+			"hash1": {Digest: "digest1", ID: "id1"},
+			"hash2": {ID: "id2"},
+		})
+		t.CheckNoError(err)
+
+		// Image IDs are local to a Docker daemon and never shared.
+		entry, found, err := store.Get(context.Background(), "hash1")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "digest1"}, entry)
+
+		_, found, err = store.Get(context.Background(), "hash2")
+		t.CheckNoError(err)
+		t.CheckFalse(found)
+	})
+}
+
+func TestHTTPStoreErrors(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		store, err := newHTTPStore(server.URL)
+		t.CheckNoError(err)
+
+		_, _, err = store.Get(context.Background(), "hash")
+		t.CheckErrorContains("http 403", err)
+
+		err = store.Put(context.Background(), ArtifactCache{"hash": {Digest: "digest"}})
+		t.CheckErrorContains("http 403", err)
+	})
+}
+
+func TestNewHTTPStore(t *testing.T) {
+	tests := []struct {
+		description string
+		url         string
+		shouldErr   bool
+	}{
+		{
+			description: "http",
+			url:         "http://cache.example.com/skaffold",
+		},
+		{
+			description: "https",
+			url:         "https://storage.googleapis.com/bucket/skaffold",
+		},
+		{
+			description: "unsupported scheme",
+			url:         "gs://bucket/skaffold",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			_, err := newHTTPStore(test.url)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestTieredStore(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		server := httptest.NewServer(newFakeObjectStore())
+		defer server.Close()
+
+		local, err := newFileStore(t.NewTempDir().Touch("cache").Path("cache"))
+		t.CheckNoError(err)
+		remote, err := newHTTPStore(server.URL)
+		t.CheckNoError(err)
+		t.CheckNoError(remote.Put(context.Background(), ArtifactCache{"remote": {Digest: "remoteDigest"}}))
+		store := newTieredStore(local, remote)
+
+		err = store.Put(context.Background(), ArtifactCache{"hash": {Digest: "digest", ID: "id"}})
+		t.CheckNoError(err)
+
+		entry, found, err := store.Get(context.Background(), "hash")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "digest", ID: "id"}, entry)
+
+		entry, found, err = store.Get(context.Background(), "remote")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "remoteDigest"}, entry)
+
+		// Entries found in the remote store are copied to the local store.
+		entry, found, err = local.Get(context.Background(), "remote")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "remoteDigest"}, entry)
+	})
+}
+
+func TestTieredStoreSeedsRemote(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		objectStore := newFakeObjectStore()
+		server := httptest.NewServer(objectStore)
+		defer server.Close()
+
+		file := t.NewTempDir().Write("cache", "local:\n  digest: localDigest\nshared:\n  digest: sharedDigest\nid:\n  id: localID\n").Path("cache")
+		local, err := newFileStore(file)
+		t.CheckNoError(err)
+		remote, err := newHTTPStore(server.URL)
+		t.CheckNoError(err)
+		t.CheckNoError(remote.Put(context.Background(), ArtifactCache{"shared": {Digest: "sharedDigest"}}))
+		store := newTieredStore(local, remote)
+
+		// The first sync uploads the entries that are only in the local cache file.
+		err = store.Put(context.Background(), ArtifactCache{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, objectStore.puts)
+
+		entry, found, err := remote.Get(context.Background(), "local")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "localDigest"}, entry)
+
+		_, found, err = remote.Get(context.Background(), "id")
+		t.CheckNoError(err)
+		t.CheckFalse(found)
+
+		// Later syncs only upload the new entries.
+		err = store.Put(context.Background(), ArtifactCache{"new": {Digest: "newDigest"}})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(3, objectStore.puts)
+	})
+}
@@ -28,11 +28,22 @@ import (
 type BuildAndTestFn func(context.Context, io.Writer, tag.ImageTags, []*latest.Artifact) ([]build.Artifact, error)
 
 type Cache interface {
-	Build(context.Context, io.Writer, tag.ImageTags, []*latest.Artifact, BuildAndTestFn) ([]build.Artifact, error)
+	Build(context.Context, io.Writer, tag.ImageTags, tag.AdditionalTags, []*latest.Artifact, BuildAndTestFn) ([]build.Artifact, error)
 }
 
-type noCache struct{}
+// noCache builds all the artifacts. It only uses the cache to apply the additional tags.
+type noCache struct {
+	c *cache
+}
+
+func (n *noCache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, additionalTags tag.AdditionalTags, artifacts []*latest.Artifact, buildAndTest BuildAndTestFn) ([]build.Artifact, error) {
+	bRes, err := buildAndTest(ctx, out, tags, artifacts)
+	if err != nil {
+		return nil, err
+	}
 
-func (n *noCache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, buildAndTest BuildAndTestFn) ([]build.Artifact, error) {
-	return buildAndTest(ctx, out, tags, artifacts)
+	if err := n.c.applyAdditionalTags(ctx, out, bRes, additionalTags); err != nil {
+		return nil, err
+	}
+	return bRes, nil
 }
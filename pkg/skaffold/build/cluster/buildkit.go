@@ -0,0 +1,426 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	homedir "github.com/mitchellh/go-homedir"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	typedAppsV1 "k8s.io/client-go/kubernetes/typed/apps/v1"
+	typedV1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildkit"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+var buildkitdLabels = map[string]string{"skaffold-buildkitd": "skaffold-buildkitd"}
+
+// buildkitdCAAnnotation records the certificate authority the BuildKit daemon trusts, so that the daemon
+// is restarted when the certificates change.
+const buildkitdCAAnnotation = "skaffold.dev/buildkitd-ca"
+
+// setupBuildKitDaemon makes sure that the long-lived BuildKit daemon is running in the build namespace.
+// It is left running after the build so that later builds share its layer cache.
+// The daemon only accepts connections from clients with a certificate of the `buildkitd-certs` Secret.
+func (b *Builder) setupBuildKitDaemon(ctx context.Context, out io.Writer) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+
+	color.Default.Fprintf(out, "Checking for BuildKit daemon [%s/%s]...\n", b.Namespace, buildkit.DefaultDaemonName)
+	certs, err := b.setupBuildKitCerts(ctx, client.CoreV1().Secrets(b.Namespace))
+	if err != nil {
+		return err
+	}
+
+	if err := b.setupBuildKitDeployment(ctx, out, client.AppsV1().Deployments(b.Namespace), caHash(certs)); err != nil {
+		return err
+	}
+
+	services := client.CoreV1().Services(b.Namespace)
+	if _, err := services.Get(ctx, buildkit.DefaultDaemonName, metav1.GetOptions{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("checking for BuildKit service: %w", err)
+		}
+		if _, err := services.Create(ctx, b.buildkitdService(), metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating BuildKit service: %w", err)
+		}
+	}
+
+	return kubernetes.WaitForDeploymentToStabilize(ctx, client, b.Namespace, buildkit.DefaultDaemonName, b.timeout)
+}
+
+// setupBuildKitCerts returns the TLS certificates of the BuildKit daemon and its clients,
+// after creating them if they don't exist yet.
+func (b *Builder) setupBuildKitCerts(ctx context.Context, secrets typedV1.SecretInterface) (map[string][]byte, error) {
+	secret, err := secrets.Get(ctx, buildkit.DefaultCertsSecretName, metav1.GetOptions{})
+	if err == nil {
+		return secret.Data, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("checking for BuildKit certificates: %w", err)
+	}
+
+	certs, err := buildkit.GenerateCerts(b.buildkitdHosts())
+	if err != nil {
+		return nil, fmt.Errorf("generating BuildKit certificates: %w", err)
+	}
+	secret = &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      buildkit.DefaultCertsSecretName,
+			Namespace: b.Namespace,
+			Labels:    buildkitdLabels,
+		},
+		Data: certs,
+	}
+	if _, err := secrets.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("creating BuildKit certificates: %w", err)
+		}
+		// Another build created the certificates in the meantime.
+		if secret, err = secrets.Get(ctx, buildkit.DefaultCertsSecretName, metav1.GetOptions{}); err != nil {
+			return nil, fmt.Errorf("checking for BuildKit certificates: %w", err)
+		}
+		return secret.Data, nil
+	}
+	return certs, nil
+}
+
+// setupBuildKitDeployment creates the BuildKit daemon, or updates it when it doesn't trust the current certificates.
+func (b *Builder) setupBuildKitDeployment(ctx context.Context, out io.Writer, deployments typedAppsV1.DeploymentInterface, caHash string) error {
+	deployment, err := deployments.Get(ctx, buildkit.DefaultDaemonName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		color.Default.Fprintf(out, "Creating BuildKit daemon [%s/%s]...\n", b.Namespace, buildkit.DefaultDaemonName)
+		if _, err := deployments.Create(ctx, b.buildkitdDeployment(caHash), metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating BuildKit daemon: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("checking for BuildKit daemon: %w", err)
+	case deployment.Spec.Template.Annotations[buildkitdCAAnnotation] != caHash:
+		color.Default.Fprintf(out, "Updating BuildKit daemon [%s/%s]...\n", b.Namespace, buildkit.DefaultDaemonName)
+		updated := b.buildkitdDeployment(caHash)
+		updated.ResourceVersion = deployment.ResourceVersion
+		if _, err := deployments.Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("updating BuildKit daemon: %w", err)
+		}
+	}
+	return nil
+}
+
+// buildkitdHosts returns the host names the BuildKit daemon is reached with, through its Service.
+func (b *Builder) buildkitdHosts() []string {
+	name := buildkit.DefaultDaemonName
+	return []string{
+		name,
+		fmt.Sprintf("%s.%s", name, b.Namespace),
+		fmt.Sprintf("%s.%s.svc", name, b.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", name, b.Namespace),
+	}
+}
+
+func caHash(certs map[string][]byte) string {
+	h := sha256.Sum256(certs[buildkit.CACertFile])
+	return hex.EncodeToString(h[:])
+}
+
+func (b *Builder) buildkitdDeployment(caHash string) *appsv1.Deployment {
+	replicas := int32(1)
+	privileged := true
+
+	annotations := map[string]string{buildkitdCAAnnotation: caHash}
+	for k, v := range b.ClusterDetails.Annotations {
+		annotations[k] = v
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      buildkit.DefaultDaemonName,
+			Namespace: b.Namespace,
+			Labels:    buildkitdLabels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: buildkitdLabels},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: annotations,
+					Labels:      buildkitdLabels,
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{
+						Name:  buildkit.DefaultDaemonName,
+						Image: b.BuildKit.Image,
+						Args: []string{
+							"--addr", "unix:///run/buildkit/buildkitd.sock",
+							"--addr", fmt.Sprintf("tcp://0.0.0.0:%d", buildkit.DefaultDaemonPort),
+							"--tlscacert", path.Join(buildkit.DefaultCertsDirMountPath, buildkit.CACertFile),
+							"--tlscert", path.Join(buildkit.DefaultCertsDirMountPath, buildkit.ServerCertFile),
+							"--tlskey", path.Join(buildkit.DefaultCertsDirMountPath, buildkit.ServerKeyFile),
+						},
+						Ports: []v1.ContainerPort{{ContainerPort: buildkit.DefaultDaemonPort}},
+						ReadinessProbe: &v1.Probe{
+							Handler: v1.Handler{
+								Exec: &v1.ExecAction{Command: []string{"buildctl", "debug", "workers"}},
+							},
+						},
+						SecurityContext: &v1.SecurityContext{Privileged: &privileged},
+						VolumeMounts: []v1.VolumeMount{{
+							Name:      buildkit.DefaultDaemonName,
+							MountPath: buildkit.DefaultCacheDirMountPath,
+						}, {
+							Name:      buildkit.DefaultCertsDirName,
+							MountPath: buildkit.DefaultCertsDirMountPath,
+							ReadOnly:  true,
+						}},
+						Resources: resourceRequirements(b.ClusterDetails.Resources),
+					}},
+					Tolerations: b.ClusterDetails.Tolerations,
+					Volumes: []v1.Volume{{
+						Name:         buildkit.DefaultDaemonName,
+						VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+					}, certsVolume(buildkit.CACertFile, buildkit.ServerCertFile, buildkit.ServerKeyFile)},
+				},
+			},
+		},
+	}
+}
+
+// certsVolume mounts the given files of the BuildKit certificates Secret.
+func certsVolume(files ...string) v1.Volume {
+	var items []v1.KeyToPath
+	for _, f := range files {
+		items = append(items, v1.KeyToPath{Key: f, Path: f})
+	}
+	return v1.Volume{
+		Name: buildkit.DefaultCertsDirName,
+		VolumeSource: v1.VolumeSource{
+			Secret: &v1.SecretVolumeSource{
+				SecretName: buildkit.DefaultCertsSecretName,
+				Items:      items,
+			},
+		},
+	}
+}
+
+func (b *Builder) buildkitdService() *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      buildkit.DefaultDaemonName,
+			Namespace: b.Namespace,
+			Labels:    buildkitdLabels,
+		},
+		Spec: v1.ServiceSpec{
+			Selector: buildkitdLabels,
+			Ports: []v1.ServicePort{{
+				Port:       buildkit.DefaultDaemonPort,
+				TargetPort: intstr.FromInt(buildkit.DefaultDaemonPort),
+			}},
+		},
+	}
+}
+
+// buildWithBuildKit builds a docker artifact with the BuildKit daemon, from a pod running `buildctl`.
+func (b *Builder) buildWithBuildKit(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, requiredImages map[string]*string) (string, error) {
+	artifact := *a.DockerArtifact
+	buildArgs, err := docker.EvalBuildArgs(b.cfg.Mode(), a.Workspace, artifact.DockerfilePath, artifact.BuildArgs, requiredImages)
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	artifact.BuildArgs = buildArgs
+
+	outputDirs, err := docker.ResolveOutputDependencies(a.OutputDependencies, b.outputs)
+	if err != nil {
+		return "", err
+	}
+
+	podSpec, err := b.buildkitPodSpec(&artifact, tag, a.Platforms)
+	if err != nil {
+		return "", err
+	}
+
+	if err := b.runBuildPod(ctx, out, podSpec, func(podName string) error {
+		if err := b.copyBuildKitSecrets(ctx, &artifact, podName); err != nil {
+			return fmt.Errorf("copying secrets: %w", err)
+		}
+		if err := b.copyBuildContext(ctx, a.Workspace, a.ImageName, artifact.DockerfilePath, artifact.BuildArgs, outputDirs, podName); err != nil {
+			return fmt.Errorf("copying sources: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	return docker.RemoteDigest(tag, b.cfg)
+}
+
+// copyBuildKitSecrets uploads the local secret and SSH keys used by the build to the init container.
+func (b *Builder) copyBuildKitSecrets(ctx context.Context, artifact *latest.DockerArtifact, podName string) error {
+	if artifact.Secret != nil {
+		if artifact.Secret.Source == "" {
+			return fmt.Errorf("secret %q has no 'src', which is required to build in the cluster", artifact.Secret.ID)
+		}
+		if err := b.copyFileToPod(ctx, artifact.Secret.Source, buildkit.SecretPath(buildkit.DefaultSecretsDirMountPath, artifact.Secret.ID), podName); err != nil {
+			return err
+		}
+	}
+
+	if artifact.SSH != "" {
+		keys, err := buildkit.SSHKeys(artifact.SSH, buildkit.DefaultSecretsDirMountPath)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if err := b.copyFileToPod(ctx, k.Local, k.Remote, podName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *Builder) copyFileToPod(ctx context.Context, local, remote, podName string) error {
+	path, err := homedir.Expand(local)
+	if err != nil {
+		return fmt.Errorf("unable to expand %s: %w", local, err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := b.copyToPod(ctx, f, remote, podName); err != nil {
+		return fmt.Errorf("uploading %s: %w", local, err)
+	}
+	return nil
+}
+
+// copyToPod writes the content of `r` to a file in the init container of the build pod.
+func (b *Builder) copyToPod(ctx context.Context, r io.Reader, remote, podName string) error {
+	var out bytes.Buffer
+	if err := b.kubectlcli.Run(ctx, r, &out, "exec", "-i", podName, "-c", initContainer, "-n", b.Namespace, "--", "sh", "-c", fmt.Sprintf("mkdir -p %s && cat > %s", path.Dir(remote), remote)); err != nil {
+		return errors.New(out.String())
+	}
+	return nil
+}
+
+func (b *Builder) buildkitPodSpec(artifact *latest.DockerArtifact, tag string, platforms []string) (*v1.Pod, error) {
+	addr := fmt.Sprintf("tcp://%s.%s:%d", buildkit.DefaultDaemonName, b.Namespace, buildkit.DefaultDaemonPort)
+	args, err := buildkit.Args(artifact, tag, addr, buildkit.DefaultCertsDirMountPath, kaniko.DefaultEmptyDirMountPath, buildkit.DefaultSecretsDirMountPath, platforms, isInsecure(tag, b.cfg.GetInsecureRegistries()))
+	if err != nil {
+		return nil, fmt.Errorf("building args list: %w", err)
+	}
+
+	vms := []v1.VolumeMount{{
+		Name:      kaniko.DefaultEmptyDirName,
+		MountPath: kaniko.DefaultEmptyDirMountPath,
+	}, {
+		Name:      buildkit.DefaultSecretsDirName,
+		MountPath: buildkit.DefaultSecretsDirMountPath,
+	}}
+
+	// Only `buildctl` gets the client certificates.
+	buildctlVms := append([]v1.VolumeMount{{
+		Name:      buildkit.DefaultCertsDirName,
+		MountPath: buildkit.DefaultCertsDirMountPath,
+		ReadOnly:  true,
+	}}, vms...)
+
+	env := b.env(nil, b.ClusterDetails.HTTPProxy, b.ClusterDetails.HTTPSProxy)
+	if b.ClusterDetails.DockerConfig != nil {
+		env = append(env, v1.EnvVar{Name: "DOCKER_CONFIG", Value: kaniko.DefaultDockerConfigPath})
+	}
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations:  b.ClusterDetails.Annotations,
+			GenerateName: "buildkit-",
+			Labels:       map[string]string{"skaffold-buildkit": "skaffold-buildkit"},
+			Namespace:    b.ClusterDetails.Namespace,
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{
+				Name:            initContainer,
+				Image:           constants.DefaultBusyboxImage,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", "while [ ! -f /tmp/complete ]; do sleep 1; done"},
+				VolumeMounts:    vms,
+				Resources:       resourceRequirements(b.ClusterDetails.Resources),
+			}},
+			Containers: []v1.Container{{
+				Name:            buildkit.DefaultContainerName,
+				Image:           b.BuildKit.Image,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"buildctl"},
+				Args:            args,
+				Env:             env,
+				VolumeMounts:    buildctlVms,
+				Resources:       resourceRequirements(b.ClusterDetails.Resources),
+			}},
+			RestartPolicy: v1.RestartPolicyNever,
+			Volumes: []v1.Volume{{
+				Name:         kaniko.DefaultEmptyDirName,
+				VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+			}, {
+				Name:         buildkit.DefaultSecretsDirName,
+				VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{Medium: v1.StorageMediumMemory}},
+			}, certsVolume(buildkit.CACertFile, buildkit.ClientCertFile, buildkit.ClientKeyFile)},
+		},
+	}
+
+	// Add secret for pull secret
+	if b.ClusterDetails.PullSecretName != "" {
+		addSecretVolume(pod, kaniko.DefaultSecretName, b.ClusterDetails.PullSecretMountPath, b.ClusterDetails.PullSecretName)
+	}
+
+	if b.ClusterDetails.DockerConfig != nil {
+		// Add secret for docker config if specified
+		addSecretVolume(pod, kaniko.DefaultDockerConfigSecretName, kaniko.DefaultDockerConfigPath, b.ClusterDetails.DockerConfig.SecretName)
+	}
+
+	b.addPodSettings(pod)
+	return pod, nil
+}
+
+func isInsecure(tag string, insecureRegistries map[string]bool) bool {
+	ref, err := docker.ParseReference(tag)
+	if err != nil {
+		return false
+	}
+	return insecureRegistries[ref.Domain]
+}
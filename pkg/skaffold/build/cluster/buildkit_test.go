@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildkit"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildKitPodSpec(t *testing.T) {
+	builder := &Builder{
+		cfg: &mockConfig{insecureRegistries: map[string]bool{"localhost:5000": true}},
+		ClusterDetails: &latest.ClusterDetails{
+			Namespace:           "ns",
+			PullSecretName:      "kaniko-secret",
+			PullSecretPath:      "kaniko-secret.json",
+			PullSecretMountPath: "/secret",
+			HTTPProxy:           "http://proxy",
+			ServiceAccountName:  "builder",
+			DockerConfig:        &latest.DockerConfig{SecretName: "docker-cfg"},
+			BuildKit:            &latest.BuildKitDetails{Image: "buildkit/image"},
+			Tolerations: []v1.Toleration{{
+				Key:      "app",
+				Operator: "Equal",
+				Value:    "skaffold",
+				Effect:   "NoSchedule",
+			}},
+		},
+	}
+
+	pod, err := builder.buildkitPodSpec(&latest.DockerArtifact{DockerfilePath: "Dockerfile"}, "localhost:5000/image:tag", []string{"linux/arm64"})
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, "ns", pod.Namespace)
+	testutil.CheckDeepEqual(t, "builder", pod.Spec.ServiceAccountName)
+	testutil.CheckDeepEqual(t, builder.ClusterDetails.Tolerations, pod.Spec.Tolerations)
+	testutil.CheckDeepEqual(t, v1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	testutil.CheckDeepEqual(t, initContainer, pod.Spec.InitContainers[0].Name)
+
+	container := pod.Spec.Containers[0]
+	testutil.CheckDeepEqual(t, buildkit.DefaultContainerName, container.Name)
+	testutil.CheckDeepEqual(t, "buildkit/image", container.Image)
+	testutil.CheckDeepEqual(t, []string{"buildctl"}, container.Command)
+	testutil.CheckDeepEqual(t, []string{
+		"--addr", "tcp://buildkitd.ns:1234",
+		"--tlscacert", "/buildkit/certs/ca.pem",
+		"--tlscert", "/buildkit/certs/client.pem",
+		"--tlskey", "/buildkit/certs/client-key.pem",
+		"build",
+		"--frontend", "dockerfile.v0",
+		"--local", "context=/kaniko/buildcontext",
+		"--local", "dockerfile=/kaniko/buildcontext",
+		"--opt", "filename=Dockerfile",
+		"--opt", "platform=linux/arm64",
+		"--output", "type=image,name=localhost:5000/image:tag,push=true,registry.insecure=true",
+	}, container.Args)
+	testutil.CheckDeepEqual(t, []v1.EnvVar{
+		{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/secret/kaniko-secret.json"},
+		{Name: "UPSTREAM_CLIENT_TYPE", Value: "UpstreamClient(skaffold-)"},
+		{Name: "HTTP_PROXY", Value: "http://proxy"},
+		{Name: "DOCKER_CONFIG", Value: kaniko.DefaultDockerConfigPath},
+	}, container.Env)
+
+	var volumes []string
+	for _, v := range pod.Spec.Volumes {
+		volumes = append(volumes, v.Name)
+	}
+	testutil.CheckDeepEqual(t, []string{kaniko.DefaultEmptyDirName, buildkit.DefaultSecretsDirName, buildkit.DefaultCertsDirName, kaniko.DefaultSecretName, kaniko.DefaultDockerConfigSecretName}, volumes)
+	testutil.CheckDeepEqual(t, v1.StorageMediumMemory, pod.Spec.Volumes[1].EmptyDir.Medium)
+
+	// Only the client certificates are mounted in the build pod.
+	testutil.CheckDeepEqual(t, []v1.KeyToPath{
+		{Key: "ca.pem", Path: "ca.pem"},
+		{Key: "client.pem", Path: "client.pem"},
+		{Key: "client-key.pem", Path: "client-key.pem"},
+	}, pod.Spec.Volumes[2].Secret.Items)
+	testutil.CheckDeepEqual(t, buildkit.DefaultCertsDirName, container.VolumeMounts[0].Name)
+	for _, vm := range pod.Spec.InitContainers[0].VolumeMounts {
+		testutil.CheckDeepEqual(t, false, vm.Name == buildkit.DefaultCertsDirName)
+	}
+}
+
+func TestBuildKitDaemon(t *testing.T) {
+	builder := &Builder{
+		ClusterDetails: &latest.ClusterDetails{
+			Namespace:   "ns",
+			Annotations: map[string]string{"key": "value"},
+			BuildKit:    &latest.BuildKitDetails{Image: "buildkit/image"},
+		},
+	}
+
+	deployment := builder.buildkitdDeployment("hash")
+	service := builder.buildkitdService()
+
+	testutil.CheckDeepEqual(t, "buildkitd", deployment.Name)
+	testutil.CheckDeepEqual(t, "ns", deployment.Namespace)
+	testutil.CheckDeepEqual(t, map[string]string{"key": "value", "skaffold.dev/buildkitd-ca": "hash"}, deployment.Spec.Template.Annotations)
+	testutil.CheckDeepEqual(t, map[string]string{"key": "value"}, builder.ClusterDetails.Annotations)
+	testutil.CheckDeepEqual(t, "buildkit/image", deployment.Spec.Template.Spec.Containers[0].Image)
+	testutil.CheckDeepEqual(t, true, *deployment.Spec.Template.Spec.Containers[0].SecurityContext.Privileged)
+	testutil.CheckDeepEqual(t, []string{
+		"--addr", "unix:///run/buildkit/buildkitd.sock",
+		"--addr", "tcp://0.0.0.0:1234",
+		"--tlscacert", "/buildkit/certs/ca.pem",
+		"--tlscert", "/buildkit/certs/server.pem",
+		"--tlskey", "/buildkit/certs/server-key.pem",
+	}, deployment.Spec.Template.Spec.Containers[0].Args)
+	testutil.CheckDeepEqual(t, []v1.KeyToPath{
+		{Key: "ca.pem", Path: "ca.pem"},
+		{Key: "server.pem", Path: "server.pem"},
+		{Key: "server-key.pem", Path: "server-key.pem"},
+	}, deployment.Spec.Template.Spec.Volumes[1].Secret.Items)
+	testutil.CheckDeepEqual(t, deployment.Spec.Template.Labels, service.Spec.Selector)
+	testutil.CheckDeepEqual(t, int32(1234), service.Spec.Ports[0].Port)
+}
+
+func TestSetupBuildKitCerts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		builder := &Builder{ClusterDetails: &latest.ClusterDetails{Namespace: "ns"}}
+		secrets := fake.NewSimpleClientset().CoreV1().Secrets("ns")
+
+		certs, err := builder.setupBuildKitCerts(context.Background(), secrets)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(5, len(certs))
+
+		secret, err := secrets.Get(context.Background(), "buildkitd-certs", metav1.GetOptions{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(certs, secret.Data)
+
+		// Existing certificates are reused.
+		again, err := builder.setupBuildKitCerts(context.Background(), secrets)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(certs, again)
+	})
+}
+
+func TestSetupBuildKitDeployment(t *testing.T) {
+	tests := []struct {
+		description     string
+		existingCA      string
+		expectedOutput  string
+		expectedActions []string
+	}{
+		{
+			description:     "create",
+			expectedOutput:  "Creating BuildKit daemon [ns/buildkitd]...\n",
+			expectedActions: []string{"get", "create"},
+		},
+		{
+			description:     "up to date",
+			existingCA:      "hash",
+			expectedActions: []string{"get"},
+		},
+		{
+			description:     "update daemon trusting other certificates",
+			existingCA:      "other",
+			expectedOutput:  "Updating BuildKit daemon [ns/buildkitd]...\n",
+			expectedActions: []string{"get", "update"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			builder := &Builder{ClusterDetails: &latest.ClusterDetails{
+				Namespace: "ns",
+				BuildKit:  &latest.BuildKitDetails{Image: "buildkit/image"},
+			}}
+			client := fake.NewSimpleClientset()
+			if test.existingCA != "" {
+				client = fake.NewSimpleClientset(builder.buildkitdDeployment(test.existingCA))
+			}
+
+			var out bytes.Buffer
+			err := builder.setupBuildKitDeployment(context.Background(), &out, client.AppsV1().Deployments("ns"), "hash")
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+
+			var actions []string
+			for _, a := range client.Actions() {
+				actions = append(actions, a.GetVerb())
+			}
+			t.CheckDeepEqual(test.expectedActions, actions)
+
+			deployment, err := client.AppsV1().Deployments("ns").Get(context.Background(), "buildkitd", metav1.GetOptions{})
+			t.CheckNoError(err)
+			t.CheckDeepEqual("hash", deployment.Spec.Template.Annotations["skaffold.dev/buildkitd-ca"])
+		})
+	}
+}
+
+func TestIsInsecure(t *testing.T) {
+	registries := map[string]bool{"localhost:5000": true}
+
+	testutil.CheckDeepEqual(t, true, isInsecure("localhost:5000/image:tag", registries))
+	testutil.CheckDeepEqual(t, false, isInsecure("gcr.io/project/image:tag", registries))
+	testutil.CheckDeepEqual(t, false, isInsecure("Invalid Tag", registries))
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildpacks"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+const (
+	buildpacksContainer = "buildpacks"
+	// lifecycleCreator runs all the phases of a buildpacks build. It's shipped with every builder image.
+	lifecycleCreator = "/cnb/lifecycle/creator"
+	layersDirName    = "buildpacks-layers"
+	layersDir        = "/layers"
+	platformDirName  = "buildpacks-platform"
+	platformDir      = "/platform"
+)
+
+// buildWithBuildpacks builds a buildpacks artifact by running the lifecycle of the builder image in the cluster,
+// which doesn't require a Docker daemon. The lifecycle pushes the image to the registry directly.
+func (b *Builder) buildWithBuildpacks(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, requiredImages map[string]*string) (string, error) {
+	env, err := buildpacks.GetEnv(a, b.cfg.Mode())
+	if err != nil {
+		return "", err
+	}
+
+	podSpec := b.buildpacksPodSpec(a.BuildpackArtifact, tag, requiredImages)
+
+	if err := b.runBuildPod(ctx, out, podSpec, func(podName string) error {
+		// Build time environment variables are passed to the buildpacks as files in `<platform>/env`.
+		for _, k := range sortedKeys(env) {
+			if err := b.copyToPod(ctx, strings.NewReader(env[k]), path.Join(platformDir, "env", k), podName); err != nil {
+				return fmt.Errorf("copying env variable %q: %w", k, err)
+			}
+		}
+		if err := b.copySources(ctx, a, podName); err != nil {
+			return fmt.Errorf("copying sources: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	return docker.RemoteDigest(tag, b.cfg)
+}
+
+func (b *Builder) buildpacksPodSpec(artifact *latest.BuildpackArtifact, tag string, requiredImages map[string]*string) *v1.Pod {
+	args := []string{
+		"-app=" + kaniko.DefaultEmptyDirMountPath,
+		"-layers=" + layersDir,
+		"-platform=" + platformDir,
+	}
+	if artifact.RunImage != "" {
+		args = append(args, "-run-image="+fromRequiredImages(artifact.RunImage, requiredImages))
+	}
+	args = append(args, tag)
+
+	pod := b.sourcesPodSpec(v1.Container{
+		Name:    buildpacksContainer,
+		Image:   fromRequiredImages(artifact.Builder, requiredImages),
+		Command: []string{lifecycleCreator},
+		Args:    args,
+	}, v1.VolumeMount{
+		Name:      layersDirName,
+		MountPath: layersDir,
+	}, v1.VolumeMount{
+		Name:      platformDirName,
+		MountPath: platformDir,
+	})
+
+	// The lifecycle runs as the non-root user of the builder image. It needs to write to the sources.
+	pod.Spec.InitContainers[0].Command = []string{"sh", "-c", fmt.Sprintf("while [ ! -f /tmp/complete ]; do sleep 1; done; chmod -R a+rwX %s %s", kaniko.DefaultEmptyDirMountPath, layersDir)}
+	return pod
+}
+
+// fromRequiredImages replaces the provided image name with the image built for a required artifact, if matched.
+func fromRequiredImages(imageName string, requiredImages map[string]*string) string {
+	if image, found := requiredImages[imageName]; found && image != nil {
+		return *image
+	}
+	return imageName
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
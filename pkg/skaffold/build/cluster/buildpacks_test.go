@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildpacksPodSpec(t *testing.T) {
+	builder := &Builder{
+		cfg: &mockConfig{},
+		ClusterDetails: &latest.ClusterDetails{
+			Namespace:    "ns",
+			DockerConfig: &latest.DockerConfig{SecretName: "docker-cfg"},
+		},
+	}
+	artifact := &latest.BuildpackArtifact{
+		Builder:  "BUILDER",
+		RunImage: "gcr.io/buildpacks/run",
+	}
+	requiredImages := map[string]*string{"BUILDER": util.StringPtr("gcr.io/project/builder:tag")}
+
+	pod := builder.buildpacksPodSpec(artifact, "img:tag", requiredImages)
+
+	testutil.CheckDeepEqual(t, "buildpacks-", pod.GenerateName)
+	container := pod.Spec.Containers[0]
+	testutil.CheckDeepEqual(t, "gcr.io/project/builder:tag", container.Image)
+	testutil.CheckDeepEqual(t, []string{"/cnb/lifecycle/creator"}, container.Command)
+	testutil.CheckDeepEqual(t, []string{"-app=/kaniko/buildcontext", "-layers=/layers", "-platform=/platform", "-run-image=gcr.io/buildpacks/run", "img:tag"}, container.Args)
+	testutil.CheckDeepEqual(t, v1.EnvVar{Name: "DOCKER_CONFIG", Value: "/kaniko/.docker"}, container.Env[len(container.Env)-1])
+
+	var mounts []string
+	for _, vm := range container.VolumeMounts {
+		mounts = append(mounts, vm.MountPath)
+	}
+	testutil.CheckDeepEqual(t, []string{"/kaniko/buildcontext", "/layers", "/platform", "/kaniko/.docker"}, mounts)
+
+	// The init container doesn't mount the docker config.
+	testutil.CheckDeepEqual(t, 3, len(pod.Spec.InitContainers[0].VolumeMounts))
+	testutil.CheckContains(t, "chmod -R a+rwX /kaniko/buildcontext /layers", pod.Spec.InitContainers[0].Command[2])
+}
+
+func TestFromRequiredImages(t *testing.T) {
+	requiredImages := map[string]*string{
+		"BUILDER": util.StringPtr("builder:tag"),
+		"MISSING": nil,
+	}
+
+	testutil.CheckDeepEqual(t, "builder:tag", fromRequiredImages("BUILDER", requiredImages))
+	testutil.CheckDeepEqual(t, "MISSING", fromRequiredImages("MISSING", requiredImages))
+	testutil.CheckDeepEqual(t, "gcr.io/buildpacks/builder", fromRequiredImages("gcr.io/buildpacks/builder", requiredImages))
+}
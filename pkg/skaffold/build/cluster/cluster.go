@@ -21,16 +21,22 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
-// Build builds a list of artifacts with Kaniko.
+// Build builds a list of artifacts with Kaniko, BuildKit, Jib or Cloud Native Buildpacks.
 func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Artifact) build.ArtifactBuilder {
 	builder := build.WithLogFile(b.buildArtifact, b.cfg.Muted())
 	return builder
@@ -50,6 +56,12 @@ func (b *Builder) PreBuild(ctx context.Context, out io.Writer) error {
 		}
 		b.teardownFunc = append(b.teardownFunc, teardownDockerConfigSecret)
 	}
+
+	if b.BuildKit != nil {
+		if err := b.setupBuildKitDaemon(ctx, out); err != nil {
+			return fmt.Errorf("setting up BuildKit daemon: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -79,10 +91,19 @@ func (b *Builder) runBuildForArtifact(ctx context.Context, out io.Writer, a *lat
 	requiredImages := docker.ResolveDependencyImages(a.Dependencies, b.artifactStore, true)
 	switch {
 	case a.KanikoArtifact != nil:
-		return b.buildWithKaniko(ctx, out, a.Workspace, a.ImageName, a.KanikoArtifact, tag, requiredImages)
+		return b.buildWithKanikoForPlatforms(ctx, out, a, tag, requiredImages)
+
+	case a.DockerArtifact != nil && b.BuildKit != nil:
+		return b.buildWithBuildKit(ctx, out, a, tag, requiredImages)
+
+	case a.JibArtifact != nil:
+		return b.buildWithJib(ctx, out, a, tag)
+
+	case a.BuildpackArtifact != nil:
+		return b.buildWithBuildpacks(ctx, out, a, tag, requiredImages)
 
 	case a.CustomArtifact != nil:
-		return custom.NewArtifactBuilder(nil, b.cfg, true, append(b.retrieveExtraEnv(), util.EnvPtrMapToSlice(requiredImages, "=")...)).Build(ctx, out, a, tag)
+		return custom.NewArtifactBuilder(nil, b.cfg, true, append(b.retrieveExtraEnv(), util.EnvPtrMapToSlice(requiredImages, "=")...), b.artifactStore).Build(ctx, out, a, tag)
 
 	default:
 		return "", fmt.Errorf("unexpected type %q for in-cluster artifact:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
@@ -101,3 +122,43 @@ func (b *Builder) retrieveExtraEnv() []string {
 	}
 	return env
 }
+
+// runBuildPod creates a build pod, calls `upload` once its init container is running,
+// and waits for the pod to succeed while streaming its logs. The pod is always deleted afterwards.
+func (b *Builder) runBuildPod(ctx context.Context, out io.Writer, podSpec *v1.Pod, upload func(podName string) error) error {
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	pods := client.CoreV1().Pods(b.Namespace)
+
+	pod, err := pods.Create(ctx, podSpec, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("creating %s pod: %w", podSpec.Spec.Containers[0].Name, err)
+	}
+	defer func() {
+		if err := pods.Delete(ctx, pod.Name, metav1.DeleteOptions{
+			GracePeriodSeconds: new(int64),
+		}); err != nil {
+			logrus.Fatalf("deleting pod: %s", err)
+		}
+	}()
+
+	if err := kubernetes.WaitForPodInitialized(ctx, pods, pod.Name); err != nil {
+		return fmt.Errorf("waiting for pod to initialize: %w", err)
+	}
+	if err := upload(pod.Name); err != nil {
+		return err
+	}
+
+	// Wait for the pods to succeed while streaming the logs
+	waitForLogs := streamLogs(ctx, out, pod.Name, pods)
+
+	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
+		waitForLogs()
+		return err
+	}
+
+	waitForLogs()
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+const jibContainer = "jib"
+
+// buildWithJib builds a jib artifact with Maven or Gradle, from a pod running in the cluster.
+// Jib pushes the image to the registry directly.
+func (b *Builder) buildWithJib(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
+	podSpec, err := b.jibPodSpec(a, tag)
+	if err != nil {
+		return "", err
+	}
+
+	if err := b.runBuildPod(ctx, out, podSpec, func(podName string) error {
+		if err := b.copySources(ctx, a, podName); err != nil {
+			return fmt.Errorf("copying sources: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	return docker.RemoteDigest(tag, b.cfg)
+}
+
+func (b *Builder) jibPodSpec(a *latest.Artifact, tag string) (*v1.Pod, error) {
+	t, err := jib.DeterminePluginType(a.Workspace, a.JibArtifact)
+	if err != nil {
+		return nil, err
+	}
+
+	container := v1.Container{
+		Name:       jibContainer,
+		WorkingDir: kaniko.DefaultEmptyDirMountPath,
+	}
+	switch t {
+	case jib.JibMaven:
+		container.Image = b.MavenImage
+		container.Command = []string{"mvn"}
+		container.Args = jib.GenerateMavenBuildArgs("build", tag, a.JibArtifact, b.cfg.SkipTests(), true, a.Dependencies, b.artifactStore, b.cfg.GetInsecureRegistries(), false)
+	case jib.JibGradle:
+		container.Image = b.GradleImage
+		container.Command = []string{"gradle"}
+		container.Args = jib.GenerateGradleBuildArgs("jib", tag, a.JibArtifact, b.cfg.SkipTests(), true, a.Dependencies, b.artifactStore, b.cfg.GetInsecureRegistries(), false)
+	default:
+		return nil, errors.New("skaffold can't determine Jib artifact type for in-cluster build")
+	}
+
+	return b.sourcesPodSpec(container), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestJibPodSpec(t *testing.T) {
+	tests := []struct {
+		description     string
+		pluginType      jib.PluginType
+		expectedImage   string
+		expectedCommand []string
+		expectedArgs    []string
+	}{
+		{
+			description:     "maven",
+			pluginType:      jib.JibMaven,
+			expectedImage:   "maven:3.6.0",
+			expectedCommand: []string{"mvn"},
+			expectedArgs:    []string{"--batch-mode", "jib:_skaffold-fail-if-jib-out-of-date", "-Djib.requiredVersion=" + jib.MinimumJibMavenVersion, "--non-recursive", "prepare-package", "jib:build", "-Dimage=img"},
+		},
+		{
+			description:     "gradle",
+			pluginType:      jib.JibGradle,
+			expectedImage:   "gradle:6.8",
+			expectedCommand: []string{"gradle"},
+			expectedArgs:    []string{"--console=plain", "_skaffoldFailIfJibOutOfDate", "-Djib.requiredVersion=" + jib.MinimumJibGradleVersion, ":jib", "--image=img"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			builder := &Builder{
+				cfg: &mockConfig{},
+				ClusterDetails: &latest.ClusterDetails{
+					Namespace:   "ns",
+					MavenImage:  "maven:3.6.0",
+					GradleImage: "gradle:6.8",
+				},
+			}
+			artifact := &latest.Artifact{
+				ArtifactType: latest.ArtifactType{
+					JibArtifact: &latest.JibArtifact{Type: string(test.pluginType)},
+				},
+			}
+
+			pod, err := builder.jibPodSpec(artifact, "img")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual("jib-", pod.GenerateName)
+			t.CheckDeepEqual(initContainer, pod.Spec.InitContainers[0].Name)
+			container := pod.Spec.Containers[0]
+			t.CheckDeepEqual(test.expectedImage, container.Image)
+			t.CheckDeepEqual(test.expectedCommand, container.Command)
+			t.CheckDeepEqual(test.expectedArgs, container.Args)
+			t.CheckDeepEqual(kaniko.DefaultEmptyDirMountPath, container.WorkingDir)
+			t.CheckDeepEqual(kaniko.DefaultEmptyDirMountPath, container.VolumeMounts[0].MountPath)
+		})
+	}
+}
+
+func TestJibPodSpecUnknownType(t *testing.T) {
+	builder := &Builder{cfg: &mockConfig{}, ClusterDetails: &latest.ClusterDetails{}}
+	artifact := &latest.Artifact{
+		Workspace: testutil.NewTempDir(t).Root(),
+		ArtifactType: latest.ArtifactType{
+			JibArtifact: &latest.JibArtifact{},
+		},
+	}
+
+	_, err := builder.jibPodSpec(artifact, "img")
+
+	testutil.CheckError(t, true, err)
+}
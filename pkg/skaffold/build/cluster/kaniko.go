@@ -22,22 +22,55 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
-	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 const initContainer = "kaniko-init-container"
 
-func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest.KanikoArtifact, tag string, requiredImages map[string]*string) (string, error) {
+// buildWithKanikoForPlatforms builds an artifact with one kaniko pod per target platform,
+// and assembles the resulting images in a manifest list when there are several platforms.
+func (b *Builder) buildWithKanikoForPlatforms(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, requiredImages map[string]*string) (string, error) {
+	outputDirs, err := docker.ResolveOutputDependencies(a.OutputDependencies, b.outputs)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(a.Platforms) {
+	case 0:
+		return b.buildWithKaniko(ctx, out, a.Workspace, a.ImageName, a.KanikoArtifact, tag, "", requiredImages, outputDirs)
+	case 1:
+		return b.buildWithKaniko(ctx, out, a.Workspace, a.ImageName, a.KanikoArtifact, tag, a.Platforms[0], requiredImages, outputDirs)
+	}
+
+	var images []docker.SinglePlatformImage
+	for _, p := range a.Platforms {
+		platform, err := docker.ParsePlatform(p)
+		if err != nil {
+			return "", err
+		}
+
+		// Each kaniko pod evaluates env and build args of its own copy of the artifact config.
+		artifact := *a.KanikoArtifact
+		platformTag := docker.PlatformTag(tag, platform)
+		digest, err := b.buildWithKaniko(ctx, out, a.Workspace, a.ImageName, &artifact, platformTag, p, requiredImages, outputDirs)
+		if err != nil {
+			return "", err
+		}
+
+		images = append(images, docker.SinglePlatformImage{Platform: platform, Image: platformTag + "@" + digest})
+	}
+
+	return docker.CreateManifestList(images, tag, b.cfg)
+}
+
+func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace string, artifactName string, artifact *latest.KanikoArtifact, tag string, platform string, requiredImages map[string]*string, outputDirs map[string]string) (string, error) {
 	generatedEnvs, err := generateEnvFromImage(tag)
 	if err != nil {
 		return "", fmt.Errorf("error processing generated env variables from image uri: %w", err)
@@ -54,60 +87,67 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 	}
 	artifact.BuildArgs = buildArgs
 
-	client, err := kubernetesclient.Client()
-	if err != nil {
-		return "", fmt.Errorf("getting Kubernetes client: %w", err)
-	}
-	pods := client.CoreV1().Pods(b.Namespace)
-
 	podSpec, err := b.kanikoPodSpec(artifact, tag)
 	if err != nil {
 		return "", err
 	}
-
-	pod, err := pods.Create(ctx, podSpec, metav1.CreateOptions{})
-	if err != nil {
-		return "", fmt.Errorf("creating kaniko pod: %w", err)
-	}
-	defer func() {
-		if err := pods.Delete(ctx, pod.Name, metav1.DeleteOptions{
-			GracePeriodSeconds: new(int64),
-		}); err != nil {
-			logrus.Fatalf("deleting pod: %s", err)
+	if platform != "" {
+		if err := setPlatform(podSpec, platform); err != nil {
+			return "", err
 		}
-	}()
+	}
 
-	if err := b.copyKanikoBuildContext(ctx, workspace, artifactName, artifact, pods, pod.Name); err != nil {
-		return "", fmt.Errorf("copying sources: %w", err)
+	if err := b.runBuildPod(ctx, out, podSpec, func(podName string) error {
+		if err := b.copyBuildContext(ctx, workspace, artifactName, artifact.DockerfilePath, artifact.BuildArgs, outputDirs, podName); err != nil {
+			return fmt.Errorf("copying sources: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return "", err
 	}
 
-	// Wait for the pods to succeed while streaming the logs
-	waitForLogs := streamLogs(ctx, out, pod.Name, pods)
+	return docker.RemoteDigest(tag, b.cfg)
+}
 
-	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
-		waitForLogs()
-		return "", err
+// copyBuildContext uploads the docker build context of an artifact, including the files of its file outputs,
+// to the init container of the build pod.
+func (b *Builder) copyBuildContext(ctx context.Context, workspace string, artifactName string, dockerfilePath string, buildArgs map[string]*string, outputDirs map[string]string, podName string) error {
+	return b.uploadBuildContext(ctx, podName, func(w io.Writer) error {
+		if err := docker.CreateDockerTarContext(ctx, w, docker.NewBuildConfig(workspace, artifactName, dockerfilePath, buildArgs).WithOutputDirs(outputDirs), b.cfg); err != nil {
+			return fmt.Errorf("creating docker context: %w", err)
+		}
+		return nil
+	})
+}
+
+// copySources uploads the source files of a jib or buildpacks artifact to the init container of the build pod.
+func (b *Builder) copySources(ctx context.Context, a *latest.Artifact, podName string) error {
+	dependencies, err := build.DependenciesForArtifact(ctx, a, b.cfg, b.artifactStore, nil)
+	if err != nil {
+		return fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
 	}
 
-	waitForLogs()
+	// Upload entire workspace for Jib projects to fix multi-module bug
+	if a.JibArtifact != nil {
+		if dependencies, err = jib.AddWorkspaceToDependencies(a.Workspace, dependencies); err != nil {
+			return fmt.Errorf("walking workspace for Jib projects: %w", err)
+		}
+	}
 
-	return docker.RemoteDigest(tag, b.cfg)
+	return b.uploadBuildContext(ctx, podName, func(w io.Writer) error {
+		return util.CreateTar(w, a.Workspace, dependencies)
+	})
 }
 
 // first copy over the buildcontext tarball into the init container tmp dir via kubectl cp
 // Via kubectl exec, we extract the tarball to the empty dir
 // Then, via kubectl exec, create the /tmp/complete file via kubectl exec to complete the init container
-func (b *Builder) copyKanikoBuildContext(ctx context.Context, workspace string, artifactName string, artifact *latest.KanikoArtifact, pods corev1.PodInterface, podName string) error {
-	if err := kubernetes.WaitForPodInitialized(ctx, pods, podName); err != nil {
-		return fmt.Errorf("waiting for pod to initialize: %w", err)
-	}
-
+// The init container of the pod must be running.
+func (b *Builder) uploadBuildContext(ctx context.Context, podName string, writeTar func(w io.Writer) error) error {
 	buildCtx, buildCtxWriter := io.Pipe()
 	go func() {
-		err := docker.CreateDockerTarContext(ctx, buildCtxWriter, docker.NewBuildConfig(
-			workspace, artifactName, artifact.DockerfilePath, artifact.BuildArgs), b.cfg)
-		if err != nil {
-			buildCtxWriter.CloseWithError(fmt.Errorf("creating docker context: %w", err))
+		if err := writeTar(buildCtxWriter); err != nil {
+			buildCtxWriter.CloseWithError(err)
 			return
 		}
 		buildCtxWriter.Close()
@@ -26,6 +26,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 )
@@ -62,7 +64,7 @@ func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string) (*v
 				Image:           artifact.Image,
 				ImagePullPolicy: v1.PullIfNotPresent,
 				Args:            args,
-				Env:             b.env(artifact, b.ClusterDetails.HTTPProxy, b.ClusterDetails.HTTPSProxy),
+				Env:             b.env(artifact.Env, b.ClusterDetails.HTTPProxy, b.ClusterDetails.HTTPSProxy),
 				VolumeMounts:    []v1.VolumeMount{vm},
 				Resources:       resourceRequirements(b.ClusterDetails.Resources),
 			}},
@@ -91,6 +93,81 @@ func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string) (*v
 		addSecretVolume(pod, kaniko.DefaultDockerConfigSecretName, kaniko.DefaultDockerConfigPath, b.ClusterDetails.DockerConfig.SecretName)
 	}
 
+	b.addPodSettings(pod)
+
+	// Add user-defined VolumeMounts
+	for _, vm := range artifact.VolumeMounts {
+		pod.Spec.InitContainers[0].VolumeMounts = append(pod.Spec.InitContainers[0].VolumeMounts, vm)
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
+	}
+
+	return pod, nil
+}
+
+// sourcesPodSpec returns a pod that runs `container` on the sources uploaded to its init container.
+// The context directory and the additional `emptyDirs` are mounted in both containers.
+func (b *Builder) sourcesPodSpec(container v1.Container, emptyDirs ...v1.VolumeMount) *v1.Pod {
+	vms := append([]v1.VolumeMount{{
+		Name:      kaniko.DefaultEmptyDirName,
+		MountPath: kaniko.DefaultEmptyDirMountPath,
+	}}, emptyDirs...)
+
+	var volumes []v1.Volume
+	for _, vm := range vms {
+		volumes = append(volumes, v1.Volume{
+			Name:         vm.Name,
+			VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+		})
+	}
+
+	env := b.env(container.Env, b.ClusterDetails.HTTPProxy, b.ClusterDetails.HTTPSProxy)
+	if b.ClusterDetails.DockerConfig != nil {
+		env = append(env, v1.EnvVar{Name: "DOCKER_CONFIG", Value: kaniko.DefaultDockerConfigPath})
+	}
+
+	container.ImagePullPolicy = v1.PullIfNotPresent
+	container.Env = env
+	container.VolumeMounts = vms
+	container.Resources = resourceRequirements(b.ClusterDetails.Resources)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations:  b.ClusterDetails.Annotations,
+			GenerateName: container.Name + "-",
+			Labels:       map[string]string{"skaffold-" + container.Name: "skaffold-" + container.Name},
+			Namespace:    b.ClusterDetails.Namespace,
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{
+				Name:            initContainer,
+				Image:           constants.DefaultBusyboxImage,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", "while [ ! -f /tmp/complete ]; do sleep 1; done"},
+				VolumeMounts:    append([]v1.VolumeMount(nil), vms...),
+				Resources:       resourceRequirements(b.ClusterDetails.Resources),
+			}},
+			Containers:    []v1.Container{container},
+			RestartPolicy: v1.RestartPolicyNever,
+			Volumes:       volumes,
+		},
+	}
+
+	// Add secret for pull secret
+	if b.ClusterDetails.PullSecretName != "" {
+		addSecretVolume(pod, kaniko.DefaultSecretName, b.ClusterDetails.PullSecretMountPath, b.ClusterDetails.PullSecretName)
+	}
+
+	if b.ClusterDetails.DockerConfig != nil {
+		// Add secret for docker config if specified
+		addSecretVolume(pod, kaniko.DefaultDockerConfigSecretName, kaniko.DefaultDockerConfigPath, b.ClusterDetails.DockerConfig.SecretName)
+	}
+
+	b.addPodSettings(pod)
+	return pod
+}
+
+// addPodSettings applies the service account, security context, tolerations and volumes of the cluster builder to a build pod.
+func (b *Builder) addPodSettings(pod *v1.Pod) {
 	// Add Service Account
 	if b.ClusterDetails.ServiceAccountName != "" {
 		pod.Spec.ServiceAccountName = b.ClusterDetails.ServiceAccountName
@@ -104,24 +181,34 @@ func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string) (*v
 		pod.Spec.SecurityContext.RunAsUser = b.ClusterDetails.RunAsUser
 	}
 
-	// Add Tolerations for kaniko pod setup
+	// Add Tolerations for build pod setup
 	if len(b.ClusterDetails.Tolerations) > 0 {
 		pod.Spec.Tolerations = b.ClusterDetails.Tolerations
 	}
 
 	// Add used-defines Volumes
 	pod.Spec.Volumes = append(pod.Spec.Volumes, b.Volumes...)
+}
 
-	// Add user-defined VolumeMounts
-	for _, vm := range artifact.VolumeMounts {
-		pod.Spec.InitContainers[0].VolumeMounts = append(pod.Spec.InitContainers[0].VolumeMounts, vm)
-		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
+// setPlatform schedules the kaniko pod on a node of the target platform
+// and has kaniko build the image for that platform.
+func setPlatform(pod *v1.Pod, platform string) error {
+	p, err := docker.ParsePlatform(platform)
+	if err != nil {
+		return err
 	}
 
-	return pod, nil
+	if pod.Spec.NodeSelector == nil {
+		pod.Spec.NodeSelector = map[string]string{}
+	}
+	pod.Spec.NodeSelector["kubernetes.io/os"] = p.OS
+	pod.Spec.NodeSelector["kubernetes.io/arch"] = p.Architecture
+
+	pod.Spec.Containers[0].Args = append(pod.Spec.Containers[0].Args, kaniko.CustomPlatformFlag, platform)
+	return nil
 }
 
-func (b *Builder) env(artifact *latest.KanikoArtifact, httpProxy, httpsProxy string) []v1.EnvVar {
+func (b *Builder) env(artifactEnv []v1.EnvVar, httpProxy, httpsProxy string) []v1.EnvVar {
 	pullSecretPath := strings.Join(
 		[]string{b.ClusterDetails.PullSecretMountPath, b.ClusterDetails.PullSecretPath},
 		"/", // linux filepath separator.
@@ -135,7 +222,7 @@ func (b *Builder) env(artifact *latest.KanikoArtifact, httpProxy, httpsProxy str
 		Value: fmt.Sprintf("UpstreamClient(skaffold-%s)", version.Get().Version),
 	}}
 
-	for _, v := range artifact.Env {
+	for _, v := range artifactEnv {
 		if v.Name != "" && v.Value != "" {
 			env = append(env, v)
 		}
@@ -383,6 +383,50 @@ func TestKanikoPodSpec(t *testing.T) {
 	testutil.CheckDeepEqual(t, expectedPod.Spec.Containers[0].Env, pod.Spec.Containers[0].Env)
 }
 
+func TestSetPlatform(t *testing.T) {
+	tests := []struct {
+		description          string
+		platform             string
+		expectedNodeSelector map[string]string
+		expectedArgs         []string
+		shouldErr            bool
+	}{
+		{
+			description:          "linux/arm64",
+			platform:             "linux/arm64",
+			expectedNodeSelector: map[string]string{"kubernetes.io/os": "linux", "kubernetes.io/arch": "arm64"},
+			expectedArgs:         []string{"--dockerfile", "Dockerfile", kaniko.CustomPlatformFlag, "linux/arm64"},
+		},
+		{
+			description:          "with variant",
+			platform:             "linux/arm/v7",
+			expectedNodeSelector: map[string]string{"kubernetes.io/os": "linux", "kubernetes.io/arch": "arm"},
+			expectedArgs:         []string{"--dockerfile", "Dockerfile", kaniko.CustomPlatformFlag, "linux/arm/v7"},
+		},
+		{
+			description:  "invalid platform",
+			platform:     "arm64",
+			expectedArgs: []string{"--dockerfile", "Dockerfile"},
+			shouldErr:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pod := &v1.Pod{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{{Args: []string{"--dockerfile", "Dockerfile"}}},
+				},
+			}
+
+			err := setPlatform(pod, test.platform)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedNodeSelector, pod.Spec.NodeSelector)
+			t.CheckDeepEqual(test.expectedArgs, pod.Spec.Containers[0].Args)
+		})
+	}
+}
+
 func TestResourceRequirements(t *testing.T) {
 	tests := []struct {
 		description string
@@ -38,6 +38,7 @@ type Builder struct {
 	mode          config.RunMode
 	timeout       time.Duration
 	artifactStore build.ArtifactStore
+	outputs       docker.OutputResolver
 	teardownFunc  []func()
 }
 
@@ -48,6 +49,7 @@ type Config interface {
 	GetKubeContext() string
 	Muted() config.Muted
 	Mode() config.RunMode
+	SkipTests() bool
 }
 
 // NewBuilder creates a new Builder that builds artifacts on cluster.
@@ -70,6 +72,11 @@ func (b *Builder) ArtifactStore(store build.ArtifactStore) {
 	b.artifactStore = store
 }
 
+// OutputResolver sets the resolver of the files of the file outputs required by artifacts.
+func (b *Builder) OutputResolver(r docker.OutputResolver) {
+	b.outputs = r
+}
+
 func (b *Builder) Prune(ctx context.Context, out io.Writer) error {
 	return nil
 }
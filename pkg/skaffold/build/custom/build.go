@@ -27,10 +27,19 @@ import (
 
 // Build builds an artifact using a custom script
 func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
-	if err := b.runBuildScript(ctx, out, artifact, tag); err != nil {
+	if artifact.CustomArtifact.ReportResult {
+		return b.buildWithResult(ctx, out, artifact, tag)
+	}
+
+	if err := b.runBuildScript(ctx, out, artifact, tag, ""); err != nil {
 		return "", fmt.Errorf("building custom artifact: %w", err)
 	}
 
+	return b.resolveImage(ctx, tag)
+}
+
+// resolveImage returns the digest of the pushed image, or the ID of the local image.
+func (b *Builder) resolveImage(ctx context.Context, tag string) (string, error) {
 	if b.pushImages {
 		return docker.RemoteDigest(tag, b.cfg)
 	}
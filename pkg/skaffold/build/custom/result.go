@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// result is what a custom build script reports in the file at `$RESULT_FILE`.
+type result struct {
+	// Digest is the digest of the pushed image.
+	Digest string `json:"digest,omitempty"`
+	// ImageID is the ID of the image in the local Docker daemon.
+	ImageID string `json:"imageID,omitempty"`
+	// Tags are the other tags the script gave to the image, in its repository.
+	Tags []string `json:"tags,omitempty"`
+	// Error explains why the build failed.
+	Error *resultError `json:"error,omitempty"`
+}
+
+type resultError struct {
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// buildWithResult runs the build script and uses the result it reports instead of
+// looking up the image in the registry or the local daemon.
+// Fields left empty by the script fall back to those lookups.
+func (b *Builder) buildWithResult(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (string, error) {
+	dir, err := ioutil.TempDir("", "skaffold-custom")
+	if err != nil {
+		return "", fmt.Errorf("creating directory for the build result: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	resultFile := filepath.Join(dir, "result.json")
+
+	runErr := b.runBuildScript(ctx, out, a, tag, resultFile)
+	res, readErr := readResult(resultFile)
+	switch {
+	case readErr == nil && res.Error != nil:
+		return "", res.Error.toActionableErr(a.ImageName, runErr)
+	case runErr != nil:
+		return "", fmt.Errorf("building custom artifact: %w", runErr)
+	case readErr != nil:
+		return "", readErr
+	}
+
+	additionalTags, err := additionalTags(tag, res.Tags)
+	if err != nil {
+		return "", err
+	}
+	if b.tags != nil {
+		b.tags.RecordAdditionalTags(a.ImageName, additionalTags)
+	}
+
+	if b.pushImages && res.Digest != "" {
+		if _, err := v1.NewHash(res.Digest); err != nil {
+			return "", fmt.Errorf("invalid digest %q reported by the custom script: %w", res.Digest, err)
+		}
+		return res.Digest, nil
+	}
+	if !b.pushImages && res.ImageID != "" {
+		return res.ImageID, nil
+	}
+	return b.resolveImage(ctx, tag)
+}
+
+// additionalTags checks that the tags reported by the script are tags of the image it had to build,
+// and returns those other than `tag`.
+func additionalTags(tag string, tags []string) ([]string, error) {
+	image, err := docker.ParseReference(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var additional []string
+	for _, t := range tags {
+		if t == tag {
+			continue
+		}
+		ref, err := docker.ParseReference(t)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag %q reported by the custom script: %w", t, err)
+		}
+		if ref.BaseName != image.BaseName || ref.Tag == "" || ref.Digest != "" {
+			return nil, fmt.Errorf("the custom script reported %q, which isn't a tag of %s", t, image.BaseName)
+		}
+		additional = append(additional, t)
+	}
+	return additional, nil
+}
+
+func readResult(file string) (*result, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("the custom script didn't write the build result to $%s", constants.ResultFile)
+		}
+		return nil, fmt.Errorf("reading build result: %w", err)
+	}
+
+	var res result
+	if err := json.Unmarshal(content, &res); err != nil {
+		return nil, fmt.Errorf("parsing build result: %w", err)
+	}
+	return &res, nil
+}
+
+func (e *resultError) toActionableErr(imageName string, err error) error {
+	if err == nil {
+		err = fmt.Errorf("building custom artifact %s: %s", imageName, e.Message)
+	}
+
+	var suggestions []*proto.Suggestion
+	if e.Suggestion != "" {
+		suggestions = append(suggestions, &proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_FIX_USER_BUILD_ERR,
+			Action:         e.Suggestion,
+		})
+	}
+	return sErrors.NewError(err, proto.ActionableErr{
+		Message:     e.Message,
+		ErrCode:     proto.StatusCode_BUILD_USER_ERROR,
+		Suggestions: suggestions,
+	})
+}
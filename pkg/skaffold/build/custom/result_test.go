@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"runtime"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const testDigest = "sha256:d3d0c5ab7f1b7b9e5b8d1ea2a0a1c5f0c2c8b6a1f0e6d2b3c4a5968778695a4b"
+
+type fakeTagsRecorder map[string][]string
+
+func (r fakeTagsRecorder) RecordAdditionalTags(imageName string, tags []string) {
+	r[imageName] = tags
+}
+
+func TestBuildWithResult(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("build commands use a POSIX shell")
+	}
+
+	tests := []struct {
+		description  string
+		command      string
+		expected     string
+		expectedTags []string
+		expectedErr  string
+		expectedCode proto.StatusCode
+	}{
+		{
+			description: "reported digest",
+			command:     `echo '{"digest":"` + testDigest + `"}' > $RESULT_FILE`,
+			expected:    testDigest,
+		},
+		{
+			description:  "reported tags",
+			command:      `echo '{"digest":"` + testDigest + `","tags":["gcr.io/project/app:v1","gcr.io/project/app:latest"]}' > $RESULT_FILE`,
+			expected:     testDigest,
+			expectedTags: []string{"gcr.io/project/app:latest"},
+		},
+		{
+			description: "tag of another image",
+			command:     `echo '{"digest":"` + testDigest + `","tags":["gcr.io/project/other:latest"]}' > $RESULT_FILE`,
+			expectedErr: `the custom script reported "gcr.io/project/other:latest", which isn't a tag of gcr.io/project/app`,
+		},
+		{
+			description: "invalid tag",
+			command:     `echo '{"digest":"` + testDigest + `","tags":["gcr.io/project/app:in valid"]}' > $RESULT_FILE`,
+			expectedErr: `invalid tag "gcr.io/project/app:in valid"`,
+		},
+		{
+			description: "no digest reported",
+			command:     `echo '{}' > $RESULT_FILE`,
+			expected:    "sha256:resolved",
+		},
+		{
+			description: "invalid digest",
+			command:     `echo '{"digest":"abc"}' > $RESULT_FILE`,
+			expectedErr: `invalid digest "abc"`,
+		},
+		{
+			description: "no result",
+			command:     "true",
+			expectedErr: "didn't write the build result to $RESULT_FILE",
+		},
+		{
+			description: "invalid result",
+			command:     `echo 'not json' > $RESULT_FILE`,
+			expectedErr: "parsing build result",
+		},
+		{
+			description:  "reported error",
+			command:      `echo '{"error":{"message":"compilation failed","suggestion":"Fix main.go"}}' > $RESULT_FILE; exit 1`,
+			expectedErr:  "compilation failed. Fix main.go.",
+			expectedCode: proto.StatusCode_BUILD_USER_ERROR,
+		},
+		{
+			description: "failure without result",
+			command:     "exit 1",
+			expectedErr: "building custom artifact: exit status 1",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.RemoteDigest, func(string, docker.Config) (string, error) { return "sha256:resolved", nil })
+			t.Override(&buildContext, func(string) (string, error) { return t.NewTempDir().Root(), nil })
+
+			artifact := &latest.Artifact{
+				ImageName: "gcr.io/project/app",
+				ArtifactType: latest.ArtifactType{
+					CustomArtifact: &latest.CustomArtifact{BuildCommand: test.command, ReportResult: true},
+				},
+			}
+			tags := fakeTagsRecorder{}
+			builder := NewArtifactBuilder(nil, nil, true, nil, tags)
+			digest, err := builder.Build(context.Background(), ioutil.Discard, artifact, "gcr.io/project/app:v1")
+
+			if test.expectedErr != "" {
+				t.CheckErrorContains(test.expectedErr, err)
+			} else {
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.expected, digest)
+				t.CheckDeepEqual(test.expectedTags, tags["gcr.io/project/app"])
+			}
+			if test.expectedCode != proto.StatusCode_OK {
+				var sErr sErrors.Error
+				t.CheckTrue(errors.As(err, &sErr))
+				t.CheckDeepEqual(test.expectedCode, sErr.StatusCode())
+			}
+		})
+	}
+}
+
+func TestBuildWithResultLocal(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("build commands use a POSIX shell")
+	}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&buildContext, func(string) (string, error) { return t.NewTempDir().Root(), nil })
+
+		artifact := &latest.Artifact{
+			ImageName: "app",
+			ArtifactType: latest.ArtifactType{
+				CustomArtifact: &latest.CustomArtifact{BuildCommand: `echo '{"imageID":"sha256:123"}' > $RESULT_FILE`, ReportResult: true},
+			},
+		}
+		// The local daemon isn't queried when the script reports the image ID.
+		builder := NewArtifactBuilder(nil, nil, false, nil, nil)
+		imageID, err := builder.Build(context.Background(), ioutil.Discard, artifact, "app:v1")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("sha256:123", imageID)
+	})
+}
@@ -38,8 +38,8 @@ var (
 	buildContext = retrieveBuildContext
 )
 
-func (b *Builder) runBuildScript(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) error {
-	cmd, err := b.retrieveCmd(ctx, out, a, tag)
+func (b *Builder) runBuildScript(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, resultFile string) error {
+	cmd, err := b.retrieveCmd(ctx, out, a, tag, resultFile)
 	if err != nil {
 		return fmt.Errorf("retrieving cmd: %w", err)
 	}
@@ -52,7 +52,7 @@ func (b *Builder) runBuildScript(ctx context.Context, out io.Writer, a *latest.A
 	return misc.HandleGracefulTermination(ctx, cmd)
 }
 
-func (b *Builder) retrieveCmd(ctx context.Context, out io.Writer, a *latest.Artifact, tag string) (*exec.Cmd, error) {
+func (b *Builder) retrieveCmd(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, resultFile string) (*exec.Cmd, error) {
 	artifact := a.CustomArtifact
 
 	// Expand command
@@ -72,7 +72,7 @@ func (b *Builder) retrieveCmd(ctx context.Context, out io.Writer, a *latest.Arti
 	cmd.Stdout = out
 	cmd.Stderr = out
 
-	env, err := b.retrieveEnv(a, tag)
+	env, err := b.retrieveEnv(a, tag, resultFile)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving env variables for %q: %w", a.ImageName, err)
 	}
@@ -87,7 +87,7 @@ func (b *Builder) retrieveCmd(ctx context.Context, out io.Writer, a *latest.Arti
 	return cmd, nil
 }
 
-func (b *Builder) retrieveEnv(a *latest.Artifact, tag string) ([]string, error) {
+func (b *Builder) retrieveEnv(a *latest.Artifact, tag string, resultFile string) ([]string, error) {
 	buildContext, err := buildContext(a.Workspace)
 	if err != nil {
 		return nil, fmt.Errorf("getting absolute path for artifact build context: %w", err)
@@ -99,6 +99,9 @@ func (b *Builder) retrieveEnv(a *latest.Artifact, tag string) ([]string, error)
 		fmt.Sprintf("%s=%t", constants.PushImage, b.pushImages),
 		fmt.Sprintf("%s=%s", constants.BuildContext, buildContext),
 	}
+	if resultFile != "" {
+		envs = append(envs, fmt.Sprintf("%s=%s", constants.ResultFile, resultFile))
+	}
 
 	ref, err := docker.ParseReference(tag)
 	if err != nil {
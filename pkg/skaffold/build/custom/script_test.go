@@ -36,6 +36,7 @@ func TestRetrieveEnv(t *testing.T) {
 		buildContext  string
 		additionalEnv []string
 		environ       []string
+		resultFile    string
 		expected      []string
 	}{
 
@@ -62,6 +63,11 @@ func TestRetrieveEnv(t *testing.T) {
 			pushImages:    true,
 			additionalEnv: []string{"KUBECONTEXT=mycluster"},
 			expected:      []string{"IMAGE=gcr.io/image/push:tag", "IMAGES=gcr.io/image/push:tag", "PUSH_IMAGE=true", "BUILD_CONTEXT=", "IMAGE_REPO=gcr.io/image/push", "IMAGE_TAG=tag", "KUBECONTEXT=mycluster"},
+		}, {
+			description: "result file",
+			tag:         "gcr.io/image/push:tag",
+			resultFile:  "/tmp/result.json",
+			expected:    []string{"IMAGE=gcr.io/image/push:tag", "IMAGES=gcr.io/image/push:tag", "PUSH_IMAGE=false", "BUILD_CONTEXT=", "RESULT_FILE=/tmp/result.json", "IMAGE_REPO=gcr.io/image/push", "IMAGE_TAG=tag"},
 		},
 	}
 	for _, test := range tests {
@@ -69,8 +75,8 @@ func TestRetrieveEnv(t *testing.T) {
 			t.Override(&util.OSEnviron, func() []string { return test.environ })
 			t.Override(&buildContext, func(string) (string, error) { return test.buildContext, nil })
 
-			builder := NewArtifactBuilder(nil, nil, test.pushImages, test.additionalEnv)
-			actual, err := builder.retrieveEnv(&latest.Artifact{}, test.tag)
+			builder := NewArtifactBuilder(nil, nil, test.pushImages, test.additionalEnv, nil)
+			actual, err := builder.retrieveEnv(&latest.Artifact{}, test.tag, test.resultFile)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
@@ -134,8 +140,8 @@ func TestRetrieveCmd(t *testing.T) {
 			t.Override(&util.OSEnviron, func() []string { return test.env })
 			t.Override(&buildContext, func(string) (string, error) { return test.artifact.Workspace, nil })
 
-			builder := NewArtifactBuilder(nil, nil, false, nil)
-			cmd, err := builder.retrieveCmd(context.Background(), ioutil.Discard, test.artifact, test.tag)
+			builder := NewArtifactBuilder(nil, nil, false, nil, nil)
+			cmd, err := builder.retrieveCmd(context.Background(), ioutil.Discard, test.artifact, test.tag, "")
 
 			t.CheckNoError(err)
 			if runtime.GOOS == "windows" {
@@ -24,14 +24,21 @@ type Builder struct {
 	cfg           docker.Config
 	pushImages    bool
 	additionalEnv []string
+	tags          TagsRecorder
+}
+
+// TagsRecorder records the other tags that custom build scripts report for the images they build.
+type TagsRecorder interface {
+	RecordAdditionalTags(imageName string, tags []string)
 }
 
 // NewArtifactBuilder returns a new custom artifact builder
-func NewArtifactBuilder(localDocker docker.LocalDaemon, cfg docker.Config, pushImages bool, additionalEnv []string) *Builder {
+func NewArtifactBuilder(localDocker docker.LocalDaemon, cfg docker.Config, pushImages bool, additionalEnv []string, tags TagsRecorder) *Builder {
 	return &Builder{
 		localDocker:   localDocker,
 		cfg:           cfg,
 		pushImages:    pushImages,
 		additionalEnv: additionalEnv,
+		tags:          tags,
 	}
 }
@@ -43,12 +43,30 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 	if err := b.pullCacheFromImages(ctx, out, a.ArtifactType.DockerArtifact); err != nil {
 		return "", cacheFromPullErr(err, a.ImageName)
 	}
-	opts := docker.BuildOptions{Tag: tag, Mode: b.mode, ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true)}
+
+	switch len(a.Platforms) {
+	case 0:
+		return b.buildForPlatform(ctx, out, a, dockerfile, tag, "")
+	case 1:
+		return b.buildForPlatform(ctx, out, a, dockerfile, tag, a.Platforms[0])
+	default:
+		return b.buildMultiPlatform(ctx, out, a, dockerfile, tag)
+	}
+}
+
+// buildForPlatform builds the artifact for a single target platform, or for the platform of the Docker daemon if empty.
+// It returns the digest of the pushed image if images are pushed, or the image ID otherwise.
+func (b *Builder) buildForPlatform(ctx context.Context, out io.Writer, a *latest.Artifact, dockerfile string, tag string, platform string) (string, error) {
+	outputDirs, err := docker.ResolveOutputDependencies(a.OutputDependencies, b.outputs)
+	if err != nil {
+		return "", err
+	}
+	opts := docker.BuildOptions{Tag: tag, Mode: b.mode, ExtraBuildArgs: docker.ResolveDependencyImages(a.Dependencies, b.artifacts, true), Platform: platform, OutputDirs: outputDirs}
 
 	var imageID string
 
 	if b.useCLI || b.useBuildKit {
-		imageID, err = b.dockerCLIBuild(ctx, color.GetWriter(out), a.Workspace, dockerfile, a.ArtifactType.DockerArtifact, opts)
+		imageID, err = b.dockerCLIBuild(ctx, color.GetWriter(out), a.Workspace, a.ImageName, dockerfile, a.ArtifactType.DockerArtifact, opts)
 	} else {
 		imageID, err = b.localDocker.Build(ctx, out, a.Workspace, a.ImageName, a.ArtifactType.DockerArtifact, opts)
 	}
@@ -66,17 +84,61 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact,
 	return imageID, nil
 }
 
-func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace string, dockerfilePath string, a *latest.DockerArtifact, opts docker.BuildOptions) (string, error) {
-	args := []string{"build", workspace, "--file", dockerfilePath, "-t", opts.Tag}
+// buildMultiPlatform builds and pushes an image per target platform, and then assembles them into a manifest list.
+// It returns the digest of the manifest list.
+func (b *Builder) buildMultiPlatform(ctx context.Context, out io.Writer, a *latest.Artifact, dockerfile string, tag string) (string, error) {
+	if !b.pushImages {
+		return "", multiPlatformNoPush(a.ImageName, a.Platforms)
+	}
+
+	var images []docker.SinglePlatformImage
+	for _, p := range a.Platforms {
+		platform, err := docker.ParsePlatform(p)
+		if err != nil {
+			return "", err
+		}
+
+		platformTag := docker.PlatformTag(tag, platform)
+		color.Default.Fprintf(out, "Building [%s] for platform %s...\n", a.ImageName, p)
+		digest, err := b.buildForPlatform(ctx, out, a, dockerfile, platformTag, p)
+		if err != nil {
+			return "", err
+		}
+
+		images = append(images, docker.SinglePlatformImage{Platform: platform, Image: platformTag + "@" + digest})
+	}
+
+	return docker.CreateManifestList(images, tag, b.cfg)
+}
+
+func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace string, artifact string, dockerfilePath string, a *latest.DockerArtifact, opts docker.BuildOptions) (string, error) {
 	ba, err := docker.EvalBuildArgs(b.mode, workspace, a.DockerfilePath, a.BuildArgs, opts.ExtraBuildArgs)
 	if err != nil {
 		return "", fmt.Errorf("unable to evaluate build args: %w", err)
 	}
+
+	// The files of file outputs aren't in the workspace, so the build context is sent to the CLI as a tar instead.
+	var buildCtx io.Reader
+	args := []string{"build", workspace, "--file", dockerfilePath, "-t", opts.Tag}
+	if len(opts.OutputDirs) > 0 {
+		args = []string{"build", "-", "--file", a.DockerfilePath, "-t", opts.Tag}
+		r, w := io.Pipe()
+		go func() {
+			w.CloseWithError(docker.CreateDockerTarContext(ctx, w, docker.NewBuildConfig(workspace, artifact, a.DockerfilePath, ba).WithOutputDirs(opts.OutputDirs), b.cfg))
+		}()
+		buildCtx = r
+	}
+
 	cliArgs, err := docker.ToCLIBuildArgs(a, ba)
 	if err != nil {
 		return "", fmt.Errorf("getting docker build args: %w", err)
 	}
 	args = append(args, cliArgs...)
+	args = append(args, "--label", docker.ArtifactLabel+"="+artifact)
+
+	if opts.Platform != "" {
+		args = append(args, "--platform", opts.Platform)
+	}
 
 	if b.prune {
 		args = append(args, "--force-rm")
@@ -87,6 +149,7 @@ func (b *Builder) dockerCLIBuild(ctx context.Context, out io.Writer, workspace s
 	if b.useBuildKit {
 		cmd.Env = append(cmd.Env, "DOCKER_BUILDKIT=1")
 	}
+	cmd.Stdin = buildCtx
 	cmd.Stdout = out
 	cmd.Stderr = out
 
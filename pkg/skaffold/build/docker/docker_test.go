@@ -93,22 +93,23 @@ func TestDockerCLIBuild(t *testing.T) {
 
 			if test.err != nil {
 				mockCmd = testutil.CmdRunErr(
-					"docker build . --file "+dockerfilePath+" -t tag",
+					"docker build . --file "+dockerfilePath+" -t tag --label skaffold.dev/artifact=test-image",
 					test.err,
 				)
 				t.Override(&util.DefaultExecCommand, mockCmd)
 			} else if test.localBuild.UseBuildkit || test.localBuild.UseDockerCLI {
 				mockCmd = testutil.CmdRunEnv(
-					"docker build . --file "+dockerfilePath+" -t tag",
+					"docker build . --file "+dockerfilePath+" -t tag --label skaffold.dev/artifact=test-image",
 					test.expectedEnv,
 				)
 				t.Override(&util.DefaultExecCommand, mockCmd)
 			}
 			t.Override(&util.OSEnviron, func() []string { return []string{"KEY=VALUE"} })
 
-			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(test.extraEnv), test.localBuild.UseDockerCLI, test.localBuild.UseBuildkit, false, false, test.mode, nil, mockArtifactResolver{make(map[string]string)})
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv(test.extraEnv), test.localBuild.UseDockerCLI, test.localBuild.UseBuildkit, false, false, test.mode, nil, mockArtifactResolver{make(map[string]string)}, nil)
 
 			artifact := &latest.Artifact{
+				ImageName: "test-image",
 				Workspace: ".",
 				ArtifactType: latest.ArtifactType{
 					DockerArtifact: &latest.DockerArtifact{
@@ -123,3 +123,18 @@ func cacheFromPullErr(err error, artifact string) error {
 			},
 		})
 }
+
+func multiPlatformNoPush(artifact string, platforms []string) error {
+	return sErrors.NewError(fmt.Errorf("cannot build artifact %s for multiple platforms %v without pushing images", artifact, platforms),
+		proto.ActionableErr{
+			Message: fmt.Sprintf("multi-platform images can only be assembled in a registry, but artifact %s is built for %v and images are not pushed", artifact, platforms),
+			ErrCode: proto.StatusCode_BUILD_USER_ERROR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_FIX_USER_BUILD_ERR,
+					Action: fmt.Sprintf("Set `build.local.push` to true, or set a single target platform for artifact %s."+
+						"\nRefer https://skaffold.dev/docs/references/yaml/#build-platforms for details.", artifact),
+				},
+			},
+		})
+}
@@ -56,10 +56,10 @@ Refer https://skaffold.dev/docs/references/yaml/#build-artifacts-docker for deta
 				return args, nil
 			})
 			t.Override(&util.DefaultExecCommand, testutil.CmdRun(
-				"docker build . --file "+dockerfilePath+" -t tag",
+				"docker build . --file "+dockerfilePath+" -t tag --label skaffold.dev/artifact=test-image",
 			))
 			t.Override(&docker.DefaultAuthHelper, stubAuth{})
-			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv([]string{}), false, true, false, false, config.RunModes.Build, nil, mockArtifactResolver{make(map[string]string)})
+			builder := NewArtifactBuilder(fakeLocalDaemonWithExtraEnv([]string{}), false, true, false, false, config.RunModes.Build, nil, mockArtifactResolver{make(map[string]string)}, nil)
 
 			artifact := &latest.Artifact{
 				ImageName: "test-image",
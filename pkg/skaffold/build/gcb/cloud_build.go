@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -94,7 +95,7 @@ func (b *Builder) buildArtifactWithCloudBuild(ctx context.Context, out io.Writer
 		return "", fmt.Errorf("checking bucket is in correct project: %w", err)
 	}
 
-	dependencies, err := build.DependenciesForArtifact(ctx, artifact, b.cfg, b.artifactStore)
+	dependencies, err := build.DependenciesForArtifact(ctx, artifact, b.cfg, b.artifactStore, nil)
 	if err != nil {
 		return "", fmt.Errorf("getting dependencies for %q: %w", artifact.ImageName, err)
 	}
@@ -105,7 +106,7 @@ func (b *Builder) buildArtifactWithCloudBuild(ctx context.Context, out io.Writer
 	// https://github.com/GoogleContainerTools/skaffold/issues/3477
 	// TODO: Avoid duplication (every Jib artifact will upload the entire workspace)
 	if artifact.JibArtifact != nil {
-		deps, err := jibAddWorkspaceToDependencies(artifact.Workspace, dependencies)
+		deps, err := jib.AddWorkspaceToDependencies(artifact.Workspace, dependencies)
 		if err != nil {
 			return "", fmt.Errorf("walking workspace for Jib projects: %w", err)
 		}
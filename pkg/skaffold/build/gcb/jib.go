@@ -18,15 +18,12 @@ package gcb
 
 import (
 	"errors"
-	"os"
-	"path/filepath"
 	"strings"
 
 	cloudbuild "google.golang.org/api/cloudbuild/v1"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
 func (b *Builder) jibBuildSpec(artifact *latest.Artifact, tag string) (cloudbuild.Build, error) {
@@ -60,33 +57,3 @@ func (b *Builder) jibBuildSpec(artifact *latest.Artifact, tag string) (cloudbuil
 func fixHome(command string, args []string) []string {
 	return []string{"-c", command + " -Duser.home=$$HOME " + strings.Join(args, " ")}
 }
-
-func jibAddWorkspaceToDependencies(workspace string, dependencies []string) ([]string, error) {
-	dependencyMap := make(map[string]bool)
-	for _, d := range dependencies {
-		dependencyMap[d] = true
-	}
-
-	err := filepath.Walk(workspace,
-		func(path string, info os.FileInfo, err error) error {
-			if err != nil {
-				return err
-			}
-			if info.IsDir() {
-				if info.Name() == "target" {
-					if util.IsFile(filepath.Join(filepath.Dir(path), "pom.xml")) {
-						return filepath.SkipDir
-					}
-				} else if info.Name() == "build" {
-					if util.IsFile(filepath.Join(filepath.Dir(path), "build.gradle")) {
-						return filepath.SkipDir
-					}
-				}
-			}
-			if _, ok := dependencyMap[path]; !ok {
-				dependencies = append(dependencies, path)
-			}
-			return nil
-		})
-	return dependencies, err
-}
@@ -428,6 +428,7 @@ type mockArtifactStore map[string]string
 
 func (m mockArtifactStore) GetImageTag(imageName string) (string, bool) { return m[imageName], true }
 func (m mockArtifactStore) Record(a *latest.Artifact, tag string)       { m[a.ImageName] = tag }
+func (m mockArtifactStore) RecordAdditionalTags(string, []string)       {}
 func (m mockArtifactStore) GetArtifacts([]*latest.Artifact) ([]build.Artifact, error) {
 	return nil, nil
 }
@@ -18,7 +18,11 @@ package jib
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
@@ -35,15 +39,43 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Art
 		if b.pushImages {
 			return b.buildJibMavenToRegistry(ctx, out, artifact.Workspace, artifact.JibArtifact, artifact.Dependencies, tag)
 		}
+		if b.daemonless {
+			return b.buildJibMavenToTar(ctx, out, artifact.Workspace, artifact.JibArtifact, artifact.Dependencies, tag)
+		}
 		return b.buildJibMavenToDocker(ctx, out, artifact.Workspace, artifact.JibArtifact, artifact.Dependencies, tag)
 
 	case JibGradle:
 		if b.pushImages {
 			return b.buildJibGradleToRegistry(ctx, out, artifact.Workspace, artifact.JibArtifact, artifact.Dependencies, tag)
 		}
+		if b.daemonless {
+			return b.buildJibGradleToTar(ctx, out, artifact.Workspace, artifact.JibArtifact, artifact.Dependencies, tag)
+		}
 		return b.buildJibGradleToDocker(ctx, out, artifact.Workspace, artifact.JibArtifact, artifact.Dependencies, tag)
 
 	default:
 		return "", unknownPluginType(artifact.Workspace)
 	}
 }
+
+// loadTar runs a Jib build that writes the image to a tarball and loads it into the local image store.
+func (b *Builder) loadTar(ctx context.Context, out io.Writer, tag string, build func(tarPath string) error) (string, error) {
+	dir, err := ioutil.TempDir("", "skaffold-jib")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	tarPath := filepath.Join(dir, "image.tar")
+	if err := build(tarPath); err != nil {
+		return "", err
+	}
+
+	imageTar, err := os.Open(tarPath)
+	if err != nil {
+		return "", fmt.Errorf("opening image tarball: %w", err)
+	}
+	defer imageTar.Close()
+
+	return b.localDocker.Load(ctx, out, imageTar, tag)
+}
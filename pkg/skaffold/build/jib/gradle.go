@@ -52,6 +52,17 @@ func (b *Builder) buildJibGradleToDocker(ctx context.Context, out io.Writer, wor
 	return b.localDocker.ImageID(ctx, tag)
 }
 
+func (b *Builder) buildJibGradleToTar(ctx context.Context, out io.Writer, workspace string, artifact *latest.JibArtifact, deps []*latest.ArtifactDependency, tag string) (string, error) {
+	return b.loadTar(ctx, out, tag, func(tarPath string) error {
+		args := GenerateGradleBuildArgs("jibBuildTar", tag, artifact, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
+		args = append(args, "-Djib.outputPaths.tar="+tarPath)
+		if err := b.runGradleCommand(ctx, out, workspace, args); err != nil {
+			return jibToolErr(err)
+		}
+		return nil
+	})
+}
+
 func (b *Builder) buildJibGradleToRegistry(ctx context.Context, out io.Writer, workspace string, artifact *latest.JibArtifact, deps []*latest.ArtifactDependency, tag string) (string, error) {
 	args := GenerateGradleBuildArgs("jib", tag, artifact, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
 	if err := b.runGradleCommand(ctx, out, workspace, args); err != nil {
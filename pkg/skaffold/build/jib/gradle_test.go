@@ -82,7 +82,7 @@ func TestBuildJibGradleToDocker(t *testing.T) {
 			api := (&testutil.FakeAPIClient{}).Add("img:tag", "imageID")
 			localDocker := fakeLocalDaemon(api)
 
-			builder := NewArtifactBuilder(localDocker, &mockConfig{}, false, false, nil)
+			builder := NewArtifactBuilder(localDocker, &mockConfig{}, false, false, false, nil)
 			result, err := builder.Build(context.Background(), ioutil.Discard, &latest.Artifact{
 				ArtifactType: latest.ArtifactType{
 					JibArtifact: test.artifact,
@@ -153,7 +153,7 @@ func TestBuildJibGradleToRegistry(t *testing.T) {
 			})
 			localDocker := fakeLocalDaemon(&testutil.FakeAPIClient{})
 
-			builder := NewArtifactBuilder(localDocker, &mockConfig{}, true, false, nil)
+			builder := NewArtifactBuilder(localDocker, &mockConfig{}, true, false, false, nil)
 			result, err := builder.Build(context.Background(), ioutil.Discard, &latest.Artifact{
 				ArtifactType: latest.ArtifactType{
 					JibArtifact: test.artifact,
@@ -348,3 +348,35 @@ func baseImageArg(a *latest.JibArtifact, r ArtifactResolver, deps []*latest.Arti
 	}
 	return fmt.Sprintf("-Djib.from.image=%s", a.BaseImage), true
 }
+
+// AddWorkspaceToDependencies adds all the files of the workspace to the dependencies, except for the Maven and Gradle build outputs.
+// Remote builds use it to upload the entire workspace, which multi-module projects need.
+func AddWorkspaceToDependencies(workspace string, dependencies []string) ([]string, error) {
+	dependencyMap := make(map[string]bool)
+	for _, d := range dependencies {
+		dependencyMap[d] = true
+	}
+
+	err := filepath.Walk(workspace,
+		func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if info.Name() == "target" {
+					if util.IsFile(filepath.Join(filepath.Dir(path), "pom.xml")) {
+						return filepath.SkipDir
+					}
+				} else if info.Name() == "build" {
+					if util.IsFile(filepath.Join(filepath.Dir(path), "build.gradle")) {
+						return filepath.SkipDir
+					}
+				}
+			}
+			if _, ok := dependencyMap[path]; !ok {
+				dependencies = append(dependencies, path)
+			}
+			return nil
+		})
+	return dependencies, err
+}
@@ -225,3 +225,61 @@ func TestGetProjectKey(t *testing.T) {
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
+
+func TestAddWorkspaceToDependencies(t *testing.T) {
+	tests := []struct {
+		description       string
+		workspacePaths    []string
+		dependencies      []string
+		expectedWorkspace []string
+	}{
+		{
+			description:       "basic test",
+			workspacePaths:    []string{"a/b/file", "c/file", "file"},
+			dependencies:      []string{"dependencyA", "dependencyB"},
+			expectedWorkspace: []string{"", "/a", "/a/b", "/a/b/file", "/c", "/c/file", "/file"},
+		},
+		{
+			description:       "ignore target with pom",
+			workspacePaths:    []string{"pom.xml", "target/fileA", "target/fileB", "watchedFile"},
+			dependencies:      []string{"dependencyA", "dependencyB"},
+			expectedWorkspace: []string{"", "/pom.xml", "/watchedFile"},
+		},
+		{
+			description:       "don't ignore target without pom",
+			workspacePaths:    []string{"target/fileA", "target/fileB", "watchedFile"},
+			dependencies:      []string{"dependencyA", "dependencyB"},
+			expectedWorkspace: []string{"", "/target", "/target/fileA", "/target/fileB", "/watchedFile"},
+		},
+		{
+			description:       "ignore build with build.gradle",
+			workspacePaths:    []string{"build.gradle", "build/fileA", "build/fileB", "watchedFile"},
+			dependencies:      []string{"dependencyA", "dependencyB"},
+			expectedWorkspace: []string{"", "/build.gradle", "/watchedFile"},
+		},
+		{
+			description:       "don't ignore build without build.gradle",
+			workspacePaths:    []string{"build/fileA", "build/fileB", "watchedFile"},
+			dependencies:      []string{"dependencyA", "dependencyB"},
+			expectedWorkspace: []string{"", "/build", "/build/fileA", "/build/fileB", "/watchedFile"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			for _, f := range test.workspacePaths {
+				tmpDir.Write(filepath.FromSlash(f), "")
+			}
+
+			for i := range test.expectedWorkspace {
+				test.expectedWorkspace[i] = tmpDir.Root() + filepath.FromSlash(test.expectedWorkspace[i])
+			}
+			expectedDependencies := append(test.dependencies, test.expectedWorkspace...)
+
+			actualDepedencies, err := AddWorkspaceToDependencies(tmpDir.Root(), test.dependencies)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(expectedDependencies, actualDepedencies)
+		})
+	}
+}
@@ -52,6 +52,17 @@ func (b *Builder) buildJibMavenToDocker(ctx context.Context, out io.Writer, work
 	return b.localDocker.ImageID(ctx, tag)
 }
 
+func (b *Builder) buildJibMavenToTar(ctx context.Context, out io.Writer, workspace string, artifact *latest.JibArtifact, deps []*latest.ArtifactDependency, tag string) (string, error) {
+	return b.loadTar(ctx, out, tag, func(tarPath string) error {
+		args := GenerateMavenBuildArgs("buildTar", tag, artifact, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
+		args = append(args, "-Djib.outputPaths.tar="+tarPath)
+		if err := b.runMavenCommand(ctx, out, workspace, args); err != nil {
+			return jibToolErr(err)
+		}
+		return nil
+	})
+}
+
 func (b *Builder) buildJibMavenToRegistry(ctx context.Context, out io.Writer, workspace string, artifact *latest.JibArtifact, deps []*latest.ArtifactDependency, tag string) (string, error) {
 	args := GenerateMavenBuildArgs("build", tag, artifact, b.skipTests, b.pushImages, deps, b.artifacts, b.cfg.GetInsecureRegistries(), color.IsColorable(out))
 	if err := b.runMavenCommand(ctx, out, workspace, args); err != nil {
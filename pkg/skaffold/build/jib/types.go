@@ -23,6 +23,7 @@ type Builder struct {
 	localDocker docker.LocalDaemon
 	cfg         docker.Config
 	pushImages  bool
+	daemonless  bool
 	skipTests   bool
 	artifacts   ArtifactResolver
 }
@@ -39,11 +40,12 @@ type ArtifactResolver interface {
 }
 
 // NewArtifactBuilder returns a new customjib artifact builder
-func NewArtifactBuilder(localDocker docker.LocalDaemon, cfg docker.Config, pushImages, skipTests bool, r ArtifactResolver) *Builder {
+func NewArtifactBuilder(localDocker docker.LocalDaemon, cfg docker.Config, pushImages, daemonless, skipTests bool, r ArtifactResolver) *Builder {
 	return &Builder{
 		localDocker: localDocker,
 		cfg:         cfg,
 		pushImages:  pushImages,
+		daemonless:  daemonless,
 		skipTests:   skipTests,
 		artifacts:   r,
 	}
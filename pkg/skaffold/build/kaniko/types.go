@@ -31,6 +31,8 @@ const (
 	TargetFlag = "--target"
 	// CleanupFlag additional flag
 	CleanupFlag = "--cleanup"
+	// CustomPlatformFlag additional flag
+	CustomPlatformFlag = "--customPlatform"
 	// DigestFileFlag additional flag
 	DigestFileFlag = "--digest-file"
 	// ForceFlag additional flag
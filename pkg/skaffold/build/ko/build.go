@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// appDir is the directory of the image where the Go binary is added.
+	appDir = "/ko-app"
+
+	// gcflagsEnv holds the flags passed to the Go compiler.
+	gcflagsEnv = "SKAFFOLD_GO_GCFLAGS"
+)
+
+// for testing
+var baseImage = docker.RemotePlatformImage
+
+// Build compiles the main package of an artifact and layers the binary on the base image.
+func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error) {
+	a := artifact.KoArtifact
+
+	platform, err := targetPlatform(artifact.Platforms)
+	if err != nil {
+		return "", err
+	}
+
+	tmpDir, err := ioutil.TempDir("", "skaffold-ko")
+	if err != nil {
+		return "", fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	binary := filepath.Join(tmpDir, binaryName(artifact.Workspace, a.Main))
+	if err := b.buildBinary(ctx, out, artifact.Workspace, a, platform, binary); err != nil {
+		return "", err
+	}
+
+	img, err := b.buildImage(a, platform, binary)
+	if err != nil {
+		return "", err
+	}
+
+	if b.pushImages {
+		return docker.PushImage(img, tag, b.cfg)
+	}
+	return b.loadImage(ctx, out, img, tag)
+}
+
+func (b *Builder) buildBinary(ctx context.Context, out io.Writer, workspace string, a *latest.KoArtifact, platform v1.Platform, binary string) error {
+	env, err := GetEnv(a, b.mode)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "go", buildArgs(a, env, binary)...)
+	cmd.Dir = workspace
+	cmd.Env = append(util.OSEnviron(), platformEnv(platform)...)
+	cmd.Env = append(cmd.Env, util.EnvMapToSlice(env, "=")...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("building Go binary: %w", err)
+	}
+	return nil
+}
+
+func (b *Builder) buildImage(a *latest.KoArtifact, platform v1.Platform, binary string) (v1.Image, error) {
+	base, err := baseImage(a.BaseImage, platform, b.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("getting base image %q: %w", a.BaseImage, err)
+	}
+
+	layer, err := binaryLayer(binary)
+	if err != nil {
+		return nil, fmt.Errorf("creating layer: %w", err)
+	}
+
+	img, err := mutate.Append(base, mutate.Addendum{
+		Layer: layer,
+		History: v1.History{
+			Author:    "skaffold",
+			CreatedBy: "skaffold ko builder",
+			Created:   v1.Time{Time: time.Unix(0, 0)},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("adding layer: %w", err)
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading image config: %w", err)
+	}
+	cfg = cfg.DeepCopy()
+	cfg.Config.Entrypoint = []string{path.Join(appDir, filepath.Base(binary))}
+	cfg.Config.Cmd = nil
+
+	return mutate.ConfigFile(img, cfg)
+}
+
+func (b *Builder) loadImage(ctx context.Context, out io.Writer, img v1.Image, tag string) (string, error) {
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	if err != nil {
+		return "", fmt.Errorf("parsing tag %q: %w", tag, err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(tarball.Write(ref, img, w))
+	}()
+
+	imageID, err := b.localDocker.Load(ctx, out, r, tag)
+	r.Close()
+	if err != nil {
+		return "", fmt.Errorf("loading image into docker daemon: %w", err)
+	}
+	return imageID, nil
+}
+
+// GetEnv returns the environment variables passed to `go build`.
+// In debug mode, `SKAFFOLD_GO_GCFLAGS` disables optimizations unless set by the user, as for Dockerfiles.
+func GetEnv(a *latest.KoArtifact, mode config.RunMode) (map[string]string, error) {
+	envVars, err := misc.EvaluateEnv(a.Env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate env variables: %w", err)
+	}
+
+	env := util.EnvSliceToMap(envVars, "=")
+	if _, found := env[gcflagsEnv]; !found && mode == config.RunModes.Debug {
+		env[gcflagsEnv] = "all=-N -l"
+	}
+	return env, nil
+}
+
+// buildArgs returns the arguments of the `go build` command.
+func buildArgs(a *latest.KoArtifact, env map[string]string, binary string) []string {
+	args := []string{"build", "-o", binary}
+	args = append(args, a.Flags...)
+	if len(a.Ldflags) > 0 {
+		args = append(args, "-ldflags="+strings.Join(a.Ldflags, " "))
+	}
+	if gcflags := env[gcflagsEnv]; gcflags != "" {
+		args = append(args, "-gcflags="+gcflags)
+	}
+	return append(args, a.Main)
+}
+
+// platformEnv returns the environment variables that select the target platform of the `go build` command.
+func platformEnv(platform v1.Platform) []string {
+	env := []string{
+		"CGO_ENABLED=0",
+		"GOOS=" + platform.OS,
+		"GOARCH=" + platform.Architecture,
+	}
+	if platform.Architecture == "arm" && strings.HasPrefix(platform.Variant, "v") {
+		env = append(env, "GOARM="+strings.TrimPrefix(platform.Variant, "v"))
+	}
+	return env
+}
+
+// targetPlatform returns the platform to build for. Defaults to linux on the host's architecture.
+func targetPlatform(platforms []string) (v1.Platform, error) {
+	switch len(platforms) {
+	case 0:
+		return v1.Platform{OS: "linux", Architecture: runtime.GOARCH}, nil
+	case 1:
+		return docker.ParsePlatform(platforms[0])
+	default:
+		return v1.Platform{}, fmt.Errorf("ko artifacts can only be built for a single platform, got %v", platforms)
+	}
+}
+
+// binaryName returns the name of the Go binary, which is the last element of the main package path.
+func binaryName(workspace, main string) string {
+	if !strings.HasPrefix(main, ".") {
+		return path.Base(main)
+	}
+	abs, err := filepath.Abs(filepath.Join(workspace, main))
+	if err != nil {
+		return "app"
+	}
+	return filepath.Base(abs)
+}
+
+// binaryLayer returns a layer containing the Go binary in the app directory.
+func binaryLayer(binary string) (v1.Layer, error) {
+	content, err := ioutil.ReadFile(binary)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     appDir[1:] + "/",
+		Typeflag: tar.TypeDir,
+		Mode:     0555,
+	}); err != nil {
+		return nil, err
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     path.Join(appDir[1:], filepath.Base(binary)),
+		Typeflag: tar.TypeReg,
+		Mode:     0555,
+		Size:     int64(len(content)),
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(content); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	layerBytes := buf.Bytes()
+	return tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(layerBytes)), nil
+	})
+}
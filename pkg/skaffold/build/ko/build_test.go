@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"archive/tar"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildArgs(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    *latest.KoArtifact
+		env         map[string]string
+		expected    []string
+	}{
+		{
+			description: "default",
+			artifact:    &latest.KoArtifact{Main: "."},
+			expected:    []string{"build", "-o", "/tmp/app", "."},
+		},
+		{
+			description: "flags and ldflags",
+			artifact: &latest.KoArtifact{
+				Main:    "./cmd/app",
+				Flags:   []string{"-trimpath", "-v"},
+				Ldflags: []string{"-s", "-w"},
+			},
+			expected: []string{"build", "-o", "/tmp/app", "-trimpath", "-v", "-ldflags=-s -w", "./cmd/app"},
+		},
+		{
+			description: "gcflags",
+			artifact:    &latest.KoArtifact{Main: "."},
+			env:         map[string]string{"SKAFFOLD_GO_GCFLAGS": "all=-N -l"},
+			expected:    []string{"build", "-o", "/tmp/app", "-gcflags=all=-N -l", "."},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, buildArgs(test.artifact, test.env, "/tmp/app"))
+		})
+	}
+}
+
+func TestGetEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		env         []string
+		mode        config.RunMode
+		expected    map[string]string
+	}{
+		{
+			description: "dev mode",
+			env:         []string{"GOPRIVATE=example.com", "GOFLAGS={{.FLAGS}}"},
+			mode:        config.RunModes.Dev,
+			expected:    map[string]string{"GOPRIVATE": "example.com", "GOFLAGS": "-mod=vendor"},
+		},
+		{
+			description: "debug mode",
+			mode:        config.RunModes.Debug,
+			expected:    map[string]string{"SKAFFOLD_GO_GCFLAGS": "all=-N -l"},
+		},
+		{
+			description: "user defined gcflags in debug mode",
+			env:         []string{"SKAFFOLD_GO_GCFLAGS=all=-N"},
+			mode:        config.RunModes.Debug,
+			expected:    map[string]string{"SKAFFOLD_GO_GCFLAGS": "all=-N"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{"FLAGS": "-mod=vendor"})
+
+			env, err := GetEnv(&latest.KoArtifact{Env: test.env}, test.mode)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, env)
+		})
+	}
+}
+
+func TestPlatformEnv(t *testing.T) {
+	testutil.CheckDeepEqual(t, []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm64"}, platformEnv(v1.Platform{OS: "linux", Architecture: "arm64"}))
+	testutil.CheckDeepEqual(t, []string{"CGO_ENABLED=0", "GOOS=linux", "GOARCH=arm", "GOARM=7"}, platformEnv(v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}))
+}
+
+func TestTargetPlatform(t *testing.T) {
+	tests := []struct {
+		description string
+		platforms   []string
+		expected    v1.Platform
+		shouldErr   bool
+	}{
+		{
+			description: "single platform",
+			platforms:   []string{"linux/arm64"},
+			expected:    v1.Platform{OS: "linux", Architecture: "arm64"},
+		},
+		{
+			description: "multiple platforms",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			platform, err := targetPlatform(test.platforms)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, platform)
+		})
+	}
+}
+
+func TestBinaryName(t *testing.T) {
+	tests := []struct {
+		description string
+		workspace   string
+		main        string
+		expected    string
+	}{
+		{
+			description: "workspace",
+			workspace:   filepath.Join("path", "to", "app"),
+			main:        ".",
+			expected:    "app",
+		},
+		{
+			description: "relative path",
+			workspace:   ".",
+			main:        "./cmd/server",
+			expected:    "server",
+		},
+		{
+			description: "import path",
+			workspace:   ".",
+			main:        "example.com/project/cmd/worker",
+			expected:    "worker",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, binaryName(test.workspace, test.main))
+		})
+	}
+}
+
+func TestBuildImage(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		binary := t.NewTempDir().Write("app", "binary").Path("app")
+		t.Override(&baseImage, func(identifier string, platform v1.Platform, cfg docker.Config) (v1.Image, error) {
+			t.CheckDeepEqual("gcr.io/distroless/static:nonroot", identifier)
+			t.CheckDeepEqual(v1.Platform{OS: "linux", Architecture: "arm64"}, platform)
+			return empty.Image, nil
+		})
+
+		builder := NewArtifactBuilder(nil, &mockConfig{}, true)
+		img, err := builder.buildImage(&latest.KoArtifact{BaseImage: "gcr.io/distroless/static:nonroot"}, v1.Platform{OS: "linux", Architecture: "arm64"}, binary)
+		t.CheckNoError(err)
+
+		cfg, err := img.ConfigFile()
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"/ko-app/app"}, cfg.Config.Entrypoint)
+
+		layers, err := img.Layers()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(layers))
+
+		r, err := layers[0].Uncompressed()
+		t.CheckNoError(err)
+		defer r.Close()
+
+		files := map[string]string{}
+		tr := tar.NewReader(r)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			t.CheckNoError(err)
+			content, err := ioutil.ReadAll(tr)
+			t.CheckNoError(err)
+			files[hdr.Name] = string(content)
+		}
+		t.CheckDeepEqual(map[string]string{"ko-app/": "", "ko-app/app": "binary"}, files)
+	})
+}
+
+type mockConfig struct {
+	docker.Config
+}
+
+func (c *mockConfig) GetInsecureRegistries() map[string]bool { return nil }
+func (c *mockConfig) Mode() config.RunMode                   { return config.RunModes.Dev }
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// listTemplate prints the source files of each non standard package, one per line.
+const listTemplate = `{{if not .Standard}}{{$dir := .Dir}}` +
+	`{{range .GoFiles}}{{$dir}}/{{.}}{{"\n"}}{{end}}` +
+	`{{range .CgoFiles}}{{$dir}}/{{.}}{{"\n"}}{{end}}` +
+	`{{range .SFiles}}{{$dir}}/{{.}}{{"\n"}}{{end}}` +
+	`{{end}}`
+
+// GetDependencies lists the source files of the main package and of the packages it imports
+// that are part of the workspace, along with the module files.
+// All paths are relative to the workspace.
+func GetDependencies(ctx context.Context, workspace string, a *latest.KoArtifact) ([]string, error) {
+	absWorkspace, err := filepath.Abs(workspace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find absolute path for %q: %w", workspace, err)
+	}
+
+	cmd := exec.CommandContext(ctx, "go", "list", "-deps", "-f", listTemplate, a.Main)
+	cmd.Dir = workspace
+	stdout, err := util.RunCmdOut(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("listing Go dependencies: %w", err)
+	}
+
+	var deps []string
+	for _, file := range strings.Split(string(stdout), "\n") {
+		if file == "" {
+			continue
+		}
+
+		rel, err := filepath.Rel(absWorkspace, filepath.FromSlash(file))
+		if err != nil || strings.HasPrefix(rel, "..") {
+			// Ignore dependencies from the module cache or from outside the workspace
+			continue
+		}
+		deps = append(deps, rel)
+	}
+
+	for _, file := range []string{"go.mod", "go.sum"} {
+		if _, err := os.Stat(filepath.Join(workspace, file)); err == nil {
+			deps = append(deps, file)
+		}
+	}
+	sort.Strings(deps)
+
+	logrus.Debugf("Found dependencies for ko artifact: %v", deps)
+
+	return deps, nil
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGetDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Touch("go.mod", "main.go", "pkg/lib/lib.go").
+			Chdir()
+		workspace := tmpDir.Root()
+
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(
+			"go list -deps -f "+listTemplate+" ./cmd/app",
+			filepath.Join(workspace, "cmd", "app", "main.go")+"\n"+
+				filepath.Join(workspace, "pkg", "lib", "lib.go")+"\n"+
+				"/go/pkg/mod/example.com/dep@v1.0.0/dep.go\n",
+		))
+
+		deps, err := GetDependencies(context.Background(), ".", &latest.KoArtifact{Main: "./cmd/app"})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{filepath.Join("cmd", "app", "main.go"), "go.mod", filepath.Join("pkg", "lib", "lib.go")}, deps)
+	})
+}
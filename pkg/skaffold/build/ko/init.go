@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ko
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// For testing
+var (
+	Validate = validate
+)
+
+// Name is the name of the ko builder
+var Name = "Ko"
+
+// ArtifactConfig holds information about a Go module that can be built with ko
+type ArtifactConfig struct {
+	File string `json:"path,omitempty"`
+}
+
+// Name returns the name of the builder
+func (c ArtifactConfig) Name() string {
+	return Name
+}
+
+// Describe returns the initBuilder's string representation, used when prompting the user to choose a builder.
+func (c ArtifactConfig) Describe() string {
+	return fmt.Sprintf("%s (%s)", c.Name(), c.File)
+}
+
+// ArtifactType returns the type of the artifact to be built.
+func (c ArtifactConfig) ArtifactType(_ string) latest.ArtifactType {
+	return latest.ArtifactType{
+		KoArtifact: &latest.KoArtifact{},
+	}
+}
+
+// ConfiguredImage returns the target image configured by the builder, or empty string if no image is configured
+func (c ArtifactConfig) ConfiguredImage() string {
+	// Target image is not configured in go.mod
+	return ""
+}
+
+// Path returns the path to the build definition
+func (c ArtifactConfig) Path() string {
+	return c.File
+}
+
+// validate checks if a file is a Go module definition.
+func validate(path string) bool {
+	return filepath.Base(path) == "go.mod"
+}
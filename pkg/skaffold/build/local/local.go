@@ -25,6 +25,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
@@ -39,7 +40,10 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, a *latest.Artifact)
 }
 
 func (b *Builder) PreBuild(_ context.Context, out io.Writer) error {
-	if b.localCluster {
+	switch {
+	case b.local.Daemonless:
+		color.Default.Fprintln(out, "Building without a Docker daemon.")
+	case b.localCluster:
 		color.Default.Fprintf(out, "Found [%s] context, using local docker daemon.\n", b.kubeContext)
 	}
 	return nil
@@ -73,7 +77,8 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 	if b.pushImages {
 		// only track images for pruning when building with docker
 		// if we're pushing a bazel image, it was built directly to the registry
-		if a.DockerArtifact != nil {
+		// multi-platform images only exist in the registry as a manifest list
+		if a.DockerArtifact != nil && len(a.Platforms) <= 1 {
 			imageID, err := b.getImageIDForTag(ctx, tag)
 			if err != nil {
 				logrus.Warnf("unable to inspect image: built images may not be cleaned up correctly by skaffold")
@@ -88,6 +93,13 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Ar
 	}
 
 	imageID := digestOrImageID
+	if a.DockerArtifact == nil {
+		// the docker builder labels the images it builds. The other builders don't support labels, so they are
+		// added after the build, for `skaffold prune` to find the images.
+		if imageID, err = b.localDocker.Label(ctx, tag, map[string]string{docker.ArtifactLabel: a.ImageName}); err != nil {
+			return "", err
+		}
+	}
 	b.builtImages = append(b.builtImages, imageID)
 	return build.TagWithImageID(ctx, tag, imageID, b.localDocker)
 }
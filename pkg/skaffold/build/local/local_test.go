@@ -253,6 +253,70 @@ func TestLocalRun(t *testing.T) {
 	}
 }
 
+func TestLocalRunLabelsImages(t *testing.T) {
+	tests := []struct {
+		description    string
+		artifact       *latest.Artifact
+		expected       string
+		expectedLabels []map[string]string
+	}{
+		{
+			description: "docker builder labels the image",
+			artifact: &latest.Artifact{
+				ImageName:    "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+			},
+			expected:       "gcr.io/test/image:1",
+			expectedLabels: []map[string]string{{docker.ArtifactLabel: "gcr.io/test/image"}},
+		},
+		{
+			description: "other builders are labeled after the build",
+			artifact: &latest.Artifact{
+				ImageName:    "gcr.io/test/image",
+				ArtifactType: latest.ArtifactType{CustomArtifact: &latest.CustomArtifact{BuildCommand: "exit 0"}},
+			},
+			expected:       "gcr.io/test/image:1",
+			expectedLabels: []map[string]string{{docker.ArtifactLabel: "gcr.io/test/image"}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			api := (&testutil.FakeAPIClient{}).Add("gcr.io/test/image:tag", "sha256:abc")
+			t.Override(&docker.DefaultAuthHelper, testAuthHelper{})
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return fakeLocalDaemon(api), nil
+			})
+			t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+				return args, nil
+			})
+			testEvent.InitializeState([]latest.Pipeline{{
+				Build: latest.BuildConfig{
+					BuildType: latest.BuildType{
+						LocalBuild: &latest.LocalBuild{},
+					},
+				}}})
+
+			builder, err := NewBuilder(&mockConfig{},
+				&latest.LocalBuild{
+					Push:        util.BoolPtr(false),
+					Concurrency: &constants.DefaultLocalConcurrency,
+				})
+			t.CheckNoError(err)
+			builder.ArtifactStore(build.NewArtifactStore())
+			ab := builder.Build(context.Background(), ioutil.Discard, test.artifact)
+			res, err := ab(context.Background(), ioutil.Discard, test.artifact, "gcr.io/test/image:tag")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, res)
+			var labels []map[string]string
+			for _, b := range api.Built {
+				labels = append(labels, b.Labels)
+			}
+			t.CheckDeepEqual(test.expectedLabels, labels)
+		})
+	}
+}
+
 type dummyLocalDaemon struct {
 	docker.LocalDaemon
 }
@@ -18,6 +18,8 @@ package local
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"sort"
 	"sync"
 	"time"
@@ -27,6 +29,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
 
 const (
@@ -34,21 +37,106 @@ const (
 	usageRetryInterval = 500 * time.Millisecond
 )
 
+// for testing
+var now = time.Now
+
 type pruner struct {
 	localDocker   docker.LocalDaemon
 	pruneChildren bool
+	policy        retentionPolicy
 	pruneMutex    sync.Mutex
 	prunedImgIDs  map[string]struct{}
 }
 
-func newPruner(dockerAPI docker.LocalDaemon, pruneChildren bool) *pruner {
+func newPruner(dockerAPI docker.LocalDaemon, pruneChildren bool, policy retentionPolicy) *pruner {
 	return &pruner{
 		localDocker:   dockerAPI,
 		pruneChildren: pruneChildren,
+		policy:        policy,
 		prunedImgIDs:  make(map[string]struct{}),
 	}
 }
 
+// retentionPolicy decides which of the images built by Skaffold are removed when pruning.
+type retentionPolicy struct {
+	keepLast     int
+	maxAge       time.Duration
+	maxDiskUsage uint64
+}
+
+// newRetentionPolicy returns the policy configured for the local builder.
+// Without configuration, only the images replaced by new builds are removed.
+func newRetentionPolicy(r *latest.ImageRetention) (retentionPolicy, error) {
+	p := retentionPolicy{keepLast: 1}
+	if r == nil {
+		return p, nil
+	}
+	if r.KeepLast != nil {
+		p.keepLast = *r.KeepLast
+	}
+	if r.MaxAge != "" {
+		d, err := time.ParseDuration(r.MaxAge)
+		if err != nil {
+			return retentionPolicy{}, fmt.Errorf("parsing image retention maxAge %q: %w", r.MaxAge, err)
+		}
+		p.maxAge = d
+	}
+	if r.MaxDiskUsage != "" {
+		size, err := humanize.ParseBytes(r.MaxDiskUsage)
+		if err != nil {
+			return retentionPolicy{}, fmt.Errorf("parsing image retention maxDiskUsage %q: %w", r.MaxDiskUsage, err)
+		}
+		p.maxDiskUsage = size
+	}
+	return p, nil
+}
+
+// selectImages returns the images that break the policy.
+// The images of each artifact are sorted from the most recent one, and the first inUse[artifact] images are always kept.
+func (p retentionPolicy) selectImages(images map[string][]types.ImageSummary, inUse map[string]int) []types.ImageSummary {
+	var artifacts []string
+	for a := range images {
+		artifacts = append(artifacts, a)
+	}
+	sort.Strings(artifacts)
+
+	var toPrune, kept []types.ImageSummary
+	var usage uint64
+	for _, a := range artifacts {
+		for i, img := range images[a] {
+			switch {
+			case i < inUse[a]:
+				usage += uint64(img.Size)
+			case i >= p.keepLast || p.expired(img):
+				toPrune = append(toPrune, img)
+			default:
+				usage += uint64(img.Size)
+				kept = append(kept, img)
+			}
+		}
+	}
+
+	if p.maxDiskUsage == 0 || usage <= p.maxDiskUsage {
+		return toPrune
+	}
+	// remove the oldest images first until the disk usage is back under the limit.
+	sort.SliceStable(kept, func(i, j int) bool {
+		return kept[i].Created < kept[j].Created
+	})
+	for _, img := range kept {
+		if usage <= p.maxDiskUsage {
+			break
+		}
+		toPrune = append(toPrune, img)
+		usage -= uint64(img.Size)
+	}
+	return toPrune
+}
+
+func (p retentionPolicy) expired(img types.ImageSummary) bool {
+	return p.maxAge > 0 && now().Sub(time.Unix(img.Created, 0)) > p.maxAge
+}
+
 func (p *pruner) listImages(ctx context.Context, name string) ([]types.ImageSummary, error) {
 	imgs, err := p.localDocker.ImageList(ctx, name)
 	if err != nil {
@@ -153,14 +241,8 @@ func (p *pruner) collectImagesToPrune(ctx context.Context, artifacts []string) [
 	for _, a := range artifacts {
 		imgNameCount[a]++
 	}
-	imgProcessed := make(map[string]struct{})
-	var rt []string
-	for _, a := range artifacts {
-		if _, ok := imgProcessed[a]; ok {
-			continue
-		}
-		imgProcessed[a] = struct{}{}
-
+	images := make(map[string][]types.ImageSummary)
+	for a := range imgNameCount {
 		imgs, err := p.listImages(ctx, a)
 		if err != nil {
 			switch err {
@@ -170,13 +252,37 @@ func (p *pruner) collectImagesToPrune(ctx context.Context, artifacts []string) [
 			logrus.Warnf("failed to list images: %v", err)
 			continue
 		}
-		for i := imgNameCount[a]; i < len(imgs); i++ {
-			rt = append(rt, imgs[i].ID)
-		}
+		images[a] = imgs
+	}
+
+	var rt []string
+	for _, img := range p.policy.selectImages(images, imgNameCount) {
+		rt = append(rt, img.ID)
 	}
 	return rt
 }
 
+// collectLabeledImagesToPrune returns the images built by Skaffold, across all projects, that break the retention policy.
+func (p *pruner) collectLabeledImagesToPrune(ctx context.Context) ([]types.ImageSummary, error) {
+	imgs, err := p.localDocker.LabeledImages(ctx, docker.ArtifactLabel)
+	if err != nil {
+		return nil, fmt.Errorf("listing images built by Skaffold: %w", err)
+	}
+
+	images := make(map[string][]types.ImageSummary)
+	for _, img := range imgs {
+		a := img.Labels[docker.ArtifactLabel]
+		images[a] = append(images[a], img)
+	}
+	for _, imgs := range images {
+		sort.SliceStable(imgs, func(i, j int) bool {
+			// reverse sort
+			return imgs[i].Created > imgs[j].Created
+		})
+	}
+	return p.policy.selectImages(images, nil), nil
+}
+
 func (p *pruner) diskUsage(ctx context.Context) (uint64, error) {
 	for retry := 0; retry < usageRetries-1; retry++ {
 		usage, err := p.localDocker.DiskUsage(ctx)
@@ -199,3 +305,51 @@ func (p *pruner) diskUsage(ctx context.Context) (uint64, error) {
 	logrus.Debugf("Failed to get usage after: %v. giving up", err)
 	return 0, err
 }
+
+// PruneImages removes the images built by Skaffold on the local Docker daemon that break the given retention policy.
+// With dryRun, the images are only listed.
+func PruneImages(ctx context.Context, out io.Writer, localDocker docker.LocalDaemon, retention *latest.ImageRetention, pruneChildren bool, dryRun bool) error {
+	policy, err := newRetentionPolicy(retention)
+	if err != nil {
+		return err
+	}
+	p := newPruner(localDocker, pruneChildren, policy)
+
+	toPrune, err := p.collectLabeledImagesToPrune(ctx)
+	if err != nil {
+		return err
+	}
+	if len(toPrune) == 0 {
+		fmt.Fprintln(out, "No images to prune.")
+		return nil
+	}
+
+	var ids []string
+	var size uint64
+	fmt.Fprintln(out, "Images to prune:")
+	for _, img := range toPrune {
+		fmt.Fprintf(out, " - %s (%s, created %s, %s)\n", imageName(img), img.Labels[docker.ArtifactLabel], humanize.RelTime(time.Unix(img.Created, 0), now(), "ago", "from now"), humanize.Bytes(uint64(img.Size)))
+		ids = append(ids, img.ID)
+		size += uint64(img.Size)
+	}
+	if dryRun {
+		fmt.Fprintf(out, "Dry run: %d image(s) would be removed, reclaiming up to %s.\n", len(ids), humanize.Bytes(size))
+		return nil
+	}
+
+	if err := p.runPrune(ctx, ids); err != nil {
+		return fmt.Errorf("pruning images: %w", err)
+	}
+	fmt.Fprintf(out, "%d image(s) removed.\n", len(ids))
+	return nil
+}
+
+// imageName returns the first tag of the image, or its ID if it isn't tagged.
+func imageName(img types.ImageSummary) string {
+	for _, tag := range img.RepoTags {
+		if tag != "<none>:<none>" {
+			return tag
+		}
+	}
+	return img.ID
+}
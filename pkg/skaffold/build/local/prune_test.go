@@ -17,10 +17,17 @@ limitations under the License.
 package local
 
 import (
+	"bytes"
 	"context"
 	"sort"
 	"testing"
+	"time"
 
+	"github.com/docker/docker/api/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -67,7 +74,7 @@ func TestDiskUsage(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{
 				DUFails: test.fails,
-			}), true)
+			}), true, retentionPolicy{keepLast: 1})
 
 			ctx := context.Background()
 			if test.ctxFunc != nil {
@@ -84,7 +91,7 @@ func TestDiskUsage(t *testing.T) {
 }
 
 func TestRunPruneOk(t *testing.T) {
-	pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{}), true)
+	pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{}), true, retentionPolicy{keepLast: 1})
 	err := pruner.runPrune(context.Background(), []string{"test"})
 	if err != nil {
 		t.Fatalf("Got an error: %v", err)
@@ -94,7 +101,7 @@ func TestRunPruneOk(t *testing.T) {
 func TestRunPruneDuFailed(t *testing.T) {
 	pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{
 		DUFails: -1,
-	}), true)
+	}), true, retentionPolicy{keepLast: 1})
 	err := pruner.runPrune(context.Background(), []string{"test"})
 	if err != nil {
 		t.Fatalf("Got an error: %v", err)
@@ -104,7 +111,7 @@ func TestRunPruneDuFailed(t *testing.T) {
 func TestRunPruneDuFailed2(t *testing.T) {
 	pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{
 		DUFails: 2,
-	}), true)
+	}), true, retentionPolicy{keepLast: 1})
 	err := pruner.runPrune(context.Background(), []string{"test"})
 	if err != nil {
 		t.Fatalf("Got an error: %v", err)
@@ -114,7 +121,7 @@ func TestRunPruneDuFailed2(t *testing.T) {
 func TestRunPruneImageRemoveFailed(t *testing.T) {
 	pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{
 		ErrImageRemove: true,
-	}), true)
+	}), true, retentionPolicy{keepLast: 1})
 	err := pruner.runPrune(context.Background(), []string{"test"})
 	if err == nil {
 		t.Fatal("An error expected here")
@@ -122,7 +129,7 @@ func TestRunPruneImageRemoveFailed(t *testing.T) {
 }
 
 func TestIsPruned(t *testing.T) {
-	pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{}), true)
+	pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{}), true, retentionPolicy{keepLast: 1})
 	err := pruner.runPrune(context.Background(),
 		[]string{"test1", "test2", "test1"})
 	if err != nil {
@@ -139,7 +146,7 @@ func TestIsPruned(t *testing.T) {
 func TestIsPrunedFail(t *testing.T) {
 	pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{
 		ErrImageRemove: true,
-	}), true)
+	}), true, retentionPolicy{keepLast: 1})
 
 	err := pruner.runPrune(context.Background(), []string{"test1"})
 	if err == nil {
@@ -179,7 +186,7 @@ func TestCollectPruneImages(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			pruner := newPruner(fakeLocalDaemon(&testutil.FakeAPIClient{
 				LocalImages: test.localImages,
-			}), true)
+			}), true, retentionPolicy{keepLast: 1})
 
 			res := pruner.collectImagesToPrune(
 				context.Background(), test.imagesToBuild)
@@ -189,3 +196,165 @@ func TestCollectPruneImages(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRetentionPolicy(t *testing.T) {
+	tests := []struct {
+		description string
+		retention   *latest.ImageRetention
+		expected    retentionPolicy
+		shouldErr   bool
+	}{
+		{
+			description: "default",
+			expected:    retentionPolicy{keepLast: 1},
+		},
+		{
+			description: "all limits",
+			retention:   &latest.ImageRetention{KeepLast: intPtr(3), MaxAge: "72h", MaxDiskUsage: "10GB"},
+			expected:    retentionPolicy{keepLast: 3, maxAge: 72 * time.Hour, maxDiskUsage: 10000000000},
+		},
+		{
+			description: "invalid max age",
+			retention:   &latest.ImageRetention{MaxAge: "3 days"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid max disk usage",
+			retention:   &latest.ImageRetention{MaxDiskUsage: "a lot"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			policy, err := newRetentionPolicy(test.retention)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, policy, cmp.AllowUnexported(retentionPolicy{}))
+		})
+	}
+}
+
+func TestRetentionPolicySelectImages(t *testing.T) {
+	current := time.Date(2021, 3, 10, 12, 0, 0, 0, time.UTC)
+	image := func(id string, age time.Duration, size int64) types.ImageSummary {
+		return types.ImageSummary{ID: id, Created: current.Add(-age).Unix(), Size: size}
+	}
+	images := map[string][]types.ImageSummary{
+		"foo": {image("foo3", time.Hour, 100), image("foo2", 30*time.Hour, 100), image("foo1", 60*time.Hour, 100)},
+		"bar": {image("bar2", 10*time.Hour, 200), image("bar1", 40*time.Hour, 200)},
+	}
+
+	tests := []struct {
+		description     string
+		policy          retentionPolicy
+		inUse           map[string]int
+		expectedToPrune []string
+	}{
+		{
+			description:     "keep last image",
+			policy:          retentionPolicy{keepLast: 1},
+			expectedToPrune: []string{"bar1", "foo1", "foo2"},
+		},
+		{
+			description:     "keep all images",
+			policy:          retentionPolicy{keepLast: 3},
+			expectedToPrune: nil,
+		},
+		{
+			description:     "max age",
+			policy:          retentionPolicy{keepLast: 3, maxAge: 36 * time.Hour},
+			expectedToPrune: []string{"bar1", "foo1"},
+		},
+		{
+			description:     "max age removes the last image",
+			policy:          retentionPolicy{keepLast: 1, maxAge: 5 * time.Hour},
+			expectedToPrune: []string{"bar1", "bar2", "foo1", "foo2"},
+		},
+		{
+			description:     "images in use are kept",
+			policy:          retentionPolicy{keepLast: 0, maxAge: time.Minute},
+			inUse:           map[string]int{"foo": 2},
+			expectedToPrune: []string{"bar1", "bar2", "foo1"},
+		},
+		{
+			description:     "max disk usage removes the oldest images first",
+			policy:          retentionPolicy{keepLast: 3, maxDiskUsage: 450},
+			expectedToPrune: []string{"bar1", "foo1"},
+		},
+		{
+			description:     "max disk usage counts the images in use",
+			policy:          retentionPolicy{keepLast: 3, maxDiskUsage: 300},
+			inUse:           map[string]int{"foo": 3},
+			expectedToPrune: []string{"bar1", "bar2"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&now, func() time.Time { return current })
+
+			var ids []string
+			for _, img := range test.policy.selectImages(images, test.inUse) {
+				ids = append(ids, img.ID)
+			}
+			sort.Strings(ids)
+			t.CheckDeepEqual(test.expectedToPrune, ids)
+		})
+	}
+}
+
+func TestPruneImages(t *testing.T) {
+	current := time.Date(2021, 3, 10, 12, 0, 0, 0, time.UTC)
+	labeled := func(id string, tag string, artifact string, age time.Duration) types.ImageSummary {
+		return types.ImageSummary{
+			ID:       id,
+			RepoTags: []string{tag},
+			Labels:   map[string]string{docker.ArtifactLabel: artifact},
+			Created:  current.Add(-age).Unix(),
+			Size:     1000000,
+		}
+	}
+	images := []types.ImageSummary{
+		labeled("sha256:1", "foo:v1", "foo", 50*time.Hour),
+		labeled("sha256:2", "foo:v2", "foo", 2*time.Hour),
+		labeled("sha256:3", "bar:v1", "bar", 30*time.Hour),
+		{ID: "sha256:4", RepoTags: []string{"other:v1"}, Created: current.Add(-100 * time.Hour).Unix()},
+	}
+
+	tests := []struct {
+		description string
+		retention   *latest.ImageRetention
+		dryRun      bool
+		expected    string
+	}{
+		{
+			description: "dry run",
+			dryRun:      true,
+			expected:    "Images to prune:\n - foo:v1 (foo, created 2 days ago, 1.0 MB)\nDry run: 1 image(s) would be removed, reclaiming up to 1.0 MB.\n",
+		},
+		{
+			description: "prune",
+			retention:   &latest.ImageRetention{MaxAge: "24h"},
+			expected:    "Images to prune:\n - bar:v1 (bar, created 1 day ago, 1.0 MB)\n - foo:v1 (foo, created 2 days ago, 1.0 MB)\n2 image(s) removed.\n",
+		},
+		{
+			description: "nothing to prune",
+			retention:   &latest.ImageRetention{KeepLast: intPtr(2)},
+			expected:    "No images to prune.\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&now, func() time.Time { return current })
+			localDocker := fakeLocalDaemon(&testutil.FakeAPIClient{LabeledImages: images})
+
+			var out bytes.Buffer
+			err := PruneImages(context.Background(), &out, localDocker, test.retention, true, test.dryRun)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, out.String())
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/custom"
 	dockerbuilder "github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/jib"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/ko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
@@ -36,7 +37,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
-// Builder uses the host docker daemon to build and tag the image.
+// Builder uses the host docker daemon, or an OCI image layout when daemonless, to build and tag the image.
 type Builder struct {
 	local latest.LocalBuild
 
@@ -55,6 +56,7 @@ type Builder struct {
 	muted              build.Muted
 	localPruner        *pruner
 	artifactStore      build.ArtifactStore
+	outputs            docker.OutputResolver
 }
 
 type Config interface {
@@ -69,12 +71,30 @@ type Config interface {
 	Muted() config.Muted
 }
 
-// NewBuilder returns an new instance of a local Builder.
-func NewBuilder(cfg Config, buildCfg *latest.LocalBuild) (*Builder, error) {
+// NewImageStore returns where the local builder stores the images it builds:
+// the Docker daemon, or an OCI image layout when daemonless.
+func NewImageStore(cfg docker.Config, buildCfg *latest.LocalBuild) (docker.LocalDaemon, error) {
+	if buildCfg.Daemonless {
+		localDocker, err := docker.NewLayoutDaemon(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("getting image layout: %w", err)
+		}
+		return localDocker, nil
+	}
+
 	localDocker, err := docker.NewAPIClient(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("getting docker client: %w", err)
 	}
+	return localDocker, nil
+}
+
+// NewBuilder returns an new instance of a local Builder.
+func NewBuilder(cfg Config, buildCfg *latest.LocalBuild) (*Builder, error) {
+	localDocker, err := NewImageStore(cfg, buildCfg)
+	if err != nil {
+		return nil, err
+	}
 
 	cluster := cfg.GetCluster()
 
@@ -85,9 +105,17 @@ func NewBuilder(cfg Config, buildCfg *latest.LocalBuild) (*Builder, error) {
 	} else {
 		pushImages = *buildCfg.Push
 	}
+	if buildCfg.Daemonless && !pushImages && cluster.Local && !cluster.LoadImages {
+		logrus.Warnf("images built with `daemonless: true` are only written to the local image layout, which the cluster of kube-context %q can't read: set `build.local.push` to true to deploy them", cfg.GetKubeContext())
+	}
 
 	tryImportMissing := buildCfg.TryImportMissing
 
+	retention, err := newRetentionPolicy(buildCfg.Retention)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Builder{
 		local:              *buildCfg,
 		cfg:                cfg,
@@ -100,7 +128,7 @@ func NewBuilder(cfg Config, buildCfg *latest.LocalBuild) (*Builder, error) {
 		mode:               cfg.Mode(),
 		prune:              cfg.Prune(),
 		pruneChildren:      !cfg.NoPruneChildren(),
-		localPruner:        newPruner(localDocker, !cfg.NoPruneChildren()),
+		localPruner:        newPruner(localDocker, !cfg.NoPruneChildren(), retention),
 		insecureRegistries: cfg.GetInsecureRegistries(),
 		muted:              cfg.Muted(),
 	}, nil
@@ -110,6 +138,11 @@ func (b *Builder) ArtifactStore(store build.ArtifactStore) {
 	b.artifactStore = store
 }
 
+// OutputResolver sets the resolver of the files of the file outputs required by artifacts.
+func (b *Builder) OutputResolver(r docker.OutputResolver) {
+	b.outputs = r
+}
+
 // Prune uses the docker API client to remove all images built with Skaffold
 func (b *Builder) Prune(ctx context.Context, _ io.Writer) error {
 	var toPrune []string
@@ -133,23 +166,29 @@ type artifactBuilder interface {
 // newPerArtifactBuilder returns an instance of `artifactBuilder`
 func newPerArtifactBuilder(b *Builder, a *latest.Artifact) (artifactBuilder, error) {
 	switch {
+	case b.local.Daemonless && (a.DockerArtifact != nil || a.CustomArtifact != nil || a.BuildpackArtifact != nil):
+		return nil, fmt.Errorf("%s artifacts can't be built without a Docker daemon", misc.ArtifactType(a))
+
 	case a.DockerArtifact != nil:
-		return dockerbuilder.NewArtifactBuilder(b.localDocker, b.local.UseDockerCLI, b.local.UseBuildkit, b.pushImages, b.prune, b.cfg.Mode(), b.cfg.GetInsecureRegistries(), b.artifactStore), nil
+		return dockerbuilder.NewArtifactBuilder(b.localDocker, b.local.UseDockerCLI, b.local.UseBuildkit, b.pushImages, b.prune, b.cfg.Mode(), b.cfg, b.artifactStore, b.outputs), nil
 
 	case a.BazelArtifact != nil:
 		return bazel.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages), nil
 
 	case a.JibArtifact != nil:
-		return jib.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages, b.skipTests, b.artifactStore), nil
+		return jib.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages, b.local.Daemonless, b.skipTests, b.artifactStore), nil
 
 	case a.CustomArtifact != nil:
 		// required artifacts as environment variables
 		dependencies := util.EnvPtrMapToSlice(docker.ResolveDependencyImages(a.Dependencies, b.artifactStore, true), "=")
-		return custom.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages, append(b.retrieveExtraEnv(), dependencies...)), nil
+		return custom.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages, append(b.retrieveExtraEnv(), dependencies...), b.artifactStore), nil
 
 	case a.BuildpackArtifact != nil:
 		return buildpacks.NewArtifactBuilder(b.localDocker, b.pushImages, b.mode, b.artifactStore), nil
 
+	case a.KoArtifact != nil:
+		return ko.NewArtifactBuilder(b.localDocker, b.cfg, b.pushImages), nil
+
 	default:
 		return nil, fmt.Errorf("unexpected type %q for local artifact:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
 	}
@@ -31,6 +31,7 @@ const (
 	Jib       = "jib"
 	Custom    = "custom"
 	Buildpack = "buildpack"
+	Ko        = "ko"
 )
 
 // ArtifactType returns a string representing the type found in an artifact. Used for error messages.
@@ -49,11 +50,30 @@ func ArtifactType(a *latest.Artifact) string {
 		return Custom
 	case a.BuildpackArtifact != nil:
 		return Buildpack
+	case a.KoArtifact != nil:
+		return Ko
 	default:
 		return ""
 	}
 }
 
+// SupportsPlatforms returns whether the artifact can be built for the given number of target platforms
+// by the builder of the build configuration.
+func SupportsPlatforms(bc latest.BuildConfig, a *latest.Artifact, count int) bool {
+	switch at := ArtifactType(a); {
+	case bc.LocalBuild != nil && at == Docker:
+		return true
+	case bc.LocalBuild != nil && at == Ko:
+		return count == 1
+	case bc.Cluster != nil && at == Kaniko:
+		return true
+	case bc.Cluster != nil && bc.Cluster.BuildKit != nil && at == Docker:
+		return true
+	default:
+		return false
+	}
+}
+
 // FormatArtifact returns a string representation of an artifact for usage in error messages
 func FormatArtifact(a *latest.Artifact) string {
 	buf, err := yaml.Marshal(a)
@@ -39,6 +39,11 @@ func TestArtifactType(t *testing.T) {
 				KanikoArtifact: &latest.KanikoArtifact{},
 			},
 		}},
+		{"ko", "ko", &latest.Artifact{
+			ArtifactType: latest.ArtifactType{
+				KoArtifact: &latest.KoArtifact{},
+			},
+		}},
 		{"docker+kaniko", "docker", &latest.Artifact{
 			ArtifactType: latest.ArtifactType{
 				DockerArtifact: &latest.DockerArtifact{},
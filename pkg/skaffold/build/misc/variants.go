@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package misc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// ExpandVariants replaces each artifact that has variants with one artifact per variant,
+// so that the variants are built, cached, tagged and deployed as independent images.
+// Artifacts without variants are kept as is.
+func ExpandVariants(artifacts []*latest.Artifact) []*latest.Artifact {
+	var expanded []*latest.Artifact
+	for _, a := range artifacts {
+		if len(a.Variants) == 0 {
+			expanded = append(expanded, a)
+			continue
+		}
+		for _, v := range a.Variants {
+			expanded = append(expanded, variantArtifact(a, v))
+		}
+	}
+	return expanded
+}
+
+// VariantImageName returns the image name of a variant: `<artifact image>-<name>`, unless the variant sets its own.
+func VariantImageName(a *latest.Artifact, v *latest.ArtifactVariant) string {
+	if v.ImageName != "" {
+		return v.ImageName
+	}
+	return fmt.Sprintf("%s-%s", a.ImageName, v.Name)
+}
+
+// VariantTargets adds the image names of their variants to the target images that select an artifact with variants,
+// so that `--build-image <artifact image>` builds all the variants of that artifact.
+func VariantTargets(targets []string, artifacts []*latest.Artifact) []string {
+	if len(targets) == 0 {
+		return targets
+	}
+
+	result := append([]string{}, targets...)
+	for _, a := range artifacts {
+		if len(a.Variants) == 0 || !matchesAny(a.ImageName, targets) {
+			continue
+		}
+		for _, v := range a.Variants {
+			result = append(result, VariantImageName(a, v))
+		}
+	}
+	return result
+}
+
+// matchesAny uses the same matching as `--build-image`.
+func matchesAny(imageName string, targets []string) bool {
+	for _, t := range targets {
+		if strings.Contains(imageName, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// variantArtifact returns the artifact that builds a variant. It shares the workspace, dependencies and sync rules
+// of the artifact, with the build args and target stage of the variant.
+func variantArtifact(a *latest.Artifact, v *latest.ArtifactVariant) *latest.Artifact {
+	variant := *a
+	variant.Variants = nil
+	variant.ImageName = VariantImageName(a, v)
+
+	switch {
+	case a.DockerArtifact != nil:
+		docker := *a.DockerArtifact
+		docker.BuildArgs = withBuildArgs(docker.BuildArgs, v.BuildArgs)
+		if v.Target != "" {
+			docker.Target = v.Target
+		}
+		variant.DockerArtifact = &docker
+	case a.KanikoArtifact != nil:
+		kaniko := *a.KanikoArtifact
+		kaniko.BuildArgs = withBuildArgs(kaniko.BuildArgs, v.BuildArgs)
+		if v.Target != "" {
+			kaniko.Target = v.Target
+		}
+		variant.KanikoArtifact = &kaniko
+	}
+	return &variant
+}
+
+// withBuildArgs returns a copy of the build args with the given overrides.
+func withBuildArgs(args, overrides map[string]*string) map[string]*string {
+	if len(args) == 0 && len(overrides) == 0 {
+		return args
+	}
+	merged := map[string]*string{}
+	for k, v := range args {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
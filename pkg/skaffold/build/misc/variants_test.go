@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package misc
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestExpandVariants(t *testing.T) {
+	debug, release, distro := "debug", "release", "alpine"
+
+	tests := []struct {
+		description string
+		artifacts   []*latest.Artifact
+		expected    []*latest.Artifact
+	}{
+		{
+			description: "no variants",
+			artifacts:   []*latest.Artifact{{ImageName: "app"}},
+			expected:    []*latest.Artifact{{ImageName: "app"}},
+		},
+		{
+			description: "docker artifact",
+			artifacts: []*latest.Artifact{{
+				ImageName: "app",
+				Workspace: "app",
+				Sync:      &latest.Sync{Infer: []string{"**/*.js"}},
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+					DockerfilePath: "Dockerfile",
+					BuildArgs:      map[string]*string{"DISTRO": &distro},
+				}},
+				Variants: []*latest.ArtifactVariant{
+					{Name: "debug", BuildArgs: map[string]*string{"MODE": &debug}, Target: "debug"},
+					{Name: "release", ImageName: "app", BuildArgs: map[string]*string{"MODE": &release}},
+				},
+			}},
+			expected: []*latest.Artifact{
+				{
+					ImageName: "app-debug",
+					Workspace: "app",
+					Sync:      &latest.Sync{Infer: []string{"**/*.js"}},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+						BuildArgs:      map[string]*string{"DISTRO": &distro, "MODE": &debug},
+						Target:         "debug",
+					}},
+				},
+				{
+					ImageName: "app",
+					Workspace: "app",
+					Sync:      &latest.Sync{Infer: []string{"**/*.js"}},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+						DockerfilePath: "Dockerfile",
+						BuildArgs:      map[string]*string{"DISTRO": &distro, "MODE": &release},
+					}},
+				},
+			},
+		},
+		{
+			description: "kaniko artifact",
+			artifacts: []*latest.Artifact{
+				{ImageName: "other"},
+				{
+					ImageName:    "app",
+					ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{DockerfilePath: "Dockerfile"}},
+					Variants:     []*latest.ArtifactVariant{{Name: "debug", Target: "debug"}},
+				},
+			},
+			expected: []*latest.Artifact{
+				{ImageName: "other"},
+				{
+					ImageName:    "app-debug",
+					ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{DockerfilePath: "Dockerfile", Target: "debug"}},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			expanded := ExpandVariants(test.artifacts)
+
+			t.CheckDeepEqual(test.expected, expanded)
+			t.CheckDeepEqual(test.expected, ExpandVariants(expanded))
+		})
+	}
+}
+
+func TestExpandVariantsDoesNotChangeArtifact(t *testing.T) {
+	distro, debug := "alpine", "debug"
+	artifact := &latest.Artifact{
+		ImageName:    "app",
+		ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{BuildArgs: map[string]*string{"DISTRO": &distro}}},
+		Variants:     []*latest.ArtifactVariant{{Name: "debug", BuildArgs: map[string]*string{"MODE": &debug}, Target: "debug"}},
+	}
+
+	ExpandVariants([]*latest.Artifact{artifact})
+
+	testutil.CheckDeepEqual(t, map[string]*string{"DISTRO": &distro}, artifact.DockerArtifact.BuildArgs)
+	testutil.CheckDeepEqual(t, "", artifact.DockerArtifact.Target)
+}
+
+func TestVariantTargets(t *testing.T) {
+	artifacts := []*latest.Artifact{
+		{ImageName: "gcr.io/project/app", Variants: []*latest.ArtifactVariant{{Name: "debug"}, {Name: "release", ImageName: "gcr.io/project/app"}}},
+		{ImageName: "gcr.io/project/other"},
+	}
+
+	tests := []struct {
+		description string
+		targets     []string
+		expected    []string
+	}{
+		{
+			description: "no targets",
+		},
+		{
+			description: "target without variants",
+			targets:     []string{"other"},
+			expected:    []string{"other"},
+		},
+		{
+			description: "target with variants",
+			targets:     []string{"app"},
+			expected:    []string{"app", "gcr.io/project/app-debug", "gcr.io/project/app"},
+		},
+		{
+			description: "variant target",
+			targets:     []string{"gcr.io/project/app-debug"},
+			expected:    []string{"gcr.io/project/app-debug"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, VariantTargets(test.targets, artifacts))
+		})
+	}
+}
@@ -18,6 +18,8 @@ package build
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 )
@@ -77,18 +79,61 @@ func createNodes(artifacts []*latest.Artifact) []node {
 	return nodes
 }
 
-// countingSemaphore uses a buffered channel of size `n` that acts like a counting semaphore, allowing up to `n` concurrent operations
-type countingSemaphore struct {
-	sem chan bool
+// prioritySemaphore allows up to `n` concurrent operations. When more operations are waiting than there are free slots,
+// the one with the highest priority goes first, using the lowest index to break ties.
+// It holds back the first slots until `initial` operations are waiting so that the builds which are ready from
+// the start are ordered by priority, rather than by whichever goroutine happens to run first.
+type prioritySemaphore struct {
+	mutex     sync.Mutex
+	available int
+	initial   int
+	waiting   []*waiter
 }
 
-func newCountingSemaphore(count int) countingSemaphore {
-	return countingSemaphore{sem: make(chan bool, count)}
+type waiter struct {
+	priority time.Duration
+	index    int
+	ready    chan struct{}
 }
 
-func (c countingSemaphore) acquire() (release func()) {
-	c.sem <- true
+func newPrioritySemaphore(count int, initial int) *prioritySemaphore {
+	return &prioritySemaphore{available: count, initial: initial}
+}
+
+func (s *prioritySemaphore) acquire(priority time.Duration, index int) (release func()) {
+	w := &waiter{priority: priority, index: index, ready: make(chan struct{})}
+
+	s.mutex.Lock()
+	s.waiting = append(s.waiting, w)
+	if s.initial > 0 {
+		s.initial--
+	}
+	s.dispatch()
+	s.mutex.Unlock()
+
+	<-w.ready
 	return func() {
-		<-c.sem
+		s.mutex.Lock()
+		s.available++
+		s.dispatch()
+		s.mutex.Unlock()
+	}
+}
+
+// dispatch hands out free slots to the highest priority waiters. It must be called with the mutex held.
+func (s *prioritySemaphore) dispatch() {
+	if s.initial > 0 {
+		return
+	}
+	for s.available > 0 && len(s.waiting) > 0 {
+		next := 0
+		for i, w := range s.waiting {
+			if w.priority > s.waiting[next].priority || (w.priority == s.waiting[next].priority && w.index < s.waiting[next].index) {
+				next = i
+			}
+		}
+		close(s.waiting[next].ready)
+		s.waiting = append(s.waiting[:next], s.waiting[next+1:]...)
+		s.available--
 	}
 }
@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// buildPlan prioritises the artifacts on the longest dependency chain, based on their build durations in previous runs.
+// All slices are indexed like the artifacts slice.
+type buildPlan struct {
+	estimates  []time.Duration
+	priorities []time.Duration
+	dependents [][]int
+	depCounts  []int
+	// hasHistory is true if at least one of the artifacts was built before.
+	hasHistory bool
+}
+
+func newBuildPlan(artifacts []*latest.Artifact, timings BuildTimings) buildPlan {
+	p := buildPlan{
+		estimates:  make([]time.Duration, len(artifacts)),
+		priorities: make([]time.Duration, len(artifacts)),
+		dependents: make([][]int, len(artifacts)),
+		depCounts:  make([]int, len(artifacts)),
+	}
+
+	indices := make(map[string]int)
+	for i, a := range artifacts {
+		indices[a.ImageName] = i
+	}
+	for i, a := range artifacts {
+		for _, d := range a.Dependencies {
+			j, found := indices[d.ImageName]
+			if !found {
+				// dependencies not present in `artifacts` are ignored, like in `createNodes`.
+				continue
+			}
+			p.dependents[j] = append(p.dependents[j], i)
+			p.depCounts[i]++
+		}
+	}
+
+	// artifacts that were never built are assumed to take as long as the average known artifact.
+	var total time.Duration
+	var known int
+	var unknown []int
+	for i, a := range artifacts {
+		if d, found := timings.Get(a.ImageName); found {
+			p.estimates[i] = d
+			total += d
+			known++
+		} else {
+			unknown = append(unknown, i)
+		}
+	}
+	if known > 0 {
+		p.hasHistory = true
+		for _, i := range unknown {
+			p.estimates[i] = total / time.Duration(known)
+		}
+	}
+
+	// the priority of an artifact is the length of the longest chain of builds that starts with it.
+	visited := make([]bool, len(artifacts))
+	var visit func(i int) time.Duration
+	visit = func(i int) time.Duration {
+		if visited[i] {
+			return p.priorities[i]
+		}
+		visited[i] = true
+		var longest time.Duration
+		for _, j := range p.dependents[i] {
+			if d := visit(j); d > longest {
+				longest = d
+			}
+		}
+		p.priorities[i] = p.estimates[i] + longest
+		return p.priorities[i]
+	}
+	for i := range artifacts {
+		visit(i)
+	}
+	return p
+}
+
+// initiallyReady returns the number of artifacts that don't wait for any other artifact.
+func (p buildPlan) initiallyReady() int {
+	var count int
+	for _, c := range p.depCounts {
+		if c == 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// simulate replays the schedule with the given concurrency and returns the order in which
+// the artifacts are expected to start building and the estimated time for all of them to finish.
+func (p buildPlan) simulate(concurrency int) ([]int, time.Duration) {
+	type running struct {
+		index int
+		end   time.Duration
+	}
+
+	depCounts := append([]int(nil), p.depCounts...)
+	var ready []int
+	for i, c := range depCounts {
+		if c == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	var order []int
+	var inProgress []running
+	var now time.Duration
+	for len(ready) > 0 || len(inProgress) > 0 {
+		for len(inProgress) < concurrency && len(ready) > 0 {
+			next := 0
+			for k, i := range ready {
+				if p.priorities[i] > p.priorities[ready[next]] || (p.priorities[i] == p.priorities[ready[next]] && i < ready[next]) {
+					next = k
+				}
+			}
+			i := ready[next]
+			ready = append(ready[:next], ready[next+1:]...)
+			order = append(order, i)
+			inProgress = append(inProgress, running{index: i, end: now + p.estimates[i]})
+		}
+
+		first := 0
+		for k, r := range inProgress {
+			if r.end < inProgress[first].end {
+				first = k
+			}
+		}
+		done := inProgress[first]
+		inProgress = append(inProgress[:first], inProgress[first+1:]...)
+		now = done.end
+		for _, j := range p.dependents[done.index] {
+			depCounts[j]--
+			if depCounts[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+	return order, now
+}
@@ -134,22 +134,29 @@ func newLogAggregator(out io.Writer, capacity int, concurrency int) logAggregato
 // ArtifactStore stores the results of each artifact build.
 type ArtifactStore interface {
 	Record(a *latest.Artifact, tag string)
+	RecordAdditionalTags(imageName string, tags []string)
 	GetImageTag(imageName string) (tag string, found bool)
 	GetArtifacts(s []*latest.Artifact) ([]Artifact, error)
 }
 
 func NewArtifactStore() ArtifactStore {
-	return &artifactStoreImpl{m: new(sync.Map)}
+	return &artifactStoreImpl{m: new(sync.Map), additionalTags: new(sync.Map)}
 }
 
 type artifactStoreImpl struct {
-	m *sync.Map
+	m              *sync.Map
+	additionalTags *sync.Map
 }
 
 func (ba *artifactStoreImpl) Record(a *latest.Artifact, tag string) {
 	ba.m.Store(a.ImageName, tag)
 }
 
+// RecordAdditionalTags records the other tags given to an image by its last build.
+func (ba *artifactStoreImpl) RecordAdditionalTags(imageName string, tags []string) {
+	ba.additionalTags.Store(imageName, tags)
+}
+
 func (ba *artifactStoreImpl) GetImageTag(imageName string) (string, bool) {
 	v, ok := ba.m.Load(imageName)
 	if !ok {
@@ -169,7 +176,11 @@ func (ba *artifactStoreImpl) GetArtifacts(s []*latest.Artifact) ([]Artifact, err
 		if !found {
 			return nil, fmt.Errorf("failed to retrieve build result for image %s", a.ImageName)
 		}
-		builds = append(builds, Artifact{ImageName: a.ImageName, Tag: t})
+		var additionalTags []string
+		if v, found := ba.additionalTags.Load(a.ImageName); found {
+			additionalTags = v.([]string)
+		}
+		builds = append(builds, Artifact{ImageName: a.ImageName, Tag: t, AdditionalTags: additionalTags})
 	}
 	return builds, nil
 }
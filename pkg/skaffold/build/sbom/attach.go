@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+// for testing
+var pushImage = docker.PushImage
+
+// attachmentTag returns the tag of the SBOM attached to an image, following the convention of `cosign`:
+// `<repository>:sha256-<image digest hex>.sbom`.
+func attachmentTag(image string) (string, error) {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	if ref.Digest == "" {
+		return "", fmt.Errorf("image %q has no digest", image)
+	}
+	return fmt.Sprintf("%s:%s.sbom", ref.BaseName, strings.Replace(ref.Digest, ":", "-", 1)), nil
+}
+
+// attach pushes the SBOM document as an OCI artifact with a single layer next to the image.
+func attach(doc []byte, mt string, image string, cfg docker.Config) (string, error) {
+	tag, err := attachmentTag(image)
+	if err != nil {
+		return "", err
+	}
+
+	img, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:     docker.NewStaticLayer(doc, types.MediaType(mt)),
+		MediaType: types.MediaType(mt),
+	})
+	if err != nil {
+		return "", err
+	}
+	img = mutate.MediaType(img, types.OCIManifestSchema1)
+
+	if _, err := pushImage(img, tag, cfg); err != nil {
+		return "", err
+	}
+	return tag, nil
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
+)
+
+// source describes what went into an image.
+type source struct {
+	imageName string
+	image     string
+	// digest is empty if the image was not pushed.
+	digest string
+	// layers are the diff IDs of the image's layers.
+	layers   []string
+	files    []file
+	required []requiredImage
+	created  time.Time
+}
+
+type file struct {
+	path   string
+	sha256 string
+}
+
+type requiredImage struct {
+	imageName string
+	image     string
+}
+
+// document renders the SBOM of an image in the given format.
+func document(format string, src source) ([]byte, error) {
+	switch format {
+	case SPDX:
+		return json.MarshalIndent(spdxDocument(src), "", "  ")
+	case CycloneDX:
+		return json.MarshalIndent(cycloneDXDocument(src), "", "  ")
+	default:
+		return nil, fmt.Errorf("unknown SBOM format %q", format)
+	}
+}
+
+func mediaType(format string) string {
+	if format == CycloneDX {
+		return CycloneDXMediaType
+	}
+	return SPDXMediaType
+}
+
+type spdx struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Files             []spdxFile         `json:"files,omitempty"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string         `json:"SPDXID"`
+	Name             string         `json:"name"`
+	VersionInfo      string         `json:"versionInfo,omitempty"`
+	DownloadLocation string         `json:"downloadLocation"`
+	FilesAnalyzed    bool           `json:"filesAnalyzed"`
+	Checksums        []spdxChecksum `json:"checksums,omitempty"`
+}
+
+type spdxFile struct {
+	SPDXID    string         `json:"SPDXID"`
+	FileName  string         `json:"fileName"`
+	Checksums []spdxChecksum `json:"checksums"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func spdxDocument(src source) spdx {
+	const imageID = "SPDXRef-Image"
+
+	image := spdxPackage{
+		SPDXID:           imageID,
+		Name:             src.imageName,
+		VersionInfo:      src.image,
+		DownloadLocation: "NOASSERTION",
+	}
+	if src.digest != "" {
+		image.Checksums = []spdxChecksum{sha256Checksum(src.digest)}
+	}
+
+	doc := spdx{
+		SPDXVersion:       "SPDX-2.2",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              src.image,
+		DocumentNamespace: fmt.Sprintf("https://skaffold.dev/spdx/%s-%s", src.imageName, uuid.New()),
+		CreationInfo: spdxCreationInfo{
+			Created:  src.created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: skaffold-" + version.Get().Version},
+		},
+		Packages: []spdxPackage{image},
+		Relationships: []spdxRelationship{
+			{SPDXElementID: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSPDXElement: imageID},
+		},
+	}
+
+	for i, l := range src.layers {
+		id := fmt.Sprintf("SPDXRef-Layer-%d", i)
+		doc.Packages = append(doc.Packages, spdxPackage{
+			SPDXID:           id,
+			Name:             l,
+			DownloadLocation: "NOASSERTION",
+			Checksums:        []spdxChecksum{sha256Checksum(l)},
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{SPDXElementID: imageID, RelationshipType: "CONTAINS", RelatedSPDXElement: id})
+	}
+
+	for i, r := range src.required {
+		id := fmt.Sprintf("SPDXRef-Required-%d", i)
+		doc.Packages = append(doc.Packages, spdxPackage{
+			SPDXID:           id,
+			Name:             r.imageName,
+			VersionInfo:      r.image,
+			DownloadLocation: "NOASSERTION",
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{SPDXElementID: imageID, RelationshipType: "DEPENDS_ON", RelatedSPDXElement: id})
+	}
+
+	for i, f := range src.files {
+		id := fmt.Sprintf("SPDXRef-File-%d", i)
+		doc.Files = append(doc.Files, spdxFile{
+			SPDXID:    id,
+			FileName:  "./" + f.path,
+			Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: f.sha256}},
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{SPDXElementID: imageID, RelationshipType: "GENERATED_FROM", RelatedSPDXElement: id})
+	}
+
+	return doc
+}
+
+func sha256Checksum(digest string) spdxChecksum {
+	return spdxChecksum{Algorithm: "SHA256", ChecksumValue: strings.TrimPrefix(digest, "sha256:")}
+}
+
+type cycloneDX struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Vendor  string `json:"vendor"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type cycloneDXComponent struct {
+	Type    string          `json:"type"`
+	Name    string          `json:"name"`
+	Version string          `json:"version,omitempty"`
+	Hashes  []cycloneDXHash `json:"hashes,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+func cycloneDXDocument(src source) cycloneDX {
+	image := cycloneDXComponent{
+		Type:    "container",
+		Name:    src.imageName,
+		Version: src.image,
+	}
+	if src.digest != "" {
+		image.Hashes = []cycloneDXHash{sha256Hash(src.digest)}
+	}
+
+	doc := cycloneDX{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.3",
+		SerialNumber: "urn:uuid:" + uuid.New().String(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: src.created.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Vendor: "Skaffold", Name: "skaffold", Version: version.Get().Version}},
+			Component: image,
+		},
+		Components: []cycloneDXComponent{},
+	}
+
+	for _, l := range src.layers {
+		doc.Components = append(doc.Components, cycloneDXComponent{
+			Type:   "file",
+			Name:   l,
+			Hashes: []cycloneDXHash{sha256Hash(l)},
+		})
+	}
+	for _, r := range src.required {
+		doc.Components = append(doc.Components, cycloneDXComponent{
+			Type:    "container",
+			Name:    r.imageName,
+			Version: r.image,
+		})
+	}
+	for _, f := range src.files {
+		doc.Components = append(doc.Components, cycloneDXComponent{
+			Type:   "file",
+			Name:   f.path,
+			Hashes: []cycloneDXHash{{Alg: "SHA-256", Content: f.sha256}},
+		})
+	}
+
+	return doc
+}
+
+func sha256Hash(digest string) cycloneDXHash {
+	return cycloneDXHash{Alg: "SHA-256", Content: strings.TrimPrefix(digest, "sha256:")}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+var testSource = source{
+	imageName: "gcr.io/project/image",
+	image:     "gcr.io/project/image:v1@sha256:aaaa",
+	digest:    "sha256:aaaa",
+	layers:    []string{"sha256:l1", "sha256:l2"},
+	files:     []file{{path: "Dockerfile", sha256: "f1"}, {path: "src/main.go", sha256: "f2"}},
+	required:  []requiredImage{{imageName: "base", image: "base:v1@sha256:bbbb"}},
+	created:   time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC),
+}
+
+func TestSPDXDocument(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&version.Get, func() *version.Info { return &version.Info{Version: "v1.20.0"} })
+
+		doc := spdxDocument(testSource)
+
+		t.CheckDeepEqual("SPDX-2.2", doc.SPDXVersion)
+		t.CheckDeepEqual("gcr.io/project/image:v1@sha256:aaaa", doc.Name)
+		t.CheckDeepEqual(spdxCreationInfo{Created: "2021-03-01T10:00:00Z", Creators: []string{"Tool: skaffold-v1.20.0"}}, doc.CreationInfo)
+		t.CheckDeepEqual([]spdxPackage{
+			{SPDXID: "SPDXRef-Image", Name: "gcr.io/project/image", VersionInfo: "gcr.io/project/image:v1@sha256:aaaa", DownloadLocation: "NOASSERTION", Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: "aaaa"}}},
+			{SPDXID: "SPDXRef-Layer-0", Name: "sha256:l1", DownloadLocation: "NOASSERTION", Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: "l1"}}},
+			{SPDXID: "SPDXRef-Layer-1", Name: "sha256:l2", DownloadLocation: "NOASSERTION", Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: "l2"}}},
+			{SPDXID: "SPDXRef-Required-0", Name: "base", VersionInfo: "base:v1@sha256:bbbb", DownloadLocation: "NOASSERTION"},
+		}, doc.Packages)
+		t.CheckDeepEqual([]spdxFile{
+			{SPDXID: "SPDXRef-File-0", FileName: "./Dockerfile", Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: "f1"}}},
+			{SPDXID: "SPDXRef-File-1", FileName: "./src/main.go", Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: "f2"}}},
+		}, doc.Files)
+		t.CheckDeepEqual([]spdxRelationship{
+			{SPDXElementID: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSPDXElement: "SPDXRef-Image"},
+			{SPDXElementID: "SPDXRef-Image", RelationshipType: "CONTAINS", RelatedSPDXElement: "SPDXRef-Layer-0"},
+			{SPDXElementID: "SPDXRef-Image", RelationshipType: "CONTAINS", RelatedSPDXElement: "SPDXRef-Layer-1"},
+			{SPDXElementID: "SPDXRef-Image", RelationshipType: "DEPENDS_ON", RelatedSPDXElement: "SPDXRef-Required-0"},
+			{SPDXElementID: "SPDXRef-Image", RelationshipType: "GENERATED_FROM", RelatedSPDXElement: "SPDXRef-File-0"},
+			{SPDXElementID: "SPDXRef-Image", RelationshipType: "GENERATED_FROM", RelatedSPDXElement: "SPDXRef-File-1"},
+		}, doc.Relationships)
+	})
+}
+
+func TestCycloneDXDocument(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&version.Get, func() *version.Info { return &version.Info{Version: "v1.20.0"} })
+
+		doc := cycloneDXDocument(testSource)
+
+		t.CheckDeepEqual("CycloneDX", doc.BOMFormat)
+		t.CheckDeepEqual("1.3", doc.SpecVersion)
+		t.CheckDeepEqual(cycloneDXMetadata{
+			Timestamp: "2021-03-01T10:00:00Z",
+			Tools:     []cycloneDXTool{{Vendor: "Skaffold", Name: "skaffold", Version: "v1.20.0"}},
+			Component: cycloneDXComponent{Type: "container", Name: "gcr.io/project/image", Version: "gcr.io/project/image:v1@sha256:aaaa", Hashes: []cycloneDXHash{{Alg: "SHA-256", Content: "aaaa"}}},
+		}, doc.Metadata)
+		t.CheckDeepEqual([]cycloneDXComponent{
+			{Type: "file", Name: "sha256:l1", Hashes: []cycloneDXHash{{Alg: "SHA-256", Content: "l1"}}},
+			{Type: "file", Name: "sha256:l2", Hashes: []cycloneDXHash{{Alg: "SHA-256", Content: "l2"}}},
+			{Type: "container", Name: "base", Version: "base:v1@sha256:bbbb"},
+			{Type: "file", Name: "Dockerfile", Hashes: []cycloneDXHash{{Alg: "SHA-256", Content: "f1"}}},
+			{Type: "file", Name: "src/main.go", Hashes: []cycloneDXHash{{Alg: "SHA-256", Content: "f2"}}},
+		}, doc.Components)
+	})
+}
+
+func TestDocument(t *testing.T) {
+	tests := []struct {
+		format    string
+		field     string
+		shouldErr bool
+	}{
+		{format: SPDX, field: "spdxVersion"},
+		{format: CycloneDX, field: "bomFormat"},
+		{format: "unknown", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.format, func(t *testutil.T) {
+			doc, err := document(test.format, testSource)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				var fields map[string]interface{}
+				t.CheckNoError(json.Unmarshal(doc, &fields))
+				t.CheckTrue(fields[test.field] != nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// for testing
+var (
+	now             = time.Now
+	imageLayers     = layersOfImage
+	newAPIClient    = docker.NewAPIClientImpl
+	newLayoutDaemon = docker.NewLayoutDaemonImpl
+	remoteConfig    = docker.RetrieveRemoteConfig
+)
+
+type generator struct {
+	cfg          Config
+	lister       DependencyLister
+	isLocalImage func(imageName string) (bool, error)
+}
+
+func (g *generator) Generate(ctx context.Context, out io.Writer, artifacts []*latest.Artifact, builds []build.Artifact) error {
+	images := make(map[string]string)
+	for _, b := range builds {
+		images[b.ImageName] = b.Tag
+	}
+
+	for _, a := range artifacts {
+		p, found := g.cfg.PipelineForImage(a.ImageName)
+		if !found || p.Build.SBOM == nil {
+			continue
+		}
+		image, found := images[a.ImageName]
+		if !found {
+			continue
+		}
+
+		if err := g.generate(ctx, out, a, image, p.Build, images); err != nil {
+			return fmt.Errorf("generating SBOM for %q: %w", a.ImageName, err)
+		}
+	}
+	return nil
+}
+
+func (g *generator) generate(ctx context.Context, out io.Writer, a *latest.Artifact, image string, bc latest.BuildConfig, images map[string]string) error {
+	local, err := g.isLocalImage(a.ImageName)
+	if err != nil {
+		return err
+	}
+	format := bc.SBOM.Format
+	daemonless := bc.LocalBuild != nil && bc.LocalBuild.Daemonless
+
+	src := source{
+		imageName: a.ImageName,
+		image:     image,
+		created:   now(),
+	}
+	if !local {
+		if ref, err := docker.ParseReference(image); err == nil {
+			src.digest = ref.Digest
+		}
+	}
+
+	if src.files, err = g.files(ctx, a); err != nil {
+		return err
+	}
+	for _, d := range a.Dependencies {
+		if required, found := images[d.ImageName]; found {
+			src.required = append(src.required, requiredImage{imageName: d.ImageName, image: required})
+		}
+	}
+	if src.layers, err = imageLayers(ctx, image, local, daemonless, g.cfg); err != nil {
+		logrus.Warnf("Unable to list the layers of %s, the SBOM won't include them: %v", image, err)
+	}
+
+	doc, err := document(format, src)
+	if err != nil {
+		return err
+	}
+
+	if dir := g.cfg.SBOMOutputDir(); dir != "" {
+		file := filepath.Join(dir, fileName(a.ImageName, format))
+		if err := ioutil.WriteFile(file, doc, 0644); err != nil {
+			return fmt.Errorf("writing SBOM: %w", err)
+		}
+		color.Default.Fprintf(out, "Wrote SBOM for %s to %s\n", a.ImageName, file)
+	}
+
+	if src.digest != "" {
+		tag, err := attach(doc, mediaType(format), image, g.cfg)
+		if err != nil {
+			return fmt.Errorf("attaching SBOM to %s: %w", image, err)
+		}
+		color.Default.Fprintf(out, "Attached SBOM for %s as %s\n", a.ImageName, tag)
+	}
+	return nil
+}
+
+// files lists the source files of an artifact with their checksum. The paths are relative to the workspace.
+func (g *generator) files(ctx context.Context, a *latest.Artifact) ([]file, error) {
+	deps, err := g.lister(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("getting dependencies for %q: %w", a.ImageName, err)
+	}
+
+	var files []file
+	for _, d := range deps {
+		h, err := fileSHA256(d)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if h == "" {
+			continue
+		}
+
+		path := d
+		if rel, err := filepath.Rel(a.Workspace, d); err == nil && !strings.HasPrefix(rel, "..") {
+			path = rel
+		}
+		files = append(files, file{path: filepath.ToSlash(path), sha256: h})
+	}
+	return files, nil
+}
+
+// fileSHA256 returns the checksum of a file's content, or an empty string for directories.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		return "", nil
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// layersOfImage returns the diff IDs of the layers of a built image. Images that were pushed are read
+// from their registry, and the other images from where they were built: the Docker daemon,
+// or the OCI image layout for daemonless builds.
+func layersOfImage(ctx context.Context, image string, local bool, daemonless bool, cfg docker.Config) ([]string, error) {
+	var configFile *v1.ConfigFile
+	if local && !isPushed(image) {
+		newLocalStore := newAPIClient
+		if daemonless {
+			newLocalStore = newLayoutDaemon
+		}
+		localStore, err := newLocalStore(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if configFile, err = localStore.ConfigFile(ctx, image); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if configFile, err = remoteConfig(image, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	var layers []string
+	for _, d := range configFile.RootFS.DiffIDs {
+		layers = append(layers, d.String())
+	}
+	return layers, nil
+}
+
+// isPushed tells whether an image reference points to an image pushed to a registry, by its digest.
+func isPushed(image string) bool {
+	ref, err := docker.ParseReference(image)
+	return err == nil && ref.Digest != ""
+}
+
+// fileName returns the name of the file holding the SBOM of an image.
+func fileName(imageName string, format string) string {
+	name := strings.NewReplacer("/", "_", ":", "_").Replace(imageName)
+	if format == CycloneDX {
+		return name + ".cdx.json"
+	}
+	return name + ".spdx.json"
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const testDigest = "d3d0c5ab7f1b7b9e5b8d1ea2a0a1c5f0c2c8b6a1f0e6d2b3c4a5968778695a4b"
+
+type mockConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	pipelines             map[string]latest.Pipeline
+	outputDir             string
+}
+
+func (c *mockConfig) PipelineForImage(imageName string) (latest.Pipeline, bool) {
+	p, found := c.pipelines[imageName]
+	return p, found
+}
+
+func (c *mockConfig) SBOMOutputDir() string { return c.outputDir }
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		description    string
+		local          bool
+		expectedPushed []string
+	}{
+		{
+			description:    "pushed image",
+			expectedPushed: []string{"gcr.io/project/app:sha256-" + testDigest + ".sbom"},
+		},
+		{
+			description: "local image",
+			local:       true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var pushed []string
+			t.Override(&pushImage, func(img v1.Image, tag string, cfg docker.Config) (string, error) {
+				pushed = append(pushed, tag)
+				return "", nil
+			})
+			t.Override(&imageLayers, func(context.Context, string, bool, bool, docker.Config) ([]string, error) {
+				return []string{"sha256:l1"}, nil
+			})
+			t.Override(&now, func() time.Time { return time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC) })
+
+			workspace := t.NewTempDir().Write("Dockerfile", "FROM scratch")
+			outputDir := t.NewTempDir()
+			app := &latest.Artifact{ImageName: "gcr.io/project/app", Workspace: workspace.Root(), Dependencies: []*latest.ArtifactDependency{{ImageName: "base"}}}
+			base := &latest.Artifact{ImageName: "base", Workspace: workspace.Root()}
+			cfg := &mockConfig{
+				pipelines: map[string]latest.Pipeline{
+					"gcr.io/project/app": {Build: latest.BuildConfig{SBOM: &latest.SBOMConfig{Format: SPDX}}},
+					"base":               {},
+				},
+				outputDir: outputDir.Root(),
+			}
+			lister := func(context.Context, *latest.Artifact) ([]string, error) {
+				return []string{workspace.Path("Dockerfile"), workspace.Path("missing")}, nil
+			}
+			isLocal := func(string) (bool, error) { return test.local, nil }
+
+			err := NewGenerator(cfg, lister, isLocal).Generate(context.Background(), ioutil.Discard, []*latest.Artifact{app, base}, []build.Artifact{
+				{ImageName: "gcr.io/project/app", Tag: "gcr.io/project/app:v1@sha256:" + testDigest},
+				{ImageName: "base", Tag: "base:v1@sha256:bbbb"},
+			})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedPushed, pushed)
+
+			content, err := ioutil.ReadFile(outputDir.Path("gcr.io_project_app.spdx.json"))
+			t.CheckNoError(err)
+			var doc spdx
+			t.CheckNoError(json.Unmarshal(content, &doc))
+			t.CheckDeepEqual(3, len(doc.Packages))
+			t.CheckDeepEqual([]spdxFile{{
+				SPDXID:    "SPDXRef-File-0",
+				FileName:  "./Dockerfile",
+				Checksums: []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: "67c4282c6928ae380489b6392127b14bb16d8c2f5ba5fa285a934f4f94387b5e"}},
+			}}, doc.Files)
+
+			// SBOM generation isn't enabled for `base`
+			t.CheckFalse(util.IsFile(outputDir.Path("base.spdx.json")))
+		})
+	}
+}
+
+// fakeImageStore is a local image store holding a single image made of one layer.
+type fakeImageStore struct {
+	docker.LocalDaemon
+	layer string
+}
+
+func (s fakeImageStore) ConfigFile(context.Context, string) (*v1.ConfigFile, error) {
+	return configWithLayer(s.layer), nil
+}
+
+func configWithLayer(layer string) *v1.ConfigFile {
+	return &v1.ConfigFile{RootFS: v1.RootFS{DiffIDs: []v1.Hash{{Algorithm: "sha256", Hex: layer}}}}
+}
+
+func TestLayersOfImage(t *testing.T) {
+	tests := []struct {
+		description string
+		image       string
+		local       bool
+		daemonless  bool
+		expected    []string
+	}{
+		{
+			description: "local image in the Docker daemon",
+			image:       "app:v1",
+			local:       true,
+			expected:    []string{"sha256:daemon"},
+		},
+		{
+			description: "local image in the OCI image layout",
+			image:       "app:v1",
+			local:       true,
+			daemonless:  true,
+			expected:    []string{"sha256:layout"},
+		},
+		{
+			description: "pushed image",
+			image:       "gcr.io/project/app:v1@sha256:" + testDigest,
+			expected:    []string{"sha256:registry"},
+		},
+		{
+			description: "local image pushed by a daemonless build",
+			image:       "gcr.io/project/app:v1@sha256:" + testDigest,
+			local:       true,
+			daemonless:  true,
+			expected:    []string{"sha256:registry"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&newAPIClient, func(docker.Config) (docker.LocalDaemon, error) {
+				return fakeImageStore{layer: "daemon"}, nil
+			})
+			t.Override(&newLayoutDaemon, func(docker.Config) (docker.LocalDaemon, error) {
+				return fakeImageStore{layer: "layout"}, nil
+			})
+			t.Override(&remoteConfig, func(string, docker.Config) (*v1.ConfigFile, error) {
+				return configWithLayer("registry"), nil
+			})
+
+			layers, err := layersOfImage(context.Background(), test.image, test.local, test.daemonless, &mockConfig{})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, layers)
+		})
+	}
+}
+
+func TestAttach(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var pushedImage v1.Image
+		t.Override(&pushImage, func(img v1.Image, tag string, cfg docker.Config) (string, error) {
+			pushedImage = img
+			return "", nil
+		})
+
+		tag, err := attach([]byte("{}"), SPDXMediaType, "gcr.io/project/app:v1@sha256:"+testDigest, &mockConfig{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("gcr.io/project/app:sha256-"+testDigest+".sbom", tag)
+		layers, err := pushedImage.Layers()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(layers))
+		mt, err := layers[0].MediaType()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(SPDXMediaType, string(mt))
+		blob, err := layers[0].Uncompressed()
+		t.CheckNoError(err)
+		var buf bytes.Buffer
+		buf.ReadFrom(blob)
+		t.CheckDeepEqual("{}", buf.String())
+	})
+}
+
+func TestAttachmentTagWithoutDigest(t *testing.T) {
+	_, err := attachmentTag("gcr.io/project/app:v1")
+
+	testutil.CheckError(t, true, err)
+}
+
+func TestFileName(t *testing.T) {
+	testutil.CheckDeepEqual(t, "gcr.io_project_app.spdx.json", fileName("gcr.io/project/app", SPDX))
+	testutil.CheckDeepEqual(t, "localhost_5000_app.cdx.json", fileName("localhost:5000/app", CycloneDX))
+}
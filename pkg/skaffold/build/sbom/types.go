@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sbom
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+const (
+	// SPDX is the format of SPDX 2.2 JSON documents.
+	SPDX = "spdx"
+	// CycloneDX is the format of CycloneDX 1.3 JSON documents.
+	CycloneDX = "cyclonedx"
+
+	// SPDXMediaType is the media type of the layer holding an SPDX document.
+	SPDXMediaType = "application/spdx+json"
+	// CycloneDXMediaType is the media type of the layer holding a CycloneDX document.
+	CycloneDXMediaType = "application/vnd.cyclonedx+json"
+)
+
+// Generator produces a Software Bill of Materials for built images.
+type Generator interface {
+	// Generate writes an SBOM for each built artifact whose pipeline enables it,
+	// and attaches it to the image when the image was pushed.
+	Generate(ctx context.Context, out io.Writer, artifacts []*latest.Artifact, builds []build.Artifact) error
+}
+
+// Config is the configuration needed to generate SBOMs.
+type Config interface {
+	docker.Config
+
+	PipelineForImage(imageName string) (latest.Pipeline, bool)
+	SBOMOutputDir() string
+}
+
+// DependencyLister lists the source files of an artifact.
+type DependencyLister func(ctx context.Context, artifact *latest.Artifact) ([]string, error)
+
+// NewGenerator returns a Generator that lists the source files of each artifact with `lister`,
+// the same way they are listed to compute the artifact's cache key.
+func NewGenerator(cfg Config, lister DependencyLister, isLocalImage func(imageName string) (bool, error)) Generator {
+	return &generator{
+		cfg:          cfg,
+		lister:       lister,
+		isLocalImage: isLocalImage,
+	}
+}
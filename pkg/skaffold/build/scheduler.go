@@ -18,15 +18,26 @@ package build
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// For testing
+var (
+	retryBackoff = 2 * time.Second
 )
 
 type ArtifactBuilder func(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string) (string, error)
@@ -37,17 +48,22 @@ type scheduler struct {
 	artifactBuilder ArtifactBuilder
 	logger          logAggregator
 	results         ArtifactStore
-	concurrencySem  countingSemaphore
+	timings         BuildTimings
+	plan            buildPlan
+	concurrencySem  *prioritySemaphore
 }
 
-func newScheduler(artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, out io.Writer, store ArtifactStore) *scheduler {
+func newScheduler(artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, out io.Writer, store ArtifactStore, timings BuildTimings) *scheduler {
+	plan := newBuildPlan(artifacts, timings)
 	s := scheduler{
 		artifacts:       artifacts,
 		nodes:           createNodes(artifacts),
 		artifactBuilder: artifactBuilder,
 		logger:          newLogAggregator(out, len(artifacts), concurrency),
 		results:         store,
-		concurrencySem:  newCountingSemaphore(concurrency),
+		timings:         timings,
+		plan:            plan,
+		concurrencySem:  newPrioritySemaphore(concurrency, plan.initiallyReady()),
 	}
 	return &s
 }
@@ -83,7 +99,7 @@ func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, i int) error
 		event.BuildCanceled(a.ImageName)
 		return err
 	}
-	release := s.concurrencySem.acquire()
+	release := s.concurrencySem.acquire(s.plan.priorities[i], i)
 	defer release()
 
 	event.BuildInProgress(a.ImageName)
@@ -95,7 +111,7 @@ func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, i int) error
 	}
 	defer closeFn()
 
-	finalTag, err := performBuild(ctx, w, tags, a, s.artifactBuilder)
+	finalTag, err := s.buildWithRetries(ctx, w, tags, a)
 	if err != nil {
 		event.BuildFailed(a.ImageName, err)
 		return err
@@ -107,8 +123,75 @@ func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, i int) error
 	return nil
 }
 
+// buildWithRetries builds an artifact, bounding each attempt with the artifact's `timeout`.
+// Failed attempts are reported and retried up to `retries` times, doubling the wait between attempts.
+func (s *scheduler) buildWithRetries(ctx context.Context, out io.Writer, tags tag.ImageTags, a *latest.Artifact) (string, error) {
+	backoff := retryBackoff
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			event.BuildInProgress(a.ImageName)
+		}
+
+		start := time.Now()
+		finalTag, err := performBuildAttempt(ctx, out, tags, a, s.artifactBuilder)
+		if err == nil {
+			s.timings.Record(a.ImageName, time.Since(start))
+			return finalTag, nil
+		}
+		if attempt > a.Retries || ctx.Err() != nil {
+			return "", err
+		}
+
+		event.BuildFailed(a.ImageName, err)
+		color.Yellow.Fprintf(out, "Build attempt %d/%d of [%s] failed, retrying in %v: %v\n", attempt, a.Retries+1, a.ImageName, backoff, err)
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// performBuildAttempt runs a single build of an artifact, cancelling it once the artifact's `timeout` is reached.
+func performBuildAttempt(ctx context.Context, out io.Writer, tags tag.ImageTags, a *latest.Artifact, build ArtifactBuilder) (string, error) {
+	if a.Timeout == "" {
+		return performBuild(ctx, out, tags, a, build)
+	}
+	timeout, err := time.ParseDuration(a.Timeout)
+	if err != nil {
+		return "", fmt.Errorf("parsing timeout %q for artifact %s: %w", a.Timeout, a.ImageName, err)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	finalTag, err := performBuild(attemptCtx, out, tags, a, build)
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return "", sErrors.NewError(err, proto.ActionableErr{
+			Message: fmt.Sprintf("build of %s timed out after %v", a.ImageName, timeout),
+			ErrCode: proto.StatusCode_BUILD_ARTIFACT_TIMEOUT,
+		})
+	}
+	return finalTag, err
+}
+
+// printPlan reports the order in which the artifacts are expected to start building, and when they should be done.
+// Nothing is printed until build timings from previous runs are available.
+func (s *scheduler) printPlan(out io.Writer, concurrency int) {
+	if !s.plan.hasHistory || len(s.artifacts) < 2 {
+		return
+	}
+	order, finish := s.plan.simulate(concurrency)
+	var names []string
+	for _, i := range order {
+		names = append(names, s.artifacts[i].ImageName)
+	}
+	color.Default.Fprintf(out, "Build order: %s (estimated to finish in %v)\n", strings.Join(names, ", "), finish.Round(time.Second))
+}
+
 // InOrder builds a list of artifacts in dependency order.
-func InOrder(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, store ArtifactStore) ([]Artifact, error) {
+// When concurrency is limited, artifacts on the longest dependency chain, according to `timings`, are started first.
+func InOrder(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, store ArtifactStore, timings BuildTimings) ([]Artifact, error) {
 	// `concurrency` specifies the max number of builds that can run at any one time. If concurrency is 0, then all builds can run in parallel.
 	if concurrency == 0 {
 		concurrency = len(artifacts)
@@ -116,9 +199,15 @@ func InOrder(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts [
 	if concurrency > 1 {
 		color.Default.Fprintf(out, "Building %d artifacts in parallel\n", concurrency)
 	}
-	s := newScheduler(artifacts, artifactBuilder, concurrency, out, store)
+	s := newScheduler(artifacts, artifactBuilder, concurrency, out, store, timings)
+	s.printPlan(out, concurrency)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	defer func() {
+		if err := timings.Save(); err != nil {
+			logrus.Warnf("Unable to save build timings: %v", err)
+		}
+	}()
 	return s.run(ctx, tags)
 }
 
@@ -30,7 +30,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
 )
@@ -90,11 +92,12 @@ func TestGetBuild(t *testing.T) {
 
 func TestFormatResults(t *testing.T) {
 	tests := []struct {
-		description string
-		artifacts   []*latest.Artifact
-		expected    []Artifact
-		results     map[string]interface{}
-		shouldErr   bool
+		description    string
+		artifacts      []*latest.Artifact
+		expected       []Artifact
+		results        map[string]interface{}
+		additionalTags map[string][]string
+		shouldErr      bool
 	}{
 		{
 			description: "all builds completely successfully",
@@ -111,6 +114,21 @@ func TestFormatResults(t *testing.T) {
 				"skaffold/image2": "skaffold/image2:v0.0.2@sha256:abac",
 			},
 		},
+		{
+			description: "additional tags",
+			artifacts: []*latest.Artifact{
+				{ImageName: "skaffold/image1"},
+			},
+			expected: []Artifact{
+				{ImageName: "skaffold/image1", Tag: "skaffold/image1:v0.0.1@sha256:abac", AdditionalTags: []string{"skaffold/image1:latest"}},
+			},
+			results: map[string]interface{}{
+				"skaffold/image1": "skaffold/image1:v0.0.1@sha256:abac",
+			},
+			additionalTags: map[string][]string{
+				"skaffold/image1": {"skaffold/image1:latest"},
+			},
+		},
 		{
 			description: "no build result produced for a build",
 			artifacts: []*latest.Artifact{
@@ -130,7 +148,10 @@ func TestFormatResults(t *testing.T) {
 			for k, v := range test.results {
 				m.Store(k, v)
 			}
-			results := &artifactStoreImpl{m: m}
+			results := &artifactStoreImpl{m: m, additionalTags: new(sync.Map)}
+			for k, v := range test.additionalTags {
+				results.RecordAdditionalTags(k, v)
+			}
 			got, err := results.GetArtifacts(test.artifacts)
 
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, got)
@@ -181,7 +202,7 @@ And new lines
 			}
 			initializeEvents()
 
-			InOrder(context.Background(), out, tags, artifacts, test.buildFunc, 0, NewArtifactStore())
+			InOrder(context.Background(), out, tags, artifacts, test.buildFunc, 0, NewArtifactStore(), NewBuildTimings(""))
 
 			t.CheckDeepEqual(test.expected, out.String())
 		})
@@ -236,7 +257,7 @@ func TestInOrderConcurrency(t *testing.T) {
 			}
 
 			initializeEvents()
-			results, err := InOrder(context.Background(), ioutil.Discard, tags, artifacts, builder, test.limit, NewArtifactStore())
+			results, err := InOrder(context.Background(), ioutil.Discard, tags, artifacts, builder, test.limit, NewArtifactStore(), NewBuildTimings(""))
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.artifacts, len(results))
@@ -359,7 +380,7 @@ func TestInOrderForArgs(t *testing.T) {
 
 			setDependencies(artifacts, test.dependency)
 			initializeEvents()
-			actual, err := InOrder(context.Background(), ioutil.Discard, tags, artifacts, test.buildArtifact, test.concurrency, NewArtifactStore())
+			actual, err := InOrder(context.Background(), ioutil.Discard, tags, artifacts, test.buildArtifact, test.concurrency, NewArtifactStore(), NewBuildTimings(""))
 
 			t.CheckDeepEqual(test.expected, actual)
 			t.CheckDeepEqual(test.err, err, cmp.Comparer(errorsComparer))
@@ -367,6 +388,118 @@ func TestInOrderForArgs(t *testing.T) {
 	}
 }
 
+func TestInOrderCriticalPathFirst(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		artifacts := []*latest.Artifact{{ImageName: "artifact1"}, {ImageName: "artifact2"}, {ImageName: "artifact3"}, {ImageName: "artifact4"}}
+		// artifact4 depends on artifact3
+		setDependencies(artifacts, map[int][]int{3: {2}})
+		tags := tag.ImageTags{}
+		for _, a := range artifacts {
+			tags[a.ImageName] = a.ImageName + ":tag"
+		}
+		timings := NewBuildTimings("")
+		timings.Record("artifact1", 2*time.Second)
+		timings.Record("artifact2", 4*time.Second)
+		timings.Record("artifact3", 1*time.Second)
+		timings.Record("artifact4", 3*time.Second)
+
+		var order []string
+		var mutex sync.Mutex
+		builder := func(_ context.Context, _ io.Writer, a *latest.Artifact, tag string) (string, error) {
+			mutex.Lock()
+			order = append(order, a.ImageName)
+			mutex.Unlock()
+			return tag, nil
+		}
+
+		out := new(bytes.Buffer)
+		initializeEvents()
+		_, err := InOrder(context.Background(), out, tags, artifacts, builder, 1, NewArtifactStore(), timings)
+
+		t.CheckNoError(err)
+		// artifacts that become ready while others wait may start in any order, so only check the initial ones.
+		t.CheckDeepEqual([]string{"artifact2", "artifact3"}, order[:2])
+		t.CheckContains("Build order: artifact2, artifact3, artifact4, artifact1 (estimated to finish in 10s)", out.String())
+	})
+}
+
+func TestInOrderRetries(t *testing.T) {
+	tests := []struct {
+		description  string
+		timeout      string
+		retries      int
+		failures     int
+		hang         bool
+		expectedRuns int
+		shouldErr    bool
+		expectedCode proto.StatusCode
+	}{
+		{
+			description:  "succeeds without retries",
+			expectedRuns: 1,
+		},
+		{
+			description:  "fails without retries",
+			failures:     1,
+			expectedRuns: 1,
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_BUILD_UNKNOWN,
+		},
+		{
+			description:  "succeeds after retries",
+			retries:      2,
+			failures:     2,
+			expectedRuns: 3,
+		},
+		{
+			description:  "fails after all retries",
+			retries:      2,
+			failures:     3,
+			expectedRuns: 3,
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_BUILD_UNKNOWN,
+		},
+		{
+			description:  "times out",
+			timeout:      "10ms",
+			retries:      1,
+			hang:         true,
+			expectedRuns: 2,
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_BUILD_ARTIFACT_TIMEOUT,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&retryBackoff, time.Millisecond)
+			artifacts := []*latest.Artifact{{ImageName: "skaffold/image1", Timeout: test.timeout, Retries: test.retries}}
+			tags := tag.ImageTags{"skaffold/image1": "skaffold/image1:v0.0.1"}
+
+			var runs int
+			builder := func(ctx context.Context, _ io.Writer, _ *latest.Artifact, tag string) (string, error) {
+				runs++
+				if test.hang {
+					<-ctx.Done()
+					return "", ctx.Err()
+				}
+				if runs <= test.failures {
+					return "", fmt.Errorf("failure %d", runs)
+				}
+				return tag, nil
+			}
+
+			initializeEvents()
+			_, err := InOrder(context.Background(), ioutil.Discard, tags, artifacts, builder, 1, NewArtifactStore(), NewBuildTimings(""))
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedRuns, runs)
+			if test.shouldErr {
+				t.CheckDeepEqual(test.expectedCode, sErrors.ActionableErr(sErrors.Build, err).ErrCode)
+			}
+		})
+	}
+}
+
 // setDependencies constructs a graph of artifact dependencies using the map as an adjacency list representation of indices in the artifacts array.
 // For example:
 // m = {
@@ -681,6 +681,33 @@ func (g *gitRepo) tag(tag string) *gitRepo {
 	return g
 }
 
+func (g *gitRepo) annotatedTag(tag string) *gitRepo {
+	head, err := g.repo.Head()
+	failNowIfError(g.t, err)
+
+	_, err = g.repo.CreateTag(tag, head.Hash(), &git.CreateTagOptions{
+		Tagger: &object.Signature{
+			Name:  "John Doe",
+			Email: "john@doe.org",
+			When:  time.Date(2013, time.February, 3, 19, 54, 0, 0, time.UTC),
+		},
+		Message: tag,
+	})
+	failNowIfError(g.t, err)
+
+	return g
+}
+
+func (g *gitRepo) branch(branch string) *gitRepo {
+	err := g.workTree.Checkout(&git.CheckoutOptions{
+		Branch: plumbing.NewBranchReferenceName(branch),
+		Create: true,
+	})
+	failNowIfError(g.t, err)
+
+	return g
+}
+
 func failNowIfError(t *testing.T, err error) {
 	if err != nil {
 		t.Fatal(err)
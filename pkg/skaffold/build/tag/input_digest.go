@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ArtifactHasher computes the digest of the inputs of the artifact with the given image name:
+// its dependencies, build args and configuration. It's the hash used as key by the artifact cache.
+type ArtifactHasher func(imageName string) (string, error)
+
+// inputDigestTagger implements Tagger
+type inputDigestTagger struct {
+	hasher ArtifactHasher
+}
+
+// NewInputDigestTagger creates a tagger that tags images with the digest of their inputs.
+func NewInputDigestTagger(hasher ArtifactHasher) (Tagger, error) {
+	if hasher == nil {
+		return nil, errors.New("the inputDigest tag policy requires a way to hash artifacts")
+	}
+
+	return &inputDigestTagger{
+		hasher: hasher,
+	}, nil
+}
+
+// GenerateTag generates a tag from the digest of the artifact's inputs.
+// Identical sources, build args and configuration always give the same tag.
+func (t *inputDigestTagger) GenerateTag(_, imageName string) (string, error) {
+	digest, err := t.hasher(imageName)
+	if err != nil {
+		return "", fmt.Errorf("computing input digest of %q: %w", imageName, err)
+	}
+
+	return digest, nil
+}
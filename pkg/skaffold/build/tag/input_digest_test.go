@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestInputDigestTagger_GenerateTag(t *testing.T) {
+	tests := []struct {
+		description string
+		hasher      ArtifactHasher
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "digest of the inputs",
+			hasher: func(imageName string) (string, error) {
+				return "digest-of-" + imageName, nil
+			},
+			expected: "digest-of-test",
+		},
+		{
+			description: "hashing error",
+			hasher: func(string) (string, error) {
+				return "", errors.New("unable to list dependencies")
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tagger, err := NewInputDigestTagger(test.hasher)
+			t.CheckNoError(err)
+
+			tag, err := tagger.GenerateTag(".", "test")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
+
+func TestNewInputDigestTagger(t *testing.T) {
+	_, err := NewInputDigestTagger(nil)
+
+	testutil.CheckError(t, true, err)
+}
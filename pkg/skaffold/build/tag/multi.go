@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"errors"
+	"fmt"
+)
+
+// multiTagger implements Tagger
+type multiTagger struct {
+	taggers []Tagger
+}
+
+// NewMultiTagger creates a tagger that generates one tag per given tagger.
+// The first tagger generates the canonical tag.
+func NewMultiTagger(taggers []Tagger) (Tagger, error) {
+	if len(taggers) == 0 {
+		return nil, errors.New("at least one tag policy is required")
+	}
+
+	return &multiTagger{
+		taggers: taggers,
+	}, nil
+}
+
+// GenerateTag generates the canonical tag.
+func (t *multiTagger) GenerateTag(workingDir, imageName string) (string, error) {
+	return t.taggers[0].GenerateTag(workingDir, imageName)
+}
+
+// GenerateFullyQualifiedImageNames resolves all the fully qualified image names for an artifact.
+// The first one is the canonical image name, as returned by GenerateFullyQualifiedImageName.
+// Only a multiTag policy yields more than one name.
+func GenerateFullyQualifiedImageNames(t Tagger, workingDir, imageName string) ([]string, error) {
+	if mux, ok := t.(*TaggerMux); ok {
+		if tagger, found := mux.byImageName[imageName]; found {
+			t = tagger
+		}
+	}
+
+	multi, ok := t.(*multiTagger)
+	if !ok {
+		name, err := GenerateFullyQualifiedImageName(t, workingDir, imageName)
+		if err != nil {
+			return nil, err
+		}
+		return []string{name}, nil
+	}
+
+	var names []string
+	seen := map[string]bool{}
+	for i, tagger := range multi.taggers {
+		name, err := GenerateFullyQualifiedImageName(tagger, workingDir, imageName)
+		if err != nil {
+			return nil, fmt.Errorf("generating tag %d: %w", i+1, err)
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestGenerateFullyQualifiedImageNames(t *testing.T) {
+	envTagger, _ := NewEnvTemplateTagger("{{.FOO}}")
+	latestTagger, _ := NewEnvTemplateTagger("latest")
+	failingTagger, _ := NewEnvTemplateTagger("{{.UNKNOWN}}")
+
+	tests := []struct {
+		description string
+		tagger      func() (Tagger, error)
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "single tag",
+			tagger:      func() (Tagger, error) { return envTagger, nil },
+			expected:    []string{"test:foo"},
+		},
+		{
+			description: "multiple tags",
+			tagger: func() (Tagger, error) {
+				return NewMultiTagger([]Tagger{envTagger, &CustomTag{Tag: "v1"}, latestTagger})
+			},
+			expected: []string{"test:foo", "test:v1", "test:latest"},
+		},
+		{
+			description: "duplicate tags",
+			tagger: func() (Tagger, error) {
+				return NewMultiTagger([]Tagger{latestTagger, envTagger, &ChecksumTagger{}})
+			},
+			expected: []string{"test:latest", "test:foo"},
+		},
+		{
+			description: "multiple tags for an image of a tagger mux",
+			tagger: func() (Tagger, error) {
+				multi, err := NewMultiTagger([]Tagger{envTagger, latestTagger})
+				return &TaggerMux{byImageName: map[string]Tagger{"test": multi}}, err
+			},
+			expected: []string{"test:foo", "test:latest"},
+		},
+		{
+			description: "error",
+			tagger: func() (Tagger, error) {
+				return NewMultiTagger([]Tagger{envTagger, failingTagger})
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{"FOO": "foo"})
+			tagger, err := test.tagger()
+			t.CheckNoError(err)
+
+			names, err := GenerateFullyQualifiedImageNames(tagger, ".", "test")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, names)
+		})
+	}
+}
+
+func TestNewMultiTagger(t *testing.T) {
+	_, err := NewMultiTagger(nil)
+
+	testutil.CheckError(t, true, err)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+type versionBump int
+
+const (
+	bumpNone versionBump = iota
+	bumpPatch
+	bumpMinor
+	bumpMajor
+)
+
+var (
+	defaultReleaseBranches = []string{"main", "master"}
+
+	// conventionalHeader matches the `type(scope)!: description` header of a conventional commit message.
+	conventionalHeader = regexp.MustCompile(`^(\w+)(?:\([^)]*\))?(!)?: `)
+	breakingFooter     = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE: `)
+	invalidPrerelease  = regexp.MustCompile(`[^0-9A-Za-z-]+`)
+)
+
+// SemVerTagger tags an image with the next semantic version, derived from the latest annotated git tag
+// and the conventional commit messages since that tag.
+type SemVerTagger struct {
+	tagPrefix       string
+	releaseBranches []string
+	ignoreChanges   bool
+}
+
+// NewSemVerTagger creates a new semantic version tagger.
+func NewSemVerTagger(tagPrefix string, releaseBranches []string, ignoreChanges bool) *SemVerTagger {
+	if tagPrefix == "" {
+		tagPrefix = "v"
+	}
+	if len(releaseBranches) == 0 {
+		releaseBranches = defaultReleaseBranches
+	}
+
+	return &SemVerTagger{
+		tagPrefix:       tagPrefix,
+		releaseBranches: releaseBranches,
+		ignoreChanges:   ignoreChanges,
+	}
+}
+
+// GenerateTag generates a tag from the latest version tag and the commits since.
+// Commits on branches other than the release branches get a `<branch>.<commits>` prerelease version.
+// Docker tags can't contain `+`, so the `dirty` build metadata is separated by `_`.
+func (t *SemVerTagger) GenerateTag(workingDir, _ string) (string, error) {
+	branch, err := runGit(workingDir, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("unable to find git commit: %w", err)
+	}
+
+	version := semver.Version{}
+	revisions := "HEAD"
+	if latest, err := runGit(workingDir, "describe", "--abbrev=0", "--match", t.tagPrefix+"[0-9]*"); err == nil {
+		version, err = semver.Parse(strings.TrimPrefix(latest, t.tagPrefix))
+		if err != nil {
+			return "", fmt.Errorf("parsing version of git tag %q: %w", latest, err)
+		}
+		revisions = latest + "..HEAD"
+	}
+
+	log, err := runGit(workingDir, "log", "--format=%B%x00", revisions)
+	if err != nil {
+		return "", fmt.Errorf("listing commits since last version: %w", err)
+	}
+	var messages []string
+	for _, m := range strings.Split(log, "\x00") {
+		if m = strings.TrimSpace(m); m != "" {
+			messages = append(messages, m)
+		}
+	}
+
+	if len(messages) > 0 {
+		version = nextVersion(version, conventionalBump(messages))
+
+		if !util.StrSliceContains(t.releaseBranches, branch) {
+			if branch == "HEAD" {
+				branch = "detached"
+			}
+			name, err := semver.NewPRVersion(strings.Trim(invalidPrerelease.ReplaceAllString(branch, "-"), "-"))
+			if err != nil {
+				return "", fmt.Errorf("creating prerelease version for branch %q: %w", branch, err)
+			}
+			count, _ := semver.NewPRVersion(strconv.Itoa(len(messages)))
+			version.Pre = []semver.PRVersion{name, count}
+		}
+	}
+
+	if !t.ignoreChanges {
+		changes, err := runGit(workingDir, "status", ".", "--porcelain")
+		if err != nil {
+			return "", fmt.Errorf("getting git status: %w", err)
+		}
+
+		if len(changes) > 0 {
+			version.Build = []string{"dirty"}
+		}
+	}
+
+	return strings.Replace(version.String(), "+", "_", 1), nil
+}
+
+// conventionalBump determines which part of the version must be incremented for the given commit messages.
+// Any commit leads to at least a patch increment.
+func conventionalBump(messages []string) versionBump {
+	bump := bumpNone
+	for _, message := range messages {
+		b := bumpPatch
+		header := conventionalHeader.FindStringSubmatch(message)
+		switch {
+		case (header != nil && header[2] == "!") || breakingFooter.MatchString(message):
+			b = bumpMajor
+		case header != nil && header[1] == "feat":
+			b = bumpMinor
+		}
+		if b > bump {
+			bump = b
+		}
+	}
+	return bump
+}
+
+// nextVersion increments the given version. A prerelease version is only released if the increment
+// doesn't go beyond it, so that `1.2.0-rc.1` followed by a fix gives `1.2.0`.
+func nextVersion(v semver.Version, bump versionBump) semver.Version {
+	pre := len(v.Pre) > 0
+	next := semver.Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+
+	switch {
+	case bump == bumpMajor && !(pre && v.Minor == 0 && v.Patch == 0):
+		next = semver.Version{Major: v.Major + 1}
+	case bump == bumpMinor && !(pre && v.Patch == 0):
+		next = semver.Version{Major: v.Major, Minor: v.Minor + 1}
+	case bump == bumpPatch && !pre:
+		next.Patch++
+	}
+	return next
+}
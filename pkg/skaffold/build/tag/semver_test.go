@@ -0,0 +1,177 @@
+// +build !windows
+
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tag
+
+import (
+	"testing"
+
+	"github.com/blang/semver"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// These tests do not run on windows
+// See: https://github.com/src-d/go-git/issues/378
+func TestSemVerTagger_GenerateTag(t *testing.T) {
+	tests := []struct {
+		description     string
+		createGitRepo   func(string)
+		tagPrefix       string
+		releaseBranches []string
+		ignoreChanges   bool
+		expected        string
+		shouldErr       bool
+	}{
+		{
+			description: "no version tag",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial")
+			},
+			expected: "0.0.1",
+		},
+		{
+			description: "on version tag",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2.3")
+			},
+			expected: "1.2.3",
+		},
+		{
+			description: "lightweight tags are ignored",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2.3").
+					write("other.go", "other").add("other.go").commit("fix: other").tag("v2.0.0")
+			},
+			expected: "1.2.4",
+		},
+		{
+			description: "feature since version tag",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2.3").
+					write("other.go", "other").add("other.go").commit("fix: other").
+					write("feature.go", "feature").add("feature.go").commit("feat(api): feature")
+			},
+			expected: "1.3.0",
+		},
+		{
+			description: "breaking change since version tag",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2.3").
+					write("other.go", "other").add("other.go").commit("refactor: other\n\nBREAKING CHANGE: other API")
+			},
+			expected: "2.0.0",
+		},
+		{
+			description: "custom tag prefix",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("release-1.2.3").annotatedTag("v3.0.0").
+					write("other.go", "other").add("other.go").commit("feat!: other")
+			},
+			tagPrefix: "release-",
+			expected:  "2.0.0",
+		},
+		{
+			description: "feature branch",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2.3").
+					branch("feature/login").
+					write("other.go", "other").add("other.go").commit("feat: login").
+					write("more.go", "more").add("more.go").commit("test: login")
+			},
+			expected: "1.3.0-feature-login.2",
+		},
+		{
+			description: "custom release branch",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2.3").
+					branch("release").
+					write("other.go", "other").add("other.go").commit("fix: other")
+			},
+			releaseBranches: []string{"release"},
+			expected:        "1.2.4",
+		},
+		{
+			description: "dirty worktree",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2.3").
+					write("source.go", "updated code")
+			},
+			expected: "1.2.3_dirty",
+		},
+		{
+			description: "ignore changes",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2.3").
+					write("source.go", "updated code")
+			},
+			ignoreChanges: true,
+			expected:      "1.2.3",
+		},
+		{
+			description: "invalid version tag",
+			createGitRepo: func(dir string) {
+				gitInit(t, dir).write("source.go", "code").add("source.go").commit("initial").annotatedTag("v1.2")
+			},
+			shouldErr: true,
+		},
+		{
+			description:   "non git repo",
+			createGitRepo: func(string) {},
+			shouldErr:     true,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Parallel()
+
+			tmpDir := t.NewTempDir()
+			test.createGitRepo(tmpDir.Root())
+
+			tag, err := NewSemVerTagger(test.tagPrefix, test.releaseBranches, test.ignoreChanges).GenerateTag(tmpDir.Root(), "test")
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tag)
+		})
+	}
+}
+
+func TestNextVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		bump     versionBump
+		expected string
+	}{
+		{version: "1.2.3", bump: bumpNone, expected: "1.2.3"},
+		{version: "1.2.3", bump: bumpPatch, expected: "1.2.4"},
+		{version: "1.2.3", bump: bumpMinor, expected: "1.3.0"},
+		{version: "1.2.3", bump: bumpMajor, expected: "2.0.0"},
+		{version: "1.2.3-rc.1", bump: bumpPatch, expected: "1.2.3"},
+		{version: "1.2.0-rc.1", bump: bumpMinor, expected: "1.2.0"},
+		{version: "1.2.3-rc.1", bump: bumpMinor, expected: "1.3.0"},
+		{version: "2.0.0-rc.1", bump: bumpMajor, expected: "2.0.0"},
+		{version: "1.2.0-rc.1", bump: bumpMajor, expected: "2.0.0"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.version, func(t *testutil.T) {
+			next := nextVersion(semver.MustParse(test.version), test.bump)
+
+			t.CheckDeepEqual(test.expected, next.String())
+		})
+	}
+}
@@ -26,6 +26,9 @@ import (
 // ImageTags maps image names to tags
 type ImageTags map[string]string
 
+// AdditionalTags maps image names to the tags generated by multiTag policies, besides their canonical tag.
+type AdditionalTags map[string][]string
+
 // Tagger is an interface for tag strategies to be implemented against
 type Tagger interface {
 	// GenerateTag generates a tag for an artifact.
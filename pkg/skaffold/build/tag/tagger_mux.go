@@ -17,6 +17,7 @@ limitations under the License.
 package tag
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
@@ -36,12 +37,13 @@ func (t *TaggerMux) GenerateTag(workingDir, imageName string) (string, error) {
 	return tagger.GenerateTag(workingDir, imageName)
 }
 
-func NewTaggerMux(runCtx *runcontext.RunContext) (Tagger, error) {
+// NewTaggerMux creates a tagger for each pipeline. The hasher is used by the inputDigest tag policy.
+func NewTaggerMux(runCtx *runcontext.RunContext, hasher ArtifactHasher) (Tagger, error) {
 	pipelines := runCtx.GetPipelines()
 	m := make(map[string]Tagger)
 	sl := make([]Tagger, len(pipelines))
 	for _, p := range pipelines {
-		t, err := getTagger(runCtx, &p.Build.TagPolicy)
+		t, err := getTagger(runCtx, &p.Build.TagPolicy, hasher)
 		if err != nil {
 			return nil, fmt.Errorf("creating tagger: %w", err)
 		}
@@ -53,7 +55,7 @@ func NewTaggerMux(runCtx *runcontext.RunContext) (Tagger, error) {
 	return &TaggerMux{taggers: sl, byImageName: m}, nil
 }
 
-func getTagger(runCtx *runcontext.RunContext, t *latest.TagPolicy) (Tagger, error) {
+func getTagger(runCtx *runcontext.RunContext, t *latest.TagPolicy, hasher ArtifactHasher) (Tagger, error) {
 	switch {
 	case runCtx.CustomTag() != "":
 		return &CustomTag{
@@ -72,8 +74,14 @@ func getTagger(runCtx *runcontext.RunContext, t *latest.TagPolicy) (Tagger, erro
 	case t.DateTimeTagger != nil:
 		return NewDateTimeTagger(t.DateTimeTagger.Format, t.DateTimeTagger.TimeZone), nil
 
+	case t.SemVerTagger != nil:
+		return NewSemVerTagger(t.SemVerTagger.TagPrefix, t.SemVerTagger.ReleaseBranches, t.SemVerTagger.IgnoreChanges), nil
+
+	case t.InputDigestTagger != nil:
+		return NewInputDigestTagger(hasher)
+
 	case t.CustomTemplateTagger != nil:
-		components, err := CreateComponents(t.CustomTemplateTagger)
+		components, err := CreateComponents(t.CustomTemplateTagger, hasher)
 
 		if err != nil {
 			return nil, fmt.Errorf("creating components: %w", err)
@@ -81,13 +89,28 @@ func getTagger(runCtx *runcontext.RunContext, t *latest.TagPolicy) (Tagger, erro
 
 		return NewCustomTemplateTagger(t.CustomTemplateTagger.Template, components)
 
+	case t.MultiTagger != nil:
+		var taggers []Tagger
+		for _, p := range t.MultiTagger.Tags {
+			if p.MultiTagger != nil {
+				return nil, errors.New("nested multiTag policies are not supported in skaffold")
+			}
+			tagger, err := getTagger(runCtx, &p, hasher)
+			if err != nil {
+				return nil, err
+			}
+			taggers = append(taggers, tagger)
+		}
+
+		return NewMultiTagger(taggers)
+
 	default:
 		return nil, fmt.Errorf("unknown tagger for strategy %+v", t)
 	}
 }
 
 // CreateComponents creates a map of taggers for CustomTemplateTagger
-func CreateComponents(t *latest.CustomTemplateTagger) (map[string]Tagger, error) {
+func CreateComponents(t *latest.CustomTemplateTagger, hasher ArtifactHasher) (map[string]Tagger, error) {
 	components := map[string]Tagger{}
 
 	for _, taggerComponent := range t.Components {
@@ -110,9 +133,22 @@ func CreateComponents(t *latest.CustomTemplateTagger) (map[string]Tagger, error)
 		case c.DateTimeTagger != nil:
 			components[name] = NewDateTimeTagger(c.DateTimeTagger.Format, c.DateTimeTagger.TimeZone)
 
+		case c.SemVerTagger != nil:
+			components[name] = NewSemVerTagger(c.SemVerTagger.TagPrefix, c.SemVerTagger.ReleaseBranches, c.SemVerTagger.IgnoreChanges)
+
+		case c.InputDigestTagger != nil:
+			tagger, err := NewInputDigestTagger(hasher)
+			if err != nil {
+				return nil, err
+			}
+			components[name] = tagger
+
 		case c.CustomTemplateTagger != nil:
 			return nil, fmt.Errorf("nested customTemplate components are not supported in skaffold (%s)", name)
 
+		case c.MultiTagger != nil:
+			return nil, fmt.Errorf("multiTag components are not supported in skaffold (%s)", name)
+
 		default:
 			return nil, fmt.Errorf("unknown component for custom template: %s %+v", name, c)
 		}
@@ -41,6 +41,7 @@ func TestCreateComponents(t *testing.T) {
 					{Name: "FOE", Component: latest.TagPolicy{ShaTagger: &latest.ShaTagger{}}},
 					{Name: "BAR", Component: latest.TagPolicy{EnvTemplateTagger: &latest.EnvTemplateTagger{Template: "test"}}},
 					{Name: "BAT", Component: latest.TagPolicy{DateTimeTagger: &latest.DateTimeTagger{}}},
+					{Name: "BAZ", Component: latest.TagPolicy{SemVerTagger: &latest.SemVerTagger{TagPrefix: "release-"}}},
 				},
 			},
 			expected: map[string]Tagger{
@@ -48,6 +49,7 @@ func TestCreateComponents(t *testing.T) {
 				"FOE": &ChecksumTagger{},
 				"BAR": envExample,
 				"BAT": NewDateTimeTagger("", ""),
+				"BAZ": NewSemVerTagger("release-", nil, false),
 			},
 		},
 		{
@@ -59,6 +61,15 @@ func TestCreateComponents(t *testing.T) {
 			},
 			shouldErr: true,
 		},
+		{
+			description: "multiTag is an invalid component",
+			customTemplateTagger: &latest.CustomTemplateTagger{
+				Components: []latest.TaggerComponent{
+					{Name: "FOO", Component: latest.TagPolicy{MultiTagger: &latest.MultiTagger{Tags: []latest.TagPolicy{{ShaTagger: &latest.ShaTagger{}}}}}},
+				},
+			},
+			shouldErr: true,
+		},
 		{
 			description: "recurring names",
 			customTemplateTagger: &latest.CustomTemplateTagger{
@@ -81,7 +92,7 @@ func TestCreateComponents(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			components, err := CreateComponents(test.customTemplateTagger)
+			components, err := CreateComponents(test.customTemplateTagger, nil)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, len(test.expected), len(components))
 			for k, v := range test.expected {
 				t.CheckTypeEquality(v, components[k])
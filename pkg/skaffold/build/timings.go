@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
+)
+
+// BuildTimings keeps track of how long each artifact took to build in previous runs.
+// The scheduler uses these durations to start the artifacts on the longest dependency chain first.
+type BuildTimings interface {
+	// Get returns the estimated build duration of the given image, if it was built before.
+	Get(imageName string) (time.Duration, bool)
+	// Record adds the duration of a successful build of the given image.
+	Record(imageName string, d time.Duration)
+	// Save persists the recorded durations.
+	Save() error
+}
+
+// NewBuildTimings returns the build timings stored in the given file.
+// An empty file name gives in-memory timings that are never persisted.
+func NewBuildTimings(file string) BuildTimings {
+	t := &buildTimingsImpl{file: file, durations: map[string]time.Duration{}}
+	if file == "" {
+		return t
+	}
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		logrus.Debugf("Could not read build timings from %s: %v", file, err)
+		return t
+	}
+	if err := yaml.Unmarshal(contents, &t.durations); err != nil {
+		logrus.Warnf("Ignoring invalid build timings file %s: %v", file, err)
+		t.durations = map[string]time.Duration{}
+	}
+	return t
+}
+
+// BuildTimingsFile returns the file holding the build timings. It lives next to the artifact cache file.
+func BuildTimingsFile(cacheFile string) (string, error) {
+	dir := filepath.Dir(cacheFile)
+	if cacheFile == "" {
+		home, err := homedir.Dir()
+		if err != nil {
+			return "", fmt.Errorf("retrieving home directory: %w", err)
+		}
+		dir = filepath.Join(home, constants.DefaultSkaffoldDir)
+	}
+	file := filepath.Join(dir, constants.DefaultBuildTimingsFile)
+	return file, util.VerifyOrCreateFile(file)
+}
+
+type buildTimingsImpl struct {
+	file      string
+	durations map[string]time.Duration
+	mutex     sync.RWMutex
+}
+
+func (t *buildTimingsImpl) Get(imageName string) (time.Duration, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	d, found := t.durations[imageName]
+	return d, found
+}
+
+func (t *buildTimingsImpl) Record(imageName string, d time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	// average with the previous duration so that a single slow or fast build doesn't dominate the estimate.
+	if previous, found := t.durations[imageName]; found {
+		d = (previous + d) / 2
+	}
+	t.durations[imageName] = d.Round(time.Millisecond)
+}
+
+func (t *buildTimingsImpl) Save() error {
+	if t.file == "" {
+		return nil
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	data, err := yaml.Marshal(t.durations)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(t.file, data, 0644)
+}
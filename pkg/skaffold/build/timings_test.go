@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestBuildTimings(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		file := t.NewTempDir().Path("build-timings")
+
+		timings := NewBuildTimings(file)
+		timings.Record("image1", 10*time.Second)
+		timings.Record("image1", 20*time.Second)
+		timings.Record("image2", 3*time.Second)
+		t.CheckNoError(timings.Save())
+
+		saved := NewBuildTimings(file)
+		d, found := saved.Get("image1")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(15*time.Second, d)
+		d, found = saved.Get("image2")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(3*time.Second, d)
+		_, found = saved.Get("image3")
+		t.CheckFalse(found)
+	})
+}
+
+func TestBuildTimingsFile(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+
+		file, err := BuildTimingsFile(tmpDir.Path("cache"))
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(tmpDir.Path("build-timings"), file)
+		_, err = os.Stat(file)
+		t.CheckNoError(err)
+	})
+}
+
+func TestBuildPlan(t *testing.T) {
+	tests := []struct {
+		description        string
+		durations          map[string]time.Duration
+		dependency         map[int][]int
+		concurrency        int
+		expectedPriorities []time.Duration
+		expectedOrder      []int
+		expectedFinish     time.Duration
+	}{
+		{
+			description:        "no history keeps input order",
+			concurrency:        1,
+			expectedPriorities: []time.Duration{0, 0, 0},
+			expectedOrder:      []int{0, 1, 2},
+		},
+		{
+			description: "longest build first",
+			durations: map[string]time.Duration{
+				"artifact1": 1 * time.Second,
+				"artifact2": 3 * time.Second,
+				"artifact3": 2 * time.Second,
+			},
+			concurrency:        2,
+			expectedPriorities: []time.Duration{1 * time.Second, 3 * time.Second, 2 * time.Second},
+			expectedOrder:      []int{1, 2, 0},
+			expectedFinish:     3 * time.Second,
+		},
+		{
+			description: "longest dependency chain first",
+			durations: map[string]time.Duration{
+				"artifact1": 5 * time.Second,
+				"artifact2": 2 * time.Second,
+				"artifact3": 4 * time.Second,
+			},
+			// artifact3 depends on artifact2
+			dependency:         map[int][]int{2: {1}},
+			concurrency:        1,
+			expectedPriorities: []time.Duration{5 * time.Second, 6 * time.Second, 4 * time.Second},
+			expectedOrder:      []int{1, 0, 2},
+			expectedFinish:     11 * time.Second,
+		},
+		{
+			description: "unknown artifacts take the average duration",
+			durations: map[string]time.Duration{
+				"artifact1": 2 * time.Second,
+				"artifact2": 4 * time.Second,
+			},
+			concurrency:        3,
+			expectedPriorities: []time.Duration{2 * time.Second, 4 * time.Second, 3 * time.Second},
+			expectedOrder:      []int{1, 2, 0},
+			expectedFinish:     4 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			artifacts := []*latest.Artifact{{ImageName: "artifact1"}, {ImageName: "artifact2"}, {ImageName: "artifact3"}}
+			setDependencies(artifacts, test.dependency)
+			timings := NewBuildTimings("")
+			for image, d := range test.durations {
+				timings.Record(image, d)
+			}
+
+			plan := newBuildPlan(artifacts, timings)
+			order, finish := plan.simulate(test.concurrency)
+
+			t.CheckDeepEqual(test.expectedPriorities, plan.priorities)
+			t.CheckDeepEqual(test.expectedOrder, order)
+			t.CheckDeepEqual(test.expectedFinish, finish)
+		})
+	}
+}
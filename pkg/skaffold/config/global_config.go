@@ -37,6 +37,10 @@ type ContextConfig struct {
 	KindDisableLoad      *bool         `yaml:"kind-disable-load,omitempty"`
 	K3dDisableLoad       *bool         `yaml:"k3d-disable-load,omitempty"`
 	CollectMetrics       *bool         `yaml:"collect-metrics,omitempty"`
+	// DockerHost is the endpoint of the remote Docker daemon used by the local builder, such as `ssh://user@build-machine`.
+	DockerHost string `yaml:"docker-host,omitempty"`
+	// DockerCertPath is the directory holding the TLS material used to connect to DockerHost.
+	DockerCertPath string `yaml:"docker-cert-path,omitempty"`
 }
 
 // SurveyConfig is the survey config information
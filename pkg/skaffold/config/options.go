@@ -61,6 +61,8 @@ type SkaffoldOptions struct {
 	AutoCreateConfig      bool
 	AssumeYes             bool
 	RenderOutput          string
+	TestReportDir         string
+	TestFilter            string
 	ProfileAutoActivation bool
 	DryRun                bool
 	SkipRender            bool
@@ -76,6 +78,8 @@ type SkaffoldOptions struct {
 	CustomTag          string
 	Namespace          string
 	CacheFile          string
+	CacheURL           string
+	BuildOutputFile    string
 	Trigger            string
 	KubeContext        string
 	KubeConfig         string
@@ -193,6 +193,20 @@ func GetDebugHelpersRegistry(configFile string) (string, error) {
 	return constants.DefaultDebugHelpersRegistry, nil
 }
 
+// GetDockerDaemon returns the endpoint of the remote Docker daemon set for the current kube-context,
+// and the directory of its TLS material, if any.
+func GetDockerDaemon(configFile string) (string, string, error) {
+	cfg, err := GetConfigForCurrentKubectx(configFile)
+	if err != nil {
+		return "", "", err
+	}
+
+	if cfg.DockerHost != "" {
+		logrus.Infof("Using docker-host=%s from config", cfg.DockerHost)
+	}
+	return cfg.DockerHost, cfg.DockerCertPath, nil
+}
+
 func GetCluster(configFile string, minikubeProfile string, detectMinikube bool) (Cluster, error) {
 	cfg, err := GetConfigForCurrentKubectx(configFile)
 	if err != nil {
@@ -645,6 +645,42 @@ func TestGetDefaultRepo(t *testing.T) {
 	}
 }
 
+func TestGetDockerDaemon(t *testing.T) {
+	tests := []struct {
+		description      string
+		cfg              *ContextConfig
+		expectedHost     string
+		expectedCertPath string
+	}{
+		{
+			description: "empty",
+			cfg:         &ContextConfig{},
+		},
+		{
+			description:  "ssh host",
+			cfg:          &ContextConfig{DockerHost: "ssh://user@build-machine"},
+			expectedHost: "ssh://user@build-machine",
+		},
+		{
+			description:      "tcp host with tls",
+			cfg:              &ContextConfig{DockerHost: "tcp://build-machine:2376", DockerCertPath: "/certs"},
+			expectedHost:     "tcp://build-machine:2376",
+			expectedCertPath: "/certs",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&GetConfigForCurrentKubectx, func(string) (*ContextConfig, error) { return test.cfg, nil })
+
+			host, certPath, err := GetDockerDaemon("config")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedHost, host)
+			t.CheckDeepEqual(test.expectedCertPath, certPath)
+		})
+	}
+}
+
 func TestUpdateGlobalSurveyTaken(t *testing.T) {
 	tests := []struct {
 		description string
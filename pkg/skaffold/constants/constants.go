@@ -44,9 +44,11 @@ const (
 	// DefaultDebugHelpersRegistry is the default location used for the helper images for `debug`.
 	DefaultDebugHelpersRegistry = "gcr.io/k8s-skaffold/skaffold-debug-support"
 
-	DefaultSkaffoldDir = ".skaffold"
-	DefaultCacheFile   = "cache"
-	DefaultMetricFile  = "metrics"
+	DefaultSkaffoldDir      = ".skaffold"
+	DefaultCacheFile        = "cache"
+	DefaultBuildTimingsFile = "build-timings"
+	DefaultOutputsDir       = "outputs"
+	DefaultMetricFile       = "metrics"
 
 	DefaultRPCPort     = 50051
 	DefaultRPCHTTPPort = 50052
@@ -55,6 +57,9 @@ const (
 
 	DefaultProjectDescriptor = "project.toml"
 
+	// DefaultKoBaseImage is the base image of artifacts built with the ko builder
+	DefaultKoBaseImage = "gcr.io/distroless/static:nonroot"
+
 	LeeroyAppResponse = "leeroooooy app!!\n"
 
 	GithubIssueLink = "https://github.com/GoogleContainerTools/skaffold/issues/new"
@@ -80,6 +85,12 @@ var (
 	// BuildContext is the absolute path to a directory this artifact is meant to be built from for custom artifacts
 	BuildContext = "BUILD_CONTEXT"
 
+	// OutputDir is the directory where the command of a file output writes its files
+	OutputDir = "OUTPUT_DIR"
+
+	// ResultFile is the path to the file where a custom build script reports the result of the build, when `reportResult` is set
+	ResultFile = "RESULT_FILE"
+
 	// KubeContext is the expected kubecontext to build an artifact with a custom build script on cluster
 	KubeContext = "KUBE_CONTEXT"
 
@@ -21,6 +21,7 @@ import (
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 )
 
 // Deployer is the Deploy API of skaffold and responsible for deploying
@@ -30,6 +31,10 @@ type Deployer interface {
 	// cluster. Returns the list of impacted namespaces.
 	Deploy(context.Context, io.Writer, []build.Artifact) ([]string, error)
 
+	// DeployedManifests returns the manifests applied by the last call to Deploy.
+	// They are used by the status check to find the deployed resources.
+	DeployedManifests() manifest.ManifestList
+
 	// Dependencies returns a list of files that the deployer depends on.
 	// In dev mode, a redeploy will be triggered
 	Dependencies() ([]string, error)
@@ -46,6 +46,14 @@ func (m DeployerMux) Deploy(ctx context.Context, w io.Writer, as []build.Artifac
 	return seenNamespaces.ToList(), nil
 }
 
+func (m DeployerMux) DeployedManifests() manifest.ManifestList {
+	var manifests manifest.ManifestList
+	for _, deployer := range m {
+		manifests = append(manifests, deployer.DeployedManifests()...)
+	}
+	return manifests
+}
+
 func (m DeployerMux) Dependencies() ([]string, error) {
 	deps := util.NewStringSet()
 	for _, deployer := range m {
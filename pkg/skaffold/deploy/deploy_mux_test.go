@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -35,6 +36,7 @@ type MockDeployer struct {
 	labels           map[string]string
 	deployNamespaces []string
 	deployErr        error
+	deployed         manifest.ManifestList
 	dependencies     []string
 	dependenciesErr  error
 	cleanupErr       error
@@ -42,6 +44,10 @@ type MockDeployer struct {
 	renderErr        error
 }
 
+func (m *MockDeployer) DeployedManifests() manifest.ManifestList {
+	return m.deployed
+}
+
 func (m *MockDeployer) Dependencies() ([]string, error) {
 	return m.dependencies, m.dependenciesErr
 }
@@ -89,6 +95,11 @@ func (m *MockDeployer) WithDeployNamespaces(namespaces []string) *MockDeployer {
 	return m
 }
 
+func (m *MockDeployer) WithDeployedManifests(manifests manifest.ManifestList) *MockDeployer {
+	m.deployed = manifests
+	return m
+}
+
 func (m *MockDeployer) WithDependencies(dependencies []string) *MockDeployer {
 	m.dependencies = dependencies
 	return m
@@ -153,6 +164,16 @@ func TestDeployerMux_Deploy(t *testing.T) {
 	}
 }
 
+func TestDeployerMux_DeployedManifests(t *testing.T) {
+	deployerMux := DeployerMux([]Deployer{
+		NewMockDeployer().WithDeployedManifests(manifest.ManifestList{[]byte("kind: Pod")}),
+		NewMockDeployer(),
+		NewMockDeployer().WithDeployedManifests(manifest.ManifestList{[]byte("kind: Certificate")}),
+	})
+
+	testutil.CheckDeepEqual(t, manifest.ManifestList{[]byte("kind: Pod"), []byte("kind: Certificate")}, deployerMux.DeployedManifests())
+}
+
 func TestDeployerMux_Dependencies(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -88,6 +88,9 @@ type Deployer struct {
 
 	// bV is the helm binary version
 	bV semver.Version
+
+	// deployed are the manifests of the releases installed by the last deploy
+	deployed manifest.ManifestList
 }
 
 // NewDeployer returns a configured Deployer.  Returns an error if current version of helm is less than 3.0.0.
@@ -121,6 +124,7 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 	var dRes []types.Artifact
 	nsMap := map[string]struct{}{}
 	valuesSet := map[string]bool{}
+	h.deployed = nil
 
 	// Deploy every release
 	for _, r := range h.Releases {
@@ -165,6 +169,11 @@ func (h *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 	return namespaces, nil
 }
 
+// DeployedManifests returns the manifests of the releases installed by the last call to Deploy.
+func (h *Deployer) DeployedManifests() manifest.ManifestList {
+	return h.deployed
+}
+
 // Dependencies returns a list of files that the deployer depends on.
 func (h *Deployer) Dependencies() ([]string, error) {
 	var deps []string
@@ -410,6 +419,12 @@ func (h *Deployer) deployRelease(ctx context.Context, out io.Writer, releaseName
 		return nil, userErr("get release", err)
 	}
 
+	manifests, err := manifest.Load(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		logrus.Debugf("Unable to read the manifests of release %s: %v", releaseName, err)
+	}
+	h.deployed = append(h.deployed, manifests...)
+
 	artifacts := parseReleaseInfo(opts.namespace, bufio.NewReader(&b))
 	return artifacts, nil
 }
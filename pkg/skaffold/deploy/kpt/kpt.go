@@ -66,6 +66,7 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	globalConfig       string
+	deployed           manifest.ManifestList
 }
 
 // NewDeployer generates a new Deployer object contains the kptDeploy schema.
@@ -166,10 +167,16 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 	if err := util.RunCmd(cmd); err != nil {
 		return nil, err
 	}
+	k.deployed = manifests
 
 	return namespaces, nil
 }
 
+// DeployedManifests returns the manifests applied by the last call to Deploy.
+func (k *Deployer) DeployedManifests() manifest.ManifestList {
+	return k.deployed
+}
+
 // Dependencies returns a list of files that the deployer depends on. This does NOT include applyDir.
 // In dev mode, a redeploy will be triggered if one of these files is updated.
 func (k *Deployer) Dependencies() ([]string, error) {
@@ -53,6 +53,7 @@ type Deployer struct {
 	insecureRegistries map[string]bool
 	labels             map[string]string
 	skipRender         bool
+	deployed           manifest.ManifestList
 }
 
 // NewDeployer returns a new Deployer for a DeployConfig filled
@@ -112,10 +113,16 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 	if err := k.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		return nil, err
 	}
+	k.deployed = manifests
 
 	return namespaces, nil
 }
 
+// DeployedManifests returns the manifests applied by the last call to Deploy.
+func (k *Deployer) DeployedManifests() manifest.ManifestList {
+	return k.deployed
+}
+
 func (k *Deployer) manifestFiles(manifests []string) ([]string, error) {
 	var nonURLManifests, gcsManifests []string
 	for _, manifest := range manifests {
@@ -247,6 +247,11 @@ func TestKubectlDeploy(t *testing.T) {
 			_, err = k.Deploy(context.Background(), ioutil.Discard, test.builds)
 
 			t.CheckError(test.shouldErr, err)
+			if test.shouldErr || len(test.kubectl.Manifests) == 0 {
+				t.CheckEmpty(k.DeployedManifests())
+			} else {
+				t.CheckDeepEqual(1, len(k.DeployedManifests()))
+			}
 		})
 	}
 }
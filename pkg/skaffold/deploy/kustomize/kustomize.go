@@ -98,6 +98,7 @@ type Deployer struct {
 	labels              map[string]string
 	globalConfig        string
 	useKubectlKustomize bool
+	deployed            manifest.ManifestList
 }
 
 func NewDeployer(cfg kubectl.Config, labels map[string]string, d *latest.KustomizeDeploy) (*Deployer, error) {
@@ -165,10 +166,16 @@ func (k *Deployer) Deploy(ctx context.Context, out io.Writer, builds []build.Art
 	if err := k.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		return nil, err
 	}
+	k.deployed = manifests
 
 	return namespaces, nil
 }
 
+// DeployedManifests returns the manifests applied by the last call to Deploy.
+func (k *Deployer) DeployedManifests() manifest.ManifestList {
+	return k.deployed
+}
+
 func (k *Deployer) renderManifests(ctx context.Context, out io.Writer, builds []build.Artifact) (manifest.ManifestList, error) {
 	if err := k.kubectl.CheckVersion(ctx); err != nil {
 		color.Default.Fprintln(out, "kubectl client version:", k.kubectl.Version(ctx))
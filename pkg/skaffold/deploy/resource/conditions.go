@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// readyConditions are the conditions that tell that a custom resource is ready, in order of precedence.
+var readyConditions = []string{"Ready", "Available"}
+
+// object holds the fields of a resource that are needed to check its status.
+type object struct {
+	Metadata struct {
+		Generation int64 `json:"generation"`
+	} `json:"metadata"`
+	Status struct {
+		ObservedGeneration *int64      `json:"observedGeneration"`
+		Conditions         []condition `json:"conditions"`
+	} `json:"status"`
+}
+
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func (o object) condition(conditionType string) (condition, bool) {
+	for _, c := range o.Status.Conditions {
+		if c.Type == conditionType {
+			return c, true
+		}
+	}
+	return condition{}, false
+}
+
+func (c condition) isTrue() bool {
+	return c.Status == "True"
+}
+
+func (c condition) details() string {
+	if c.Message != "" {
+		return c.Message
+	}
+	return c.Reason
+}
+
+// checkJobStatus checks whether a job has completed.
+func (r *Resource) checkJobStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	obj, ae, ok := r.get(ctx, kubeCtl)
+	if !ok {
+		return ae
+	}
+
+	if c, found := obj.condition("Failed"); found && c.isTrue() {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_JOB_FAILED,
+			Message: fmt.Sprintf("job failed: %s\n", c.details()),
+		}
+	}
+	if c, found := obj.condition("Complete"); found && c.isTrue() {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "job completed\n",
+		}
+	}
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+		Message: "waiting for job to complete\n",
+	}
+}
+
+// checkConditions checks whether a custom resource is ready, based on its `status.conditions`.
+// A resource that has conditions is pending until it has a `Ready` or `Available` condition and,
+// if it reports `status.observedGeneration`, until its controller has observed its latest generation.
+// A resource without any condition, like a ServiceMonitor, has nothing to wait for and is ready.
+func (r *Resource) checkConditions(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	obj, ae, ok := r.get(ctx, kubeCtl)
+	if !ok {
+		return ae
+	}
+
+	if observed := obj.Status.ObservedGeneration; observed != nil && *observed != obj.Metadata.Generation {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			Message: "waiting for the latest changes to be observed\n",
+		}
+	}
+
+	if len(obj.Status.Conditions) == 0 {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+			Message: "no conditions to wait for\n",
+		}
+	}
+
+	for _, conditionType := range readyConditions {
+		c, found := obj.condition(conditionType)
+		if !found {
+			continue
+		}
+		if c.isTrue() {
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+				Message: fmt.Sprintf("%s\n", strings.ToLower(conditionType)),
+			}
+		}
+		message := fmt.Sprintf("not %s", strings.ToLower(conditionType))
+		if details := c.details(); details != "" {
+			message = fmt.Sprintf("%s: %s", message, details)
+		}
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			Message: message + "\n",
+		}
+	}
+
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+		Message: "waiting for a ready or available condition\n",
+	}
+}
+
+// get fetches the resource with kubectl. It returns false, along with the error to report, if that failed.
+func (r *Resource) get(ctx context.Context, kubeCtl *kubectl.CLI) (object, proto.ActionableErr, bool) {
+	var obj object
+	b, ae, ok := r.getJSON(ctx, kubeCtl)
+	if !ok {
+		return obj, ae, false
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return obj, proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("parsing %s: %v\n", r, err),
+		}, false
+	}
+	return obj, proto.ActionableErr{}, true
+}
+
+// getJSON fetches the JSON definition of the resource with kubectl. It returns false, along with the error to report, if that failed.
+func (r *Resource) getJSON(ctx context.Context, kubeCtl *kubectl.CLI) ([]byte, proto.ActionableErr, bool) {
+	b, err := kubeCtl.RunOut(ctx, "get", r.rType, r.name, "--namespace", r.namespace, "-o", "json")
+	if err != nil {
+		return nil, parseKubectlError(err), false
+	}
+	return b, proto.ActionableErr{}, true
+}
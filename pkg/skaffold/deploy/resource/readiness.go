@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// WithReadinessRule makes the status check of a resource rely on a user-defined rule
+// rather than on the built-in checks for its type.
+func (r *Resource) WithReadinessRule(rule *latest.ReadinessRule) *Resource {
+	r.rule = rule
+	return r
+}
+
+// checkReadinessRule checks whether a resource is ready, or has failed, according to its readiness rule.
+// The failure conditions are checked first: any one of them that holds fails the status check.
+// The resource is ready once all the success conditions hold.
+func (r *Resource) checkReadinessRule(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	b, ae, ok := r.getJSON(ctx, kubeCtl)
+	if !ok {
+		return ae
+	}
+	var obj interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return proto.ActionableErr{
+			ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+			Message: fmt.Sprintf("parsing %s: %v\n", r, err),
+		}
+	}
+
+	for _, c := range r.rule.Failure {
+		holds, details, err := evalCondition(obj, c)
+		if err != nil {
+			return invalidRuleErr(err)
+		}
+		if holds {
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_FAILED,
+				Message: details + "\n",
+			}
+		}
+	}
+
+	for _, c := range r.rule.Success {
+		holds, details, err := evalCondition(obj, c)
+		if err != nil {
+			return invalidRuleErr(err)
+		}
+		if !holds {
+			return proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+				Message: fmt.Sprintf("not ready: %s\n", details),
+			}
+		}
+	}
+
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+		Message: "ready\n",
+	}
+}
+
+// evalCondition tells whether a condition holds for the given object, along with the message explaining
+// its current state: the field selected by `messagePath` if any, or the field selected by `jsonPath`.
+func evalCondition(obj interface{}, c latest.ResourceCondition) (bool, string, error) {
+	value, err := evalJSONPath(obj, c.JSONPath)
+	if err != nil {
+		return false, "", err
+	}
+
+	var holds bool
+	if c.Value == "" {
+		holds = value != ""
+	} else {
+		holds = value == c.Value
+	}
+
+	if c.MessagePath != "" {
+		message, err := evalJSONPath(obj, c.MessagePath)
+		if err != nil {
+			return false, "", err
+		}
+		if message != "" {
+			return holds, message, nil
+		}
+	}
+	if value == "" {
+		return holds, fmt.Sprintf("%s is not set", c.JSONPath), nil
+	}
+	return holds, fmt.Sprintf("%s is %q", c.JSONPath, value), nil
+}
+
+// evalJSONPath returns the value of the fields selected by a JSONPath expression, as printed by `kubectl -o jsonpath`.
+// Missing fields are empty.
+func evalJSONPath(obj interface{}, expr string) (string, error) {
+	j := jsonpath.New("readinessRule").AllowMissingKeys(true)
+	if err := j.Parse(expr); err != nil {
+		return "", fmt.Errorf("parsing JSONPath %q: %w", expr, err)
+	}
+
+	var buf bytes.Buffer
+	if err := j.Execute(&buf, obj); err != nil {
+		return "", fmt.Errorf("evaluating JSONPath %q: %w", expr, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func invalidRuleErr(err error) proto.ActionableErr {
+	return proto.ActionableErr{
+		ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN,
+		Message: fmt.Sprintf("invalid readiness rule: %v\n", err),
+	}
+}
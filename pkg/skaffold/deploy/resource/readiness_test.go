@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCheckReadinessRule(t *testing.T) {
+	certificateRule := latest.ReadinessRule{
+		Group: "cert-manager.io",
+		Kind:  "Certificate",
+		Success: []latest.ResourceCondition{{
+			JSONPath:    `{.status.conditions[?(@.type=="Ready")].status}`,
+			Value:       "True",
+			MessagePath: `{.status.conditions[?(@.type=="Ready")].message}`,
+		}},
+		Failure: []latest.ResourceCondition{{
+			JSONPath:    `{.status.conditions[?(@.type=="Issuing")].reason}`,
+			Value:       "Failed",
+			MessagePath: `{.status.conditions[?(@.type=="Issuing")].message}`,
+		}},
+	}
+	topicRule := latest.ReadinessRule{
+		Group:   "kafka.strimzi.io",
+		Kind:    "KafkaTopic",
+		Success: []latest.ResourceCondition{{JSONPath: "{.status.topicName}"}},
+		Failure: []latest.ResourceCondition{{JSONPath: "{.status.error}"}},
+	}
+
+	tests := []struct {
+		description     string
+		rule            latest.ReadinessRule
+		object          string
+		expectedCode    proto.StatusCode
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description:     "success condition holds",
+			rule:            certificateRule,
+			object:          `{"status":{"conditions":[{"type":"Ready","status":"True","message":"Certificate is up to date"}]}}`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "ready\n",
+			complete:        true,
+		},
+		{
+			description:     "success condition doesn't hold",
+			rule:            certificateRule,
+			object:          `{"status":{"conditions":[{"type":"Ready","status":"False","message":"Issuing certificate as Secret does not exist"}]}}`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			expectedDetails: "not ready: Issuing certificate as Secret does not exist\n",
+		},
+		{
+			description:     "failure condition holds",
+			rule:            certificateRule,
+			object:          `{"status":{"conditions":[{"type":"Ready","status":"False"},{"type":"Issuing","status":"False","reason":"Failed","message":"issuer \"letsencrypt\" not found"}]}}`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_FAILED,
+			expectedDetails: "issuer \"letsencrypt\" not found\n",
+			complete:        true,
+		},
+		{
+			description:     "field without message path",
+			rule:            topicRule,
+			object:          `{"status":{"topicName":"orders"}}`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "ready\n",
+			complete:        true,
+		},
+		{
+			description:     "missing field",
+			rule:            topicRule,
+			object:          `{"spec":{}}`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			expectedDetails: "not ready: {.status.topicName} is not set\n",
+		},
+		{
+			description:     "failure field is set",
+			rule:            topicRule,
+			object:          `{"status":{"error":"invalid partitions"}}`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_FAILED,
+			expectedDetails: "{.status.error} is \"invalid partitions\"\n",
+			complete:        true,
+		},
+		{
+			description:     "no conditions",
+			rule:            latest.ReadinessRule{Kind: "PersistentVolumeClaim"},
+			object:          `{"status":{"phase":"Pending"}}`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "ready\n",
+			complete:        true,
+		},
+		{
+			description:     "invalid JSONPath",
+			rule:            latest.ReadinessRule{Kind: "PersistentVolumeClaim", Success: []latest.ResourceCondition{{JSONPath: "{.status.phase"}}},
+			object:          `{"status":{"phase":"Bound"}}`,
+			expectedCode:    proto.StatusCode_STATUSCHECK_UNKNOWN,
+			expectedDetails: "invalid readiness rule: parsing JSONPath \"{.status.phase\": unclosed action\n",
+			complete:        true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("kubectl --context kubecontext get custom res --namespace test -o json", test.object))
+
+			r := NewResource("res", "custom", "test", 0).WithReadinessRule(&test.rule)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedCode, r.Status().ActionableError().ErrCode)
+			t.CheckDeepEqual(test.expectedDetails, r.Status().ActionableError().Message)
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
+
+func TestReadinessRuleReplacesRolloutStatus(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOut("kubectl --context kubecontext get deployment dep --namespace test -o json", `{"status":{"readyReplicas":1}}`))
+
+		r := NewDeployment("dep", "test", 0).WithReadinessRule(&latest.ReadinessRule{
+			Group:   "apps",
+			Kind:    "Deployment",
+			Success: []latest.ResourceCondition{{JSONPath: "{.status.readyReplicas}", Value: "1"}},
+		})
+		r.CheckStatus(context.Background(), &statusConfig{})
+
+		t.CheckDeepEqual(proto.StatusCode_STATUSCHECK_SUCCESS, r.Status().ActionableError().ErrCode)
+	})
+}
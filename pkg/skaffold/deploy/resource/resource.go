@@ -28,11 +28,20 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/diag/validator"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
+// Types of the resources whose status is checked. Custom resources have their lowercase kind and API group as type,
+// for example `certificate.cert-manager.io`.
+const (
+	TypeDeployment  = "deployment"
+	TypeStatefulSet = "statefulset"
+	TypeDaemonSet   = "daemonset"
+	TypeJob         = "job"
+)
+
 const (
-	deploymentType          = "deployment"
 	rollOutSuccess          = "successfully rolled out"
 	connectionErrMsg        = "Unable to connect to the server"
 	killedErrMsg            = "signal: killed"
@@ -46,6 +55,17 @@ var (
 	msgKubectlKilled     = "kubectl rollout status command interrupted\n"
 	MsgKubectlConnection = "kubectl connection error\n"
 
+	// rolloutSuccesses are the messages printed by `kubectl rollout status` once the rollout of a deployment,
+	// a daemon set or a statefulset is complete.
+	rolloutSuccesses = []string{rollOutSuccess, "rolling update complete", "roll out complete"}
+
+	// rolloutNames are the names given to resources in the messages printed by `kubectl rollout status`.
+	rolloutNames = map[string]string{
+		TypeDeployment:  "deployment",
+		TypeStatefulSet: "statefulset",
+		TypeDaemonSet:   "daemon set",
+	}
+
 	nonRetryContainerErrors = map[proto.StatusCode]struct{}{
 		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:       {},
 		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:    {},
@@ -54,7 +74,11 @@ var (
 	}
 )
 
-type Deployment struct {
+// Resource is a deployed resource whose status is checked until it's ready.
+// Deployments, statefulsets and daemon sets are ready once rolled out, jobs once completed,
+// and custom resources once their `Ready` or `Available` condition is true.
+// A user-defined readiness rule replaces those checks.
+type Resource struct {
 	name         string
 	namespace    string
 	rType        string
@@ -64,106 +88,127 @@ type Deployment struct {
 	deadline     time.Duration
 	pods         map[string]validator.Resource
 	podValidator diag.Diagnose
+	rule         *latest.ReadinessRule
 }
 
-func (d *Deployment) Deadline() time.Duration {
-	return d.deadline
+func (r *Resource) Deadline() time.Duration {
+	return r.deadline
 }
 
-func (d *Deployment) UpdateStatus(ae proto.ActionableErr) {
+func (r *Resource) UpdateStatus(ae proto.ActionableErr) {
 	updated := newStatus(ae)
-	if d.status.Equal(updated) {
-		d.status.changed = false
+	if r.status.Equal(updated) {
+		r.status.changed = false
 		return
 	}
-	d.status = updated
-	d.statusCode = updated.ActionableError().ErrCode
-	d.status.changed = true
+	r.status = updated
+	r.statusCode = updated.ActionableError().ErrCode
+	r.status.changed = true
 	if ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS || isErrAndNotRetryAble(ae.ErrCode) {
-		d.done = true
+		r.done = true
 	}
 }
 
-func NewDeployment(name string, ns string, deadline time.Duration) *Deployment {
-	return &Deployment{
+func NewDeployment(name string, ns string, deadline time.Duration) *Resource {
+	return NewResource(name, TypeDeployment, ns, deadline)
+}
+
+// NewResource creates a Resource of the given type.
+func NewResource(name string, rType string, ns string, deadline time.Duration) *Resource {
+	return &Resource{
 		name:         name,
 		namespace:    ns,
-		rType:        deploymentType,
+		rType:        rType,
 		status:       newStatus(proto.ActionableErr{}),
 		deadline:     deadline,
 		podValidator: diag.New(nil),
 	}
 }
 
-func (d *Deployment) WithValidator(pd diag.Diagnose) *Deployment {
-	d.podValidator = pd
-	return d
+func (r *Resource) WithValidator(pd diag.Diagnose) *Resource {
+	r.podValidator = pd
+	return r
 }
 
-func (d *Deployment) CheckStatus(ctx context.Context, cfg kubectl.Config) {
+func (r *Resource) CheckStatus(ctx context.Context, cfg kubectl.Config) {
 	kubeCtl := kubectl.NewCLI(cfg, "")
 
-	b, err := kubeCtl.RunOut(ctx, "rollout", "status", "deployment", d.name, "--namespace", d.namespace, "--watch=false")
+	var ae proto.ActionableErr
+	switch {
+	case r.rule != nil:
+		ae = r.checkReadinessRule(ctx, kubeCtl)
+	case r.rType == TypeDeployment, r.rType == TypeStatefulSet, r.rType == TypeDaemonSet:
+		ae = r.checkRolloutStatus(ctx, kubeCtl)
+	case r.rType == TypeJob:
+		ae = r.checkJobStatus(ctx, kubeCtl)
+	default:
+		ae = r.checkConditions(ctx, kubeCtl)
+	}
 	if ctx.Err() != nil {
 		return
 	}
 
-	details := d.cleanupStatus(string(b))
+	r.UpdateStatus(ae)
+	if err := r.fetchPods(ctx); err != nil {
+		logrus.Debugf("pod statuses could be fetched this time due to %s", err)
+	}
+}
+
+func (r *Resource) checkRolloutStatus(ctx context.Context, kubeCtl *kubectl.CLI) proto.ActionableErr {
+	b, err := kubeCtl.RunOut(ctx, "rollout", "status", r.rType, r.name, "--namespace", r.namespace, "--watch=false")
+
+	details := r.cleanupStatus(string(b))
 
 	ae := parseKubectlRolloutError(details, err)
 	if ae.ErrCode == proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED {
-		ae.Message = fmt.Sprintf("received Ctrl-C or deployments could not stabilize within %v: %v", d.deadline, err)
-	}
-
-	d.UpdateStatus(ae)
-	if err := d.fetchPods(ctx); err != nil {
-		logrus.Debugf("pod statuses could be fetched this time due to %s", err)
+		ae.Message = fmt.Sprintf("received Ctrl-C or %ss could not stabilize within %v: %v", r.rType, r.deadline, err)
 	}
+	return ae
 }
 
-func (d *Deployment) String() string {
-	if d.namespace == "default" {
-		return fmt.Sprintf("%s/%s", d.rType, d.name)
+func (r *Resource) String() string {
+	if r.namespace == "default" {
+		return fmt.Sprintf("%s/%s", r.rType, r.name)
 	}
 
-	return fmt.Sprintf("%s:%s/%s", d.namespace, d.rType, d.name)
+	return fmt.Sprintf("%s:%s/%s", r.namespace, r.rType, r.name)
 }
 
-func (d *Deployment) Name() string {
-	return d.name
+func (r *Resource) Name() string {
+	return r.name
 }
 
-func (d *Deployment) Status() Status {
-	return d.status
+func (r *Resource) Status() Status {
+	return r.status
 }
 
-func (d *Deployment) IsStatusCheckCompleteOrCancelled() bool {
-	return d.done || d.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED
+func (r *Resource) IsStatusCheckCompleteOrCancelled() bool {
+	return r.done || r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED
 }
 
-func (d *Deployment) StatusMessage() string {
-	for _, p := range d.pods {
+func (r *Resource) StatusMessage() string {
+	for _, p := range r.pods {
 		if s := p.ActionableError(); s.ErrCode != proto.StatusCode_STATUSCHECK_SUCCESS {
 			return fmt.Sprintf("%s\n", s.Message)
 		}
 	}
-	return d.status.String()
+	return r.status.String()
 }
 
-func (d *Deployment) MarkComplete() {
-	d.done = true
+func (r *Resource) MarkComplete() {
+	r.done = true
 }
 
-// ReportSinceLastUpdated returns a string representing deployment status along with tab header
+// ReportSinceLastUpdated returns a string representing resource status along with tab header
 // e.g.
 //  - testNs:deployment/leeroy-app: waiting for rollout to complete. (1/2) pending
 //      - testNs:pod/leeroy-app-xvbg : error pulling container image
-func (d *Deployment) ReportSinceLastUpdated(isMuted bool) string {
-	if d.status.reported && !d.status.changed {
+func (r *Resource) ReportSinceLastUpdated(isMuted bool) string {
+	if r.status.reported && !r.status.changed {
 		return ""
 	}
-	d.status.reported = true
-	if d.status.String() == "" {
+	r.status.reported = true
+	if r.status.String() == "" {
 		return ""
 	}
 	var result strings.Builder
@@ -172,7 +217,7 @@ func (d *Deployment) ReportSinceLastUpdated(isMuted bool) string {
 	// 1. No pods have been scheduled for the deployment
 	// 2. All containers are in running phase with no errors.
 	// In such case, avoid printing any status update for the deployment.
-	for _, p := range d.pods {
+	for _, p := range r.pods {
 		if s := p.ActionableError().Message; s != "" {
 			result.WriteString(fmt.Sprintf("%s %s %s: %s\n", tab, tabHeader, p, s))
 			// if logs are muted, write container logs to file and last 3 lines to
@@ -192,11 +237,11 @@ func (d *Deployment) ReportSinceLastUpdated(isMuted bool) string {
 			writeTrimLines(trimLines)
 		}
 	}
-	return fmt.Sprintf("%s %s: %s%s", tabHeader, d, d.StatusMessage(), result.String())
+	return fmt.Sprintf("%s %s: %s%s", tabHeader, r, r.StatusMessage(), result.String())
 }
 
-func (d *Deployment) cleanupStatus(msg string) string {
-	clean := strings.ReplaceAll(msg, `deployment "`+d.Name()+`" `, "")
+func (r *Resource) cleanupStatus(msg string) string {
+	clean := strings.ReplaceAll(msg, rolloutNames[r.rType]+` "`+r.Name()+`" `, "")
 	if len(clean) > 0 {
 		clean = strings.ToLower(clean[0:1]) + clean[1:]
 	}
@@ -213,7 +258,7 @@ func (d *Deployment) cleanupStatus(msg string) string {
 // Killed: 9
 func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
 	switch {
-	case err == nil && strings.Contains(details, rollOutSuccess):
+	case err == nil && isRolloutComplete(details):
 		return proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS,
 			Message: details,
@@ -223,6 +268,22 @@ func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
 			ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 			Message: details,
 		}
+	default:
+		return parseKubectlError(err)
+	}
+}
+
+func isRolloutComplete(details string) bool {
+	for _, s := range rolloutSuccesses {
+		if strings.Contains(details, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseKubectlError(err error) proto.ActionableErr {
+	switch {
 	case strings.Contains(err.Error(), connectionErrMsg):
 		return proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
@@ -243,13 +304,14 @@ func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
 
 func isErrAndNotRetryAble(statusCode proto.StatusCode) bool {
 	return statusCode != proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR &&
-		statusCode != proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING
+		statusCode != proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING &&
+		statusCode != proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY
 }
 
 // HasEncounteredUnrecoverableError goes through all pod statuses and return true
 // if any cannot be recovered
-func (d *Deployment) HasEncounteredUnrecoverableError() bool {
-	for _, p := range d.pods {
+func (r *Resource) HasEncounteredUnrecoverableError() bool {
+	for _, p := range r.pods {
 		if _, ok := nonRetryContainerErrors[p.ActionableError().ErrCode]; ok {
 			return true
 		}
@@ -257,20 +319,20 @@ func (d *Deployment) HasEncounteredUnrecoverableError() bool {
 	return false
 }
 
-func (d *Deployment) fetchPods(ctx context.Context) error {
+func (r *Resource) fetchPods(ctx context.Context) error {
 	timeoutContext, cancel := context.WithTimeout(ctx, defaultPodCheckDeadline)
 	defer cancel()
-	pods, err := d.podValidator.Run(timeoutContext)
+	pods, err := r.podValidator.Run(timeoutContext)
 	if err != nil {
 		return err
 	}
 
 	newPods := map[string]validator.Resource{}
-	d.status.changed = false
+	r.status.changed = false
 	for _, p := range pods {
-		originalPod, found := d.pods[p.String()]
+		originalPod, found := r.pods[p.String()]
 		if !found || originalPod.StatusUpdated(p) {
-			d.status.changed = true
+			r.status.changed = true
 			switch p.ActionableError().ErrCode {
 			case proto.StatusCode_STATUSCHECK_CONTAINER_CREATING,
 				proto.StatusCode_STATUSCHECK_POD_INITIALIZING:
@@ -281,33 +343,33 @@ func (d *Deployment) fetchPods(ctx context.Context) error {
 		}
 		newPods[p.String()] = p
 	}
-	d.pods = newPods
+	r.pods = newPods
 	return nil
 }
 
-// StatusCode() returns the deployment status code if the status check is cancelled
-// or if no pod data exists for this deployment.
+// StatusCode() returns the resource status code if the status check is cancelled
+// or if no pod data exists for this resource.
 // If pods are fetched, this function returns the error code a pod container encountered.
-func (d *Deployment) StatusCode() proto.StatusCode {
-	// do not process pod status codes if another deployment failed
+func (r *Resource) StatusCode() proto.StatusCode {
+	// do not process pod status codes if another resource failed
 	// or the user aborted the run.
-	if d.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
-		return d.statusCode
+	if r.statusCode == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
+		return r.statusCode
 	}
-	for _, p := range d.pods {
+	for _, p := range r.pods {
 		if s := p.ActionableError().ErrCode; s != proto.StatusCode_STATUSCHECK_SUCCESS {
 			return s
 		}
 	}
-	return d.statusCode
+	return r.statusCode
 }
 
-func (d *Deployment) WithPodStatuses(scs []proto.StatusCode) *Deployment {
-	d.pods = map[string]validator.Resource{}
+func (r *Resource) WithPodStatuses(scs []proto.StatusCode) *Resource {
+	r.pods = map[string]validator.Resource{}
 	for i, s := range scs {
-		name := fmt.Sprintf("%s-%d", d.name, i)
-		d.pods[name] = validator.NewResource("test", "pod", "foo", validator.Status("failed"),
+		name := fmt.Sprintf("%s-%d", r.name, i)
+		r.pods[name] = validator.NewResource("test", "pod", "foo", validator.Status("failed"),
 			proto.ActionableErr{Message: "pod failed", ErrCode: s}, nil)
 	}
-	return d
+	return r
 }
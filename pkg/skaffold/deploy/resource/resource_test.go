@@ -120,6 +120,149 @@ func TestDeploymentCheckStatus(t *testing.T) {
 	}
 }
 
+func TestResourceCheckStatus(t *testing.T) {
+	tests := []struct {
+		description     string
+		rType           string
+		commands        util.Command
+		expectedCode    proto.StatusCode
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description:     "statefulset rolled out",
+			rType:           TypeStatefulSet,
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext rollout status statefulset res --namespace test --watch=false", "statefulset rolling update complete 3 pods at revision res-7b9f8...\n"),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "statefulset rolling update complete 3 pods at revision res-7b9f8...\n",
+			complete:        true,
+		},
+		{
+			description:     "statefulset partitioned roll out",
+			rType:           TypeStatefulSet,
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext rollout status statefulset res --namespace test --watch=false", "partitioned roll out complete: 2 new pods have been updated...\n"),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "partitioned roll out complete: 2 new pods have been updated...\n",
+			complete:        true,
+		},
+		{
+			description:     "daemon set rolling out",
+			rType:           TypeDaemonSet,
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext rollout status daemonset res --namespace test --watch=false", "Waiting for daemon set \"res\" rollout to finish: 0 of 1 updated pods are available...\n"),
+			expectedCode:    proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
+			expectedDetails: "waiting for rollout to finish: 0 of 1 updated pods are available...\n",
+		},
+		{
+			description:     "daemon set rolled out",
+			rType:           TypeDaemonSet,
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext rollout status daemonset res --namespace test --watch=false", "daemon set \"res\" successfully rolled out\n"),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "successfully rolled out\n",
+			complete:        true,
+		},
+		{
+			description:     "job running",
+			rType:           TypeJob,
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get job res --namespace test -o json", `{"status":{"active":1}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			expectedDetails: "waiting for job to complete\n",
+		},
+		{
+			description:     "job completed",
+			rType:           TypeJob,
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get job res --namespace test -o json", `{"status":{"conditions":[{"type":"Complete","status":"True"}]}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "job completed\n",
+			complete:        true,
+		},
+		{
+			description:     "job failed",
+			rType:           TypeJob,
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get job res --namespace test -o json", `{"status":{"conditions":[{"type":"Failed","status":"True","reason":"BackoffLimitExceeded","message":"Job has reached the specified backoff limit"}]}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_JOB_FAILED,
+			expectedDetails: "job failed: Job has reached the specified backoff limit\n",
+			complete:        true,
+		},
+		{
+			description:     "custom resource ready",
+			rType:           "certificate.cert-manager.io",
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get certificate.cert-manager.io res --namespace test -o json", `{"status":{"conditions":[{"type":"Ready","status":"True"}]}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "ready\n",
+			complete:        true,
+		},
+		{
+			description:     "custom resource not available",
+			rType:           "database.example.com",
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get database.example.com res --namespace test -o json", `{"status":{"conditions":[{"type":"Available","status":"False","reason":"Provisioning"}]}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			expectedDetails: "not available: Provisioning\n",
+		},
+		{
+			description:     "custom resource with outdated status",
+			rType:           "database.example.com",
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get database.example.com res --namespace test -o json", `{"metadata":{"generation":2},"status":{"observedGeneration":1,"conditions":[{"type":"Ready","status":"True"}]}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			expectedDetails: "waiting for the latest changes to be observed\n",
+		},
+		{
+			description:     "custom resource with observed generation",
+			rType:           "database.example.com",
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get database.example.com res --namespace test -o json", `{"metadata":{"generation":2},"status":{"observedGeneration":2,"conditions":[{"type":"Ready","status":"True"}]}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "ready\n",
+			complete:        true,
+		},
+		{
+			description:     "custom resource with status not observed yet",
+			rType:           "database.example.com",
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get database.example.com res --namespace test -o json", `{"metadata":{"generation":1},"status":{"observedGeneration":0,"conditions":[{"type":"Ready","status":"True"}]}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			expectedDetails: "waiting for the latest changes to be observed\n",
+		},
+		{
+			description:     "custom resource without readiness condition",
+			rType:           "config.example.com",
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get config.example.com res --namespace test -o json", `{"status":{"conditions":[{"type":"Reconciling","status":"True"}]}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+			expectedDetails: "waiting for a ready or available condition\n",
+		},
+		{
+			description:     "custom resource without conditions",
+			rType:           "servicemonitor.monitoring.coreos.com",
+			commands:        testutil.CmdRunOut("kubectl --context kubecontext get servicemonitor.monitoring.coreos.com res --namespace test -o json", `{"spec":{}}`),
+			expectedCode:    proto.StatusCode_STATUSCHECK_SUCCESS,
+			expectedDetails: "no conditions to wait for\n",
+			complete:        true,
+		},
+		{
+			description:     "custom resource not found",
+			rType:           "config.example.com",
+			commands:        testutil.CmdRunOutErr("kubectl --context kubecontext get config.example.com res --namespace test -o json", "", errors.New("not found")),
+			expectedCode:    proto.StatusCode_STATUSCHECK_UNKNOWN,
+			expectedDetails: "not found",
+			complete:        true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			r := NewResource("res", test.rType, "test", 0)
+			r.CheckStatus(context.Background(), &statusConfig{})
+
+			t.CheckDeepEqual(test.expectedCode, r.Status().ActionableError().ErrCode)
+			t.CheckDeepEqual(test.expectedDetails, r.Status().ActionableError().Message)
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckCompleteOrCancelled())
+		})
+	}
+}
+
+func TestResourceString(t *testing.T) {
+	testutil.CheckDeepEqual(t, "statefulset/db", NewResource("db", TypeStatefulSet, "default", 0).String())
+	testutil.CheckDeepEqual(t, "test:certificate.cert-manager.io/tls", NewResource("tls", "certificate.cert-manager.io", "test", 0).String())
+}
+
 func TestParseKubectlError(t *testing.T) {
 	tests := []struct {
 		description string
@@ -203,6 +346,15 @@ func TestIsErrAndNotRetriable(t *testing.T) {
 			statusCode:  proto.StatusCode_STATUSCHECK_SUCCESS,
 			expected:    true,
 		},
+		{
+			description: "resource not ready",
+			statusCode:  proto.StatusCode_STATUSCHECK_RESOURCE_NOT_READY,
+		},
+		{
+			description: "job failed",
+			statusCode:  proto.StatusCode_STATUSCHECK_JOB_FAILED,
+			expected:    true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -26,7 +26,11 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/diag"
@@ -38,6 +42,9 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yaml"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
@@ -67,6 +74,7 @@ type Config interface {
 
 	GetNamespaces() []string
 	StatusCheckDeadlineSeconds() int
+	ReadinessRules() []latest.ReadinessRule
 	Muted() config.Muted
 }
 
@@ -75,25 +83,42 @@ type Checker interface {
 	Check(context.Context, io.Writer) error
 }
 
-// statusChecker runs status checks for pods and deployments
+// statusChecker runs status checks for pods, deployments, statefulsets, daemon sets, jobs and custom resources
 type statusChecker struct {
 	cfg             Config
 	labeller        *label.DefaultLabeller
 	deadlineSeconds int
 	muteLogs        bool
+	rules           map[string]*latest.ReadinessRule
+	manifests       manifest.ManifestList
 }
 
-// NewStatusChecker returns a status checker which runs checks on deployments and pods.
-func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller) Checker {
+// NewStatusChecker returns a status checker which runs checks on the deployed resources and their pods.
+// The custom resources to check are taken from the deployed manifests.
+func NewStatusChecker(cfg Config, labeller *label.DefaultLabeller, manifests manifest.ManifestList) Checker {
 	return statusChecker{
 		muteLogs:        cfg.Muted().MuteStatusCheck(),
 		cfg:             cfg,
 		labeller:        labeller,
 		deadlineSeconds: cfg.StatusCheckDeadlineSeconds(),
+		rules:           readinessRulesByType(cfg.ReadinessRules()),
+		manifests:       manifests,
 	}
 }
 
-// Run runs the status checks on deployments and pods deployed in current skaffold dev iteration.
+// readinessRulesByType indexes the readiness rules by resource type. The first rule given for a kind wins.
+func readinessRulesByType(rules []latest.ReadinessRule) map[string]*latest.ReadinessRule {
+	byType := map[string]*latest.ReadinessRule{}
+	for i := range rules {
+		rType := resourceType(rules[i].Group, rules[i].Kind)
+		if _, found := byType[rType]; !found {
+			byType[rType] = &rules[i]
+		}
+	}
+	return byType
+}
+
+// Run runs the status checks on resources and pods deployed in current skaffold dev iteration.
 func (s statusChecker) Check(ctx context.Context, out io.Writer) error {
 	event.StatusCheckEventStarted()
 	errCode, err := s.statusCheck(ctx, out)
@@ -106,51 +131,91 @@ func (s statusChecker) statusCheck(ctx context.Context, out io.Writer) (proto.St
 	if err != nil {
 		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
+	dynClient, err := kubernetesclient.DynamicClient()
+	if err != nil {
+		return proto.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR, fmt.Errorf("getting Kubernetes dynamic client: %w", err)
+	}
 
-	deployments := make([]*resource.Deployment, 0)
+	refs := customResourceRefs(s.manifests, s.rules)
+	resources := make([]*resource.Resource, 0)
 	for _, n := range s.cfg.GetNamespaces() {
-		newDeployments, err := getDeployments(ctx, client, n, s.labeller,
-			getDeadline(s.deadlineSeconds))
+		newResources, err := getResources(ctx, client, dynClient, n, s.labeller,
+			getDeadline(s.deadlineSeconds), s.rules, refs)
 		if err != nil {
-			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch deployments: %w", err)
+			return proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR, fmt.Errorf("could not fetch resources: %w", err)
 		}
-		deployments = append(deployments, newDeployments...)
+		resources = append(resources, newResources...)
 	}
 
 	var wg sync.WaitGroup
 
-	c := newCounter(len(deployments))
+	c := newCounter(len(resources))
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	for _, d := range deployments {
+	for _, d := range resources {
 		wg.Add(1)
-		go func(r *resource.Deployment) {
+		go func(r *resource.Resource) {
 			defer wg.Done()
 			// keep updating the resource status until it fails/succeeds/times out
-			pollDeploymentStatus(ctx, s.cfg, r)
+			pollResourceStatus(ctx, s.cfg, r)
 			rcCopy := c.markProcessed(r.Status().Error())
 			s.printStatusCheckSummary(out, r, rcCopy)
-			// if one deployment fails, cancel status checks for all deployments.
+			// if one resource fails, cancel status checks for all resources.
 			if r.Status().Error() != nil && r.StatusCode() != proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 				cancel()
 			}
 		}(d)
 	}
 
-	// Retrieve pending deployments statuses
+	// Retrieve pending resources statuses
 	go func() {
-		s.printDeploymentStatus(ctx, out, deployments)
+		s.printResourceStatus(ctx, out, resources)
 	}()
 
-	// Wait for all deployment statuses to be fetched
+	// Wait for all resource statuses to be fetched
 	wg.Wait()
 	cancel()
-	return getSkaffoldDeployStatus(c, deployments)
+	return getSkaffoldDeployStatus(c, resources)
+}
+
+// typedResources are the kinds of resources that are listed with the typed client, by resource type.
+var typedResources = []struct {
+	rType string
+	get   func(context.Context, kubernetes.Interface, string, *label.DefaultLabeller, time.Duration) ([]*resource.Resource, error)
+}{
+	{"deployment.apps", getDeployments},
+	{"statefulset.apps", getStatefulSets},
+	{"daemonset.apps", getDaemonSets},
+	{"job.batch", getJobs},
+}
+
+// getResources lists the resources deployed by the current run in a namespace: deployments, statefulsets,
+// daemon sets, jobs, custom resources and the resources of any other kind that has a readiness rule.
+func getResources(ctx context.Context, client kubernetes.Interface, dynClient dynamic.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration, rules map[string]*latest.ReadinessRule, refs []resourceRef) ([]*resource.Resource, error) {
+	var resources []*resource.Resource
+	for _, typed := range typedResources {
+		r, err := typed.get(ctx, client, ns, l, deadlineDuration)
+		if err != nil {
+			return nil, err
+		}
+		if rule, found := rules[typed.rType]; found {
+			for _, res := range r {
+				res.WithReadinessRule(rule)
+			}
+		}
+		resources = append(resources, r...)
+	}
+
+	crs, err := getCustomResources(ctx, client.Discovery(), dynClient, ns, deadlineDuration, rules, refs)
+	if err != nil {
+		return nil, err
+	}
+	return append(resources, crs...), nil
 }
 
-func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Deployment, error) {
+func getDeployments(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadlineDuration time.Duration) ([]*resource.Resource, error) {
 	deps, err := client.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{
 		LabelSelector: l.RunIDSelector(),
 	})
@@ -158,7 +223,7 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		return nil, fmt.Errorf("could not fetch deployments: %w", err)
 	}
 
-	deployments := make([]*resource.Deployment, len(deps.Items))
+	deployments := make([]*resource.Resource, len(deps.Items))
 	for i, d := range deps.Items {
 		var deadline time.Duration
 		if d.Spec.ProgressDeadlineSeconds == nil || *d.Spec.ProgressDeadlineSeconds == kubernetesMaxDeadline {
@@ -166,20 +231,213 @@ func getDeployments(ctx context.Context, client kubernetes.Interface, ns string,
 		} else {
 			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
 		}
-		pd := diag.New([]string{d.Namespace}).
-			WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
-			WithValidators([]validator.Validator{validator.NewPodValidator(client)})
 
-		for k, v := range d.Spec.Template.Labels {
-			pd = pd.WithLabel(k, v)
+		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(podValidator(client, d.Namespace, l, d.Spec.Template.Labels))
+	}
+	return deployments, nil
+}
+
+func getStatefulSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadline time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch statefulsets: %w", err)
+	}
+
+	statefulSets := make([]*resource.Resource, len(sets.Items))
+	for i, s := range sets.Items {
+		statefulSets[i] = resource.NewResource(s.Name, resource.TypeStatefulSet, s.Namespace, deadline).WithValidator(podValidator(client, s.Namespace, l, s.Spec.Template.Labels))
+	}
+	return statefulSets, nil
+}
+
+func getDaemonSets(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadline time.Duration) ([]*resource.Resource, error) {
+	sets, err := client.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch daemon sets: %w", err)
+	}
+
+	daemonSets := make([]*resource.Resource, len(sets.Items))
+	for i, s := range sets.Items {
+		daemonSets[i] = resource.NewResource(s.Name, resource.TypeDaemonSet, s.Namespace, deadline).WithValidator(podValidator(client, s.Namespace, l, s.Spec.Template.Labels))
+	}
+	return daemonSets, nil
+}
+
+func getJobs(ctx context.Context, client kubernetes.Interface, ns string, l *label.DefaultLabeller, deadline time.Duration) ([]*resource.Resource, error) {
+	list, err := client.BatchV1().Jobs(ns).List(ctx, metav1.ListOptions{
+		LabelSelector: l.RunIDSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch jobs: %w", err)
+	}
+
+	jobs := make([]*resource.Resource, len(list.Items))
+	for i, j := range list.Items {
+		jobs[i] = resource.NewResource(j.Name, resource.TypeJob, j.Namespace, deadline).WithValidator(podValidator(client, j.Namespace, l, j.Spec.Template.Labels))
+	}
+	return jobs, nil
+}
+
+// resourceRef identifies a resource found in the deployed manifests.
+type resourceRef struct {
+	gv        schema.GroupVersion
+	kind      string
+	name      string
+	namespace string
+}
+
+// customResourceRefs returns the resources of the deployed manifests that are checked generically:
+// the custom resources, and the resources of the other built-in kinds that have a readiness rule.
+func customResourceRefs(manifests manifest.ManifestList, rules map[string]*latest.ReadinessRule) []resourceRef {
+	var refs []resourceRef
+	for _, m := range manifests {
+		var obj struct {
+			APIVersion string `yaml:"apiVersion"`
+			Kind       string `yaml:"kind"`
+			Metadata   struct {
+				Name      string `yaml:"name"`
+				Namespace string `yaml:"namespace"`
+			} `yaml:"metadata"`
+		}
+		if err := yaml.Unmarshal(m, &obj); err != nil || obj.Kind == "" || obj.Metadata.Name == "" {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(obj.APIVersion)
+		if err != nil {
+			continue
 		}
 
-		deployments[i] = resource.NewDeployment(d.Name, d.Namespace, deadline).WithValidator(pd)
+		rType := resourceType(gv.Group, obj.Kind)
+		if isTypedResource(rType) || (isBuiltinGroup(gv.Group) && rules[rType] == nil) {
+			continue
+		}
+		refs = append(refs, resourceRef{gv: gv, kind: obj.Kind, name: obj.Metadata.Name, namespace: obj.Metadata.Namespace})
 	}
-	return deployments, nil
+	return refs
+}
+
+// getCustomResources gets the resources found in the deployed manifests that belong to a namespace.
+// The resources that don't set their namespace are looked up in every namespace checked.
+func getCustomResources(ctx context.Context, disco discovery.DiscoveryInterface, dynClient dynamic.Interface, ns string, deadline time.Duration, rules map[string]*latest.ReadinessRule, refs []resourceRef) ([]*resource.Resource, error) {
+	apiResources := map[schema.GroupVersion]*metav1.APIResourceList{}
+	var resources []*resource.Resource
+	for _, ref := range refs {
+		if ref.namespace != "" && ref.namespace != ns {
+			continue
+		}
+
+		list, found := apiResources[ref.gv]
+		if !found {
+			var err error
+			if list, err = disco.ServerResourcesForGroupVersion(ref.gv.String()); err != nil {
+				logrus.Debugf("Unable to discover the resources of %s: %v", ref.gv, err)
+			}
+			apiResources[ref.gv] = list
+		}
+		name, namespaced := resourceName(list, ref.kind)
+		if name == "" || !namespaced {
+			continue
+		}
+
+		obj, err := dynClient.Resource(ref.gv.WithResource(name)).Namespace(ns).Get(ctx, ref.name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("could not fetch %s %s: %w", ref.kind, ref.name, err)
+			}
+			continue
+		}
+
+		rType := resourceType(ref.gv.Group, ref.kind)
+		res := resource.NewResource(obj.GetName(), rType, obj.GetNamespace(), deadline)
+		if rule := rules[rType]; rule != nil {
+			res.WithReadinessRule(rule)
+		}
+		resources = append(resources, res)
+	}
+	return resources, nil
+}
+
+// resourceName returns the name of the API resource of a kind, as used in URLs, and whether it's namespaced.
+func resourceName(list *metav1.APIResourceList, kind string) (string, bool) {
+	if list == nil {
+		return "", false
+	}
+	for _, r := range list.APIResources {
+		// Skip subresources.
+		if r.Kind == kind && !strings.Contains(r.Name, "/") {
+			return r.Name, r.Namespaced
+		}
+	}
+	return "", false
+}
+
+// resourceType returns the type of the resources of a given kind, as passed to kubectl:
+// their lowercase kind and API group, for example `certificate.cert-manager.io`, or only their lowercase kind
+// for the core API group.
+func resourceType(group, kind string) string {
+	if group == "" {
+		return strings.ToLower(kind)
+	}
+	return strings.ToLower(kind + "." + group)
+}
+
+func isTypedResource(rType string) bool {
+	for _, typed := range typedResources {
+		if typed.rType == rType {
+			return true
+		}
+	}
+	return false
+}
+
+// builtinGroups are the API groups built into Kubernetes.
+var builtinGroups = map[string]bool{
+	"":                             true,
+	"admissionregistration.k8s.io": true,
+	"apiextensions.k8s.io":         true,
+	"apiregistration.k8s.io":       true,
+	"apps":                         true,
+	"authentication.k8s.io":        true,
+	"authorization.k8s.io":         true,
+	"autoscaling":                  true,
+	"batch":                        true,
+	"certificates.k8s.io":          true,
+	"coordination.k8s.io":          true,
+	"discovery.k8s.io":             true,
+	"events.k8s.io":                true,
+	"extensions":                   true,
+	"flowcontrol.apiserver.k8s.io": true,
+	"internal.apiserver.k8s.io":    true,
+	"networking.k8s.io":            true,
+	"node.k8s.io":                  true,
+	"policy":                       true,
+	"rbac.authorization.k8s.io":    true,
+	"scheduling.k8s.io":            true,
+	"storage.k8s.io":               true,
+}
+
+// isBuiltinGroup tells whether an API group is built into Kubernetes, like `apps` or `networking.k8s.io`.
+func isBuiltinGroup(group string) bool {
+	return builtinGroups[group]
+}
+
+// podValidator creates the validator of the pods created from a pod template.
+func podValidator(client kubernetes.Interface, ns string, l *label.DefaultLabeller, templateLabels map[string]string) diag.Diagnose {
+	pd := diag.New([]string{ns}).
+		WithLabel(label.RunIDLabel, l.Labels()[label.RunIDLabel]).
+		WithValidators([]validator.Validator{validator.NewPodValidator(client)})
+
+	for k, v := range templateLabels {
+		pd = pd.WithLabel(k, v)
+	}
+	return pd
 }
 
-func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Deployment) {
+func pollResourceStatus(ctx context.Context, cfg pkgkubectl.Config, r *resource.Resource) {
 	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
 	// Add poll duration to account for one last attempt after progressDeadlineSeconds.
 	timeoutContext, cancel := context.WithTimeout(ctx, r.Deadline()+pollDuration)
@@ -219,9 +477,9 @@ func pollDeploymentStatus(ctx context.Context, cfg pkgkubectl.Config, r *resourc
 	}
 }
 
-func getSkaffoldDeployStatus(c *counter, rs []*resource.Deployment) (proto.StatusCode, error) {
+func getSkaffoldDeployStatus(c *counter, rs []*resource.Resource) (proto.StatusCode, error) {
 	if c.failed > 0 {
-		err := fmt.Errorf("%d/%d deployment(s) failed", c.failed, c.total)
+		err := fmt.Errorf("%d/%d resource(s) failed", c.failed, c.total)
 		for _, r := range rs {
 			if r.StatusCode() != proto.StatusCode_STATUSCHECK_SUCCESS {
 				return r.StatusCode(), err
@@ -238,11 +496,11 @@ func getDeadline(d int) time.Duration {
 	return defaultStatusCheckDeadline
 }
 
-func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deployment, c counter) {
+func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Resource, c counter) {
 	ae := r.Status().ActionableError()
 	if r.StatusCode() == proto.StatusCode_STATUSCHECK_USER_CANCELLED {
 		// Don't print the status summary if the user ctrl-C or
-		// another deployment failed
+		// another resource failed
 		return
 	}
 	event.ResourceStatusCheckEventCompleted(r.String(), ae)
@@ -262,15 +520,15 @@ func (s statusChecker) printStatusCheckSummary(out io.Writer, r *resource.Deploy
 	fmt.Fprintln(out, status)
 }
 
-// printDeploymentStatus prints resource statuses until all status check are completed or context is cancelled.
-func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer, deployments []*resource.Deployment) {
+// printResourceStatus prints resource statuses until all status check are completed or context is cancelled.
+func (s statusChecker) printResourceStatus(ctx context.Context, out io.Writer, resources []*resource.Resource) {
 	for {
 		var allDone bool
 		select {
 		case <-ctx.Done():
 			return
 		case <-time.After(reportStatusTime):
-			allDone = s.printStatus(deployments, out)
+			allDone = s.printStatus(resources, out)
 		}
 		if allDone {
 			return
@@ -278,9 +536,9 @@ func (s statusChecker) printDeploymentStatus(ctx context.Context, out io.Writer,
 	}
 }
 
-func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Writer) bool {
+func (s statusChecker) printStatus(resources []*resource.Resource, out io.Writer) bool {
 	allDone := true
-	for _, r := range deployments {
+	for _, r := range resources {
 		if r.IsStatusCheckCompleteOrCancelled() {
 			continue
 		}
@@ -295,7 +553,7 @@ func (s statusChecker) printStatus(deployments []*resource.Deployment, out io.Wr
 
 func getPendingMessage(pending int32, total int) string {
 	if pending > 0 {
-		return fmt.Sprintf(" [%d/%d deployment(s) still pending]", pending, total)
+		return fmt.Sprintf(" [%d/%d resource(s) still pending]", pending, total)
 	}
 	return ""
 }
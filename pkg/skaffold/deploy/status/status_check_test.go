@@ -26,8 +26,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	utilpointer "k8s.io/utils/pointer"
 
@@ -36,6 +40,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/resource"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -49,7 +54,7 @@ func TestGetDeployments(t *testing.T) {
 	tests := []struct {
 		description string
 		deps        []*appsv1.Deployment
-		expected    []*resource.Deployment
+		expected    []*resource.Resource
 		shouldErr   bool
 	}{
 		{
@@ -77,7 +82,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(20)},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 10*time.Second),
 				resource.NewDeployment("dep2", "test", 20*time.Second),
 			},
@@ -97,7 +102,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(300)},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 300*time.Second),
 			},
 		},
@@ -124,7 +129,7 @@ func TestGetDeployments(t *testing.T) {
 					},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 100*time.Second),
 				resource.NewDeployment("dep2", "test", 200*time.Second),
 			},
@@ -143,13 +148,13 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(600)},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 200*time.Second),
 			},
 		},
 		{
 			description: "no deployments",
-			expected:    []*resource.Deployment{},
+			expected:    []*resource.Resource{},
 		},
 		{
 			description: "multiple deployments in different namespaces",
@@ -175,7 +180,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{
+			expected: []*resource.Resource{
 				resource.NewDeployment("dep1", "test", 100*time.Second),
 			},
 		},
@@ -193,7 +198,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 		{
 			description: "deployment in correct namespace deployed by skaffold but different run",
@@ -209,7 +214,7 @@ func TestGetDeployments(t *testing.T) {
 					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
 				},
 			},
-			expected: []*resource.Deployment{},
+			expected: []*resource.Resource{},
 		},
 	}
 
@@ -222,17 +227,149 @@ func TestGetDeployments(t *testing.T) {
 			client := fakekubeclientset.NewSimpleClientset(objs...)
 			actual, err := getDeployments(context.Background(), client, "test", labeller, 200*time.Second)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, &test.expected, &actual,
-				cmp.AllowUnexported(resource.Deployment{}, resource.Status{}),
+				cmp.AllowUnexported(resource.Resource{}, resource.Status{}),
 				cmpopts.IgnoreInterfaces(struct{ diag.Diagnose }{}))
 		})
 	}
 }
 
+func TestGetResources(t *testing.T) {
+	labeller := label.NewLabeller(true, nil)
+	runIDLabels := map[string]string{label.RunIDLabel: labeller.GetRunID()}
+
+	tests := []struct {
+		description string
+		rules       []latest.ReadinessRule
+		expected    []string
+	}{
+		{
+			description: "built-in kinds and custom resources",
+			expected: []string{
+				"test:deployment/dep",
+				"test:statefulset/db",
+				"test:daemonset/agent",
+				"test:job/migrate",
+				"test:certificate.cert-manager.io/tls",
+				"test:certificate.cert-manager.io/default-ns",
+			},
+		},
+		{
+			description: "readiness rules for other built-in kinds",
+			rules: []latest.ReadinessRule{
+				{Kind: "PersistentVolumeClaim"},
+				{Group: "apps", Kind: "Deployment"},
+				{Group: "cert-manager.io", Kind: "Certificate"},
+			},
+			expected: []string{
+				"test:deployment/dep",
+				"test:statefulset/db",
+				"test:daemonset/agent",
+				"test:job/migrate",
+				"test:certificate.cert-manager.io/tls",
+				"test:certificate.cert-manager.io/default-ns",
+				"test:persistentvolumeclaim/data",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			client := fakekubeclientset.NewSimpleClientset(
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "dep", Namespace: "test", Labels: runIDLabels}},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test", Labels: runIDLabels}},
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "test", Labels: runIDLabels}},
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "test", Labels: runIDLabels}},
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test"}},
+			)
+			client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{{Name: "persistentvolumeclaims", Kind: "PersistentVolumeClaim", Namespaced: true, Verbs: []string{"list"}}},
+				},
+				{
+					GroupVersion: "apps/v1",
+					APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"list"}}},
+				},
+				{
+					GroupVersion: "cert-manager.io/v1",
+					APIResources: []metav1.APIResource{
+						{Name: "certificates", Kind: "Certificate", Namespaced: true, Verbs: []string{"get", "list"}},
+						{Name: "certificates/status", Kind: "Certificate", Namespaced: true, Verbs: []string{"get"}},
+						{Name: "clusterissuers", Kind: "ClusterIssuer", Verbs: []string{"get", "list"}},
+					},
+				},
+				{
+					GroupVersion: "cert-manager.io/v1alpha2",
+					APIResources: []metav1.APIResource{{Name: "certificates", Kind: "Certificate", Namespaced: true, Verbs: []string{"get", "list"}}},
+				},
+			}
+			// Only the resources of the deployed manifests are checked.
+			manifests := manifest.ManifestList{
+				[]byte("apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: dep\n  namespace: test"),
+				[]byte("apiVersion: cert-manager.io/v1\nkind: Certificate\nmetadata:\n  name: tls\n  namespace: test"),
+				[]byte("apiVersion: cert-manager.io/v1\nkind: Certificate\nmetadata:\n  name: default-ns"),
+				[]byte("apiVersion: cert-manager.io/v1\nkind: Certificate\nmetadata:\n  name: other-ns\n  namespace: other"),
+				[]byte("apiVersion: cert-manager.io/v1\nkind: Certificate\nmetadata:\n  name: not-created"),
+				[]byte("apiVersion: cert-manager.io/v1\nkind: ClusterIssuer\nmetadata:\n  name: issuer"),
+				[]byte("apiVersion: v1\nkind: PersistentVolumeClaim\nmetadata:\n  name: data"),
+				[]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config"),
+			}
+			certificate := func(name string, labels map[string]string) *unstructured.Unstructured {
+				u := &unstructured.Unstructured{}
+				u.SetAPIVersion("cert-manager.io/v1")
+				u.SetKind("Certificate")
+				u.SetName(name)
+				u.SetNamespace("test")
+				u.SetLabels(labels)
+				return u
+			}
+			pvc := &unstructured.Unstructured{}
+			pvc.SetAPIVersion("v1")
+			pvc.SetKind("PersistentVolumeClaim")
+			pvc.SetName("data")
+			pvc.SetNamespace("test")
+			pvc.SetLabels(runIDLabels)
+			dynClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), pvc, certificate("tls", runIDLabels), certificate("default-ns", runIDLabels), certificate("unrelated", nil))
+
+			rules := readinessRulesByType(test.rules)
+			actual, err := getResources(context.Background(), client, dynClient, "test", labeller, 200*time.Second, rules, customResourceRefs(manifests, rules))
+			t.CheckNoError(err)
+
+			var names []string
+			for _, r := range actual {
+				names = append(names, r.String())
+			}
+			t.CheckDeepEqual(test.expected, names)
+		})
+	}
+}
+
+func TestIsBuiltinGroup(t *testing.T) {
+	testutil.CheckDeepEqual(t, true, isBuiltinGroup(""))
+	testutil.CheckDeepEqual(t, true, isBuiltinGroup("apps"))
+	testutil.CheckDeepEqual(t, true, isBuiltinGroup("networking.k8s.io"))
+	testutil.CheckDeepEqual(t, false, isBuiltinGroup("cert-manager.io"))
+	testutil.CheckDeepEqual(t, false, isBuiltinGroup("cluster.x-k8s.io"))
+	testutil.CheckDeepEqual(t, false, isBuiltinGroup("monitoring.coreos.com"))
+}
+
+func TestReadinessRulesByType(t *testing.T) {
+	rules := []latest.ReadinessRule{
+		{Group: "cert-manager.io", Kind: "Certificate", Success: []latest.ResourceCondition{{JSONPath: "{.status.ready}"}}},
+		{Kind: "PersistentVolumeClaim"},
+		{Group: "Cert-Manager.io", Kind: "certificate"},
+	}
+
+	testutil.CheckDeepEqual(t, map[string]*latest.ReadinessRule{
+		"certificate.cert-manager.io": &rules[0],
+		"persistentvolumeclaim":       &rules[1],
+	}, readinessRulesByType(rules))
+}
+
 func TestGetDeployStatus(t *testing.T) {
 	tests := []struct {
 		description  string
 		counter      *counter
-		deployments  []*resource.Deployment
+		deployments  []*resource.Resource
 		expected     string
 		expectedCode proto.StatusCode
 		shouldErr    bool
@@ -240,18 +377,18 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "one error",
 			counter:     &counter{total: 2, failed: 1},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				resource.NewDeployment("foo", "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
-			expected:     "1/2 deployment(s) failed",
+			expected:     "1/2 resource(s) failed",
 			expectedCode: proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE,
 			shouldErr:    true,
 		},
 		{
 			description: "no error",
 			counter:     &counter{total: 2},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
@@ -265,8 +402,8 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "multiple errors",
 			counter:     &counter{total: 3, failed: 2},
-			expected:    "2/3 deployment(s) failed",
-			deployments: []*resource.Deployment{
+			expected:    "2/3 resource(s) failed",
+			deployments: []*resource.Resource{
 				resource.NewDeployment("foo", "test", time.Second).
 					WithPodStatuses([]proto.StatusCode{proto.StatusCode_STATUSCHECK_NODE_DISK_PRESSURE}),
 			},
@@ -280,7 +417,7 @@ func TestGetDeployStatus(t *testing.T) {
 		{
 			description: "unable to retrieve pods for deployment",
 			counter:     &counter{total: 1, failed: 1},
-			deployments: []*resource.Deployment{
+			deployments: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("deployment", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR},
@@ -344,7 +481,7 @@ func TestPrintSummaryStatus(t *testing.T) {
 			deployment:  "dep",
 			pending:     4,
 			ae:          proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
-			expected:    " - test:deployment/dep is ready. [4/10 deployment(s) still pending]\n",
+			expected:    " - test:deployment/dep is ready. [4/10 resource(s) still pending]\n",
 		},
 		{
 			description: "more than 1 deployment left and current is in error",
@@ -354,6 +491,14 @@ func TestPrintSummaryStatus(t *testing.T) {
 			ae:          proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED, Message: "context deadline expired"},
 			expected:    " - test:deployment/dep failed. Error: context deadline expired.\n",
 		},
+		{
+			description: "failure condition of a readiness rule",
+			namespace:   "test",
+			deployment:  "dep",
+			pending:     2,
+			ae:          proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_RESOURCE_FAILED, Message: "Progress deadline exceeded\n"},
+			expected:    " - test:deployment/dep failed. Error: Progress deadline exceeded.\n",
+		},
 		{
 			description: "skip printing if status check is cancelled",
 			namespace:   "test",
@@ -385,13 +530,13 @@ func TestPrintStatus(t *testing.T) {
 	labeller := label.NewLabeller(true, nil)
 	tests := []struct {
 		description string
-		rs          []*resource.Deployment
+		rs          []*resource.Resource
 		expectedOut string
 		expected    bool
 	}{
 		{
 			description: "single resource successful marked complete - skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
@@ -401,7 +546,7 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "single resource in error marked complete -skip print",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_UNKNOWN, Message: "error"},
@@ -411,7 +556,7 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
@@ -429,7 +574,7 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "multiple resources 1 not complete and retry-able error",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_SUCCESS},
@@ -446,7 +591,7 @@ func TestPrintStatus(t *testing.T) {
 		},
 		{
 			description: "skip printing if status check is cancelled",
-			rs: []*resource.Deployment{
+			rs: []*resource.Resource{
 				withStatus(
 					resource.NewDeployment("r1", "test", 1),
 					proto.ActionableErr{ErrCode: proto.StatusCode_STATUSCHECK_USER_CANCELLED},
@@ -469,7 +614,7 @@ func TestPrintStatus(t *testing.T) {
 	}
 }
 
-func withStatus(d *resource.Deployment, ae proto.ActionableErr) *resource.Deployment {
+func withStatus(d *resource.Resource, ae proto.ActionableErr) *resource.Resource {
 	d.UpdateStatus(ae)
 	return d
 }
@@ -538,13 +683,13 @@ func TestPollDeployment(t *testing.T) {
 	rolloutCmd := "kubectl --context kubecontext rollout status deployment dep --namespace test --watch=false"
 	tests := []struct {
 		description string
-		dep         *resource.Deployment
+		dep         *resource.Resource
 		runs        [][]validator.Resource
 		command     util.Command
 		expected    proto.StatusCode
 	}{
 		{
-			description: "pollDeploymentStatus errors out immediately when container error can't recover",
+			description: "pollResourceStatus errors out immediately when container error can't recover",
 			dep:         resource.NewDeployment("dep", "test", time.Second),
 			command:     testutil.CmdRunOut(rolloutCmd, "Waiting for replicas to be available"),
 			runs: [][]validator.Resource{
@@ -559,7 +704,7 @@ func TestPollDeployment(t *testing.T) {
 			expected: proto.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING,
 		},
 		{
-			description: "pollDeploymentStatus waits when a container can recover and eventually succeeds",
+			description: "pollResourceStatus waits when a container can recover and eventually succeeds",
 			dep:         resource.NewDeployment("dep", "test", time.Second),
 			command: testutil.CmdRunOutErr(
 				// pending due to recoverable error
@@ -595,7 +740,7 @@ func TestPollDeployment(t *testing.T) {
 			mockVal := mockValidator{runs: test.runs}
 			dep := test.dep.WithValidator(mockVal)
 
-			pollDeploymentStatus(context.Background(), &statusConfig{}, dep)
+			pollResourceStatus(context.Background(), &statusConfig{}, dep)
 
 			t.CheckDeepEqual(test.expected, test.dep.Status().ActionableError().ErrCode)
 		})
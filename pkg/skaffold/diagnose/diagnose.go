@@ -108,6 +108,8 @@ func typeOfArtifact(a *latest.Artifact) string {
 		return "Custom artifact"
 	case a.BuildpackArtifact != nil:
 		return "Buildpack artifact"
+	case a.KoArtifact != nil:
+		return "Ko artifact"
 	default:
 		panic("Unknown artifact")
 	}
@@ -115,7 +117,7 @@ func typeOfArtifact(a *latest.Artifact) string {
 
 func timeToListDependencies(ctx context.Context, a *latest.Artifact, cfg docker.Config) (string, []string, error) {
 	start := time.Now()
-	paths, err := build.DependenciesForArtifact(ctx, a, cfg, nil)
+	paths, err := build.DependenciesForArtifact(ctx, a, cfg, nil, nil)
 	return util.ShowHumanizeTime(time.Since(start)), paths, err
 }
 
@@ -26,12 +26,14 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/docker/cli/cli/connhelper"
 	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/tlsconfig"
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/cluster"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 )
@@ -55,6 +57,7 @@ type Config interface {
 	Prune() bool
 	GetKubeContext() string
 	MinikubeProfile() string
+	GetDockerDaemon() *latest.DockerDaemon
 	GetInsecureRegistries() map[string]bool
 	Mode() config.RunMode
 }
@@ -62,7 +65,7 @@ type Config interface {
 // NewAPIClientImpl guesses the docker client to use based on current Kubernetes context.
 func NewAPIClientImpl(cfg Config) (LocalDaemon, error) {
 	dockerAPIClientOnce.Do(func() {
-		env, apiClient, err := newAPIClient(cfg.GetKubeContext(), cfg.MinikubeProfile())
+		env, apiClient, err := newAPIClient(cfg.GetKubeContext(), cfg.MinikubeProfile(), cfg.GetDockerDaemon())
 		dockerAPIClient = NewLocalDaemon(apiClient, env, cfg.Prune(), cfg)
 		dockerAPIClientErr = err
 	})
@@ -75,7 +78,11 @@ func NewAPIClientImpl(cfg Config) (LocalDaemon, error) {
 // kubecontext API Server to minikube profiles
 
 // newAPIClient guesses the docker client to use based on current Kubernetes context.
-func newAPIClient(kubeContext string, minikubeProfile string) ([]string, client.CommonAPIClient, error) {
+// A remote daemon, when configured, takes precedence.
+func newAPIClient(kubeContext string, minikubeProfile string, daemon *latest.DockerDaemon) ([]string, client.CommonAPIClient, error) {
+	if daemon != nil {
+		return newRemoteAPIClient(daemon)
+	}
 	if minikubeProfile != "" { // skip validation if explicitly specifying minikubeProfile.
 		return newMinikubeAPIClient(minikubeProfile)
 	}
@@ -98,6 +105,42 @@ func newEnvAPIClient() ([]string, client.CommonAPIClient, error) {
 	return nil, cli, nil
 }
 
+// newRemoteAPIClient returns a docker client connected to a remote daemon, either through ssh or tcp.
+// The returned environment variables point the docker CLI, and the tools relying on it, to the same daemon.
+func newRemoteAPIClient(daemon *latest.DockerDaemon) ([]string, client.CommonAPIClient, error) {
+	helper, err := connhelper.GetConnectionHelper(daemon.Host)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connecting to docker daemon at %s: %w", daemon.Host, err)
+	}
+
+	env := []string{"DOCKER_HOST=" + daemon.Host}
+	opts := []client.Opt{client.WithHTTPHeaders(getUserAgentHeader())}
+	switch {
+	case helper != nil:
+		// ssh endpoints are reached through the ssh command, like the docker CLI does.
+		opts = append(opts,
+			client.WithHTTPClient(&http.Client{Transport: &http.Transport{DialContext: helper.Dialer}}),
+			client.WithHost(helper.Host),
+			client.WithDialContext(helper.Dialer))
+	case daemon.CertPath != "":
+		opts = append(opts,
+			client.WithHost(daemon.Host),
+			client.WithTLSClientConfig(filepath.Join(daemon.CertPath, "ca.pem"), filepath.Join(daemon.CertPath, "cert.pem"), filepath.Join(daemon.CertPath, "key.pem")))
+		env = append(env, "DOCKER_CERT_PATH="+daemon.CertPath, "DOCKER_TLS_VERIFY=1")
+	default:
+		opts = append(opts, client.WithHost(daemon.Host))
+	}
+
+	api, err := client.NewClientWithOpts(opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting docker client for %s: %w", daemon.Host, err)
+	}
+	api.NegotiateAPIVersion(context.Background())
+
+	logrus.Infof("Using remote docker daemon at %s", daemon.Host)
+	return env, api, nil
+}
+
 type ExitCoder interface {
 	ExitCode() int
 }
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/cluster"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -59,6 +60,48 @@ func TestNewEnvClient(t *testing.T) {
 	}
 }
 
+func TestNewRemoteAPIClient(t *testing.T) {
+	tests := []struct {
+		description string
+		daemon      latest.DockerDaemon
+		expectedEnv []string
+		shouldErr   bool
+	}{
+		{
+			description: "tcp",
+			daemon:      latest.DockerDaemon{Host: "tcp://127.0.0.1:8080"},
+			expectedEnv: []string{"DOCKER_HOST=tcp://127.0.0.1:8080"},
+		},
+		{
+			description: "tcp with tls",
+			daemon:      latest.DockerDaemon{Host: "tcp://127.0.0.1:8080", CertPath: "testdata"},
+			expectedEnv: []string{"DOCKER_HOST=tcp://127.0.0.1:8080", "DOCKER_CERT_PATH=testdata", "DOCKER_TLS_VERIFY=1"},
+		},
+		{
+			description: "invalid cert path",
+			daemon:      latest.DockerDaemon{Host: "tcp://127.0.0.1:8080", CertPath: "invalid/cert/path"},
+			shouldErr:   true,
+		},
+		{
+			description: "ssh without host",
+			daemon:      latest.DockerDaemon{Host: "ssh://"},
+			shouldErr:   true,
+		},
+		{
+			description: "bad url",
+			daemon:      latest.DockerDaemon{Host: "badurl"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			env, _, err := newRemoteAPIClient(&test.daemon)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedEnv, env)
+		})
+	}
+}
+
 func TestNewMinikubeImageAPIClient(t *testing.T) {
 	tests := []struct {
 		description string
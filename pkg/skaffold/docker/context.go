@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/walk"
 )
 
 func CreateDockerTarContext(ctx context.Context, w io.Writer, buildCfg BuildConfig, cfg Config) error {
@@ -31,6 +32,10 @@ func CreateDockerTarContext(ctx context.Context, w io.Writer, buildCfg BuildConf
 		return fmt.Errorf("getting relative tar paths: %w", err)
 	}
 
+	if len(buildCfg.outputDirs) > 0 {
+		return createTarContextWithOutputs(w, buildCfg, paths)
+	}
+
 	var p []string
 	for _, path := range paths {
 		p = append(p, filepath.Join(buildCfg.workspace, path))
@@ -42,3 +47,31 @@ func CreateDockerTarContext(ctx context.Context, w io.Writer, buildCfg BuildConf
 
 	return nil
 }
+
+// createTarContextWithOutputs creates a build context with the given workspace files,
+// and the files of the file outputs under their paths in the context.
+func createTarContextWithOutputs(w io.Writer, buildCfg BuildConfig, paths []string) error {
+	pathMap := make(map[string][]string)
+	for _, path := range paths {
+		pathMap[filepath.Join(buildCfg.workspace, path)] = []string{path}
+	}
+
+	for contextPath, dir := range buildCfg.outputDirs {
+		files, err := walk.From(dir).WhenIsFile().CollectPaths()
+		if err != nil {
+			return fmt.Errorf("listing files of output in %q: %w", dir, err)
+		}
+		for _, f := range files {
+			rel, err := filepath.Rel(dir, f)
+			if err != nil {
+				return err
+			}
+			pathMap[f] = append(pathMap[f], filepath.Join(contextPath, rel))
+		}
+	}
+
+	if err := util.CreateMappedTar(w, buildCfg.workspace, pathMap); err != nil {
+		return fmt.Errorf("creating tar gz: %w", err)
+	}
+	return nil
+}
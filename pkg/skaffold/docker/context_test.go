@@ -20,6 +20,7 @@ import (
 	"archive/tar"
 	"context"
 	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -74,3 +75,44 @@ func TestDockerContext(t *testing.T) {
 		})
 	}
 }
+
+func TestDockerContextWithOutputs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		imageFetcher := fakeImageFetcher{}
+		t.Override(&RetrieveImage, imageFetcher.fetch)
+		outputDir := t.NewTempDir().
+			Write("bundle.js", "bundle").
+			Write("css/style.css", "style")
+		t.NewTempDir().
+			Write("Dockerfile", "FROM busybox\nCOPY ./files /files\nCOPY dist/ /app/").
+			Touch("files/included.txt").
+			Chdir()
+
+		reader, writer := io.Pipe()
+		go func() {
+			buildCfg := NewBuildConfig(".", "test-outputs", "Dockerfile", nil).WithOutputDirs(map[string]string{"dist": outputDir.Root()})
+			writer.CloseWithError(CreateDockerTarContext(context.Background(), writer, buildCfg, nil))
+		}()
+
+		files := make(map[string]string)
+		tr := tar.NewReader(reader)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			t.CheckNoError(err)
+
+			content, err := ioutil.ReadAll(tr)
+			t.CheckNoError(err)
+			files[header.Name] = string(content)
+		}
+
+		t.CheckDeepEqual(map[string]string{
+			"Dockerfile":         "FROM busybox\nCOPY ./files /files\nCOPY dist/ /app/",
+			"files/included.txt": "",
+			"dist/bundle.js":     "bundle",
+			"dist/css/style.css": "style",
+		}, files)
+	})
+}
@@ -39,6 +39,10 @@ type BuildConfig struct {
 	artifact       string
 	dockerfilePath string
 	args           map[string]*string
+	// outputPaths are the paths in the build context that hold the files of file outputs.
+	outputPaths []string
+	// outputDirs maps these paths to the directories holding the files, once they've been produced.
+	outputDirs map[string]string
 }
 
 // NewBuildConfig returns a `BuildConfig` for a dockerfilePath build.
@@ -51,6 +55,25 @@ func NewBuildConfig(ws string, a string, path string, args map[string]*string) B
 	}
 }
 
+// WithOutputPaths returns a copy of the `BuildConfig` where the given paths of the build context hold the files of file outputs.
+// These files aren't part of the workspace, so they're not listed as dependencies.
+func (c BuildConfig) WithOutputPaths(paths []string) BuildConfig {
+	c.outputPaths = paths
+	return c
+}
+
+// WithOutputDirs returns a copy of the `BuildConfig` that adds the files of file outputs to the build context.
+// The given map has the paths in the build context as keys, and the directories holding the files as values.
+func (c BuildConfig) WithOutputDirs(dirs map[string]string) BuildConfig {
+	c.outputPaths = nil
+	for p := range dirs {
+		c.outputPaths = append(c.outputPaths, p)
+	}
+	sort.Strings(c.outputPaths)
+	c.outputDirs = dirs
+	return c
+}
+
 // NormalizeDockerfilePath returns the absolute path to the dockerfilePath.
 func NormalizeDockerfilePath(context, dockerfile string) (string, error) {
 	// Expected case: should be found relative to the context directory.
@@ -73,7 +96,7 @@ func GetDependencies(ctx context.Context, buildCfg BuildConfig, cfg Config) ([]s
 	if err != nil {
 		return nil, fmt.Errorf("normalizing dockerfilePath path: %w", err)
 	}
-	result := getDependencies(buildCfg.workspace, buildCfg.dockerfilePath, absDockerfilePath, buildCfg.args, cfg)
+	result := getDependencies(buildCfg.workspace, buildCfg.dockerfilePath, absDockerfilePath, buildCfg.args, buildCfg.outputPaths, cfg)
 	dependencyCache.Store(buildCfg.artifact, result)
 	return resultPair(result)
 }
@@ -87,7 +110,7 @@ func GetDependenciesCached(ctx context.Context, buildCfg BuildConfig, cfg Config
 	}
 
 	deps := dependencyCache.Exec(buildCfg.artifact, func() interface{} {
-		return getDependencies(buildCfg.workspace, buildCfg.dockerfilePath, absDockerfilePath, buildCfg.args, cfg)
+		return getDependencies(buildCfg.workspace, buildCfg.dockerfilePath, absDockerfilePath, buildCfg.args, buildCfg.outputPaths, cfg)
 	})
 	return resultPair(deps)
 }
@@ -103,7 +126,7 @@ func resultPair(deps interface{}) ([]string, error) {
 	}
 }
 
-func getDependencies(workspace string, dockerfilePath string, absDockerfilePath string, buildArgs map[string]*string, cfg Config) interface{} {
+func getDependencies(workspace string, dockerfilePath string, absDockerfilePath string, buildArgs map[string]*string, outputPaths []string, cfg Config) interface{} {
 	// If the Dockerfile doesn't exist, we can't compute the dependency.
 	// But since we know the Dockerfile is a dependency, let's return a list
 	// with only that file. It makes errors down the line more actionable
@@ -112,7 +135,7 @@ func getDependencies(workspace string, dockerfilePath string, absDockerfilePath
 		return []string{dockerfilePath}
 	}
 
-	fts, err := readCopyCmdsFromDockerfile(false, absDockerfilePath, workspace, buildArgs, cfg)
+	fts, err := readCopyCmdsFromDockerfile(false, absDockerfilePath, workspace, buildArgs, outputPaths, cfg)
 	if err != nil {
 		return err
 	}
@@ -104,6 +104,39 @@ COPY server.go .
 RUN --mount=type=cache,target=/go/pkg/mod go build .
 `
 
+const runMountDockerfile = `
+# syntax = docker/dockerfile:1.4
+FROM golang:1.9.2 AS builder
+COPY --link server.go .
+RUN --mount=type=bind,source=worker.go,target=/go/worker.go --mount=type=cache,target=/root/.cache go build .
+RUN --mount=type=bind,from=builder,source=/go,target=/out ls /out
+`
+
+const runMountContextDockerfile = `
+FROM golang:1.9.2
+RUN --mount=target=/src go build /src
+`
+
+const copyFromExternalImage = `
+FROM golang:1.9.2
+COPY --from=gcr.io/distroless/base /etc/passwd /etc/passwd
+COPY server.go .
+`
+
+const heredocDockerfile = `
+# syntax = docker/dockerfile:1.4
+FROM busybox
+COPY <<EOF /etc/motd
+COPY file /file
+EOF
+COPY <<-CONF server.go /app/
+	ADD worker.go /app/
+	CONF
+RUN <<EOF
+COPY bar /bar
+EOF
+`
+
 const envTest = `
 FROM busybox
 ENV foo bar
@@ -278,6 +311,7 @@ func TestGetDependencies(t *testing.T) {
 		ignoreFilename string
 		buildArgs      map[string]*string
 		env            []string
+		outputPaths    []string
 
 		expected  []string
 		shouldErr bool
@@ -288,6 +322,30 @@ func TestGetDependencies(t *testing.T) {
 			workspace:   "",
 			expected:    []string{"Dockerfile", "server.go"},
 		},
+		{
+			description: "buildkit bind mounts",
+			dockerfile:  runMountDockerfile,
+			workspace:   "",
+			expected:    []string{"Dockerfile", "server.go", "worker.go"},
+		},
+		{
+			description: "buildkit bind mount of the whole context",
+			dockerfile:  runMountContextDockerfile,
+			workspace:   ".",
+			expected:    []string{".dot", "Dockerfile", "bar", filepath.Join("docker", "bar"), filepath.Join("docker", "nginx.conf"), "file", "server.go", "test.conf", "worker.go"},
+		},
+		{
+			description: "copy from external image",
+			dockerfile:  copyFromExternalImage,
+			workspace:   ".",
+			expected:    []string{"Dockerfile", "server.go"},
+		},
+		{
+			description: "heredocs",
+			dockerfile:  heredocDockerfile,
+			workspace:   ".",
+			expected:    []string{"Dockerfile", "server.go"},
+		},
 		{
 			description: "copy dependency",
 			dockerfile:  copyServerGo,
@@ -594,6 +652,20 @@ func TestGetDependencies(t *testing.T) {
 			workspace:   ".",
 			expected:    []string{"Dockerfile", "file"},
 		},
+		{
+			description: "files copied from file outputs",
+			dockerfile:  "FROM busybox\nCOPY server.go dist/bundle.js /app/\nCOPY dist/assets/* /assets/",
+			workspace:   ".",
+			outputPaths: []string{"dist"},
+			expected:    []string{"Dockerfile", "server.go"},
+		},
+		{
+			description: "missing files outside of file outputs",
+			dockerfile:  "FROM busybox\nCOPY dist/bundle.js /app/",
+			workspace:   ".",
+			outputPaths: []string{"build"},
+			shouldErr:   true,
+		},
 	}
 
 	for _, test := range tests {
@@ -617,7 +689,7 @@ func TestGetDependencies(t *testing.T) {
 			}
 
 			workspace := tmpDir.Path(test.workspace)
-			deps, err := GetDependencies(context.Background(), NewBuildConfig(workspace, "test", "Dockerfile", test.buildArgs), nil)
+			deps, err := GetDependencies(context.Background(), NewBuildConfig(workspace, "test", "Dockerfile", test.buildArgs).WithOutputPaths(test.outputPaths), nil)
 
 			t.CheckError(test.shouldErr, err)
 			t.CheckDeepEqual(test.expected, deps)
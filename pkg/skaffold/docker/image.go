@@ -17,10 +17,13 @@ limitations under the License.
 package docker
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"sort"
 	"strings"
@@ -47,6 +50,9 @@ import (
 const (
 	retries   = 5
 	sleepTime = 1 * time.Second
+
+	// ArtifactLabel is set on the images built by Skaffold to the image name of their artifact.
+	ArtifactLabel = "skaffold.dev/artifact"
 )
 
 type ContainerRun struct {
@@ -65,6 +71,7 @@ type LocalDaemon interface {
 	ServerVersion(ctx context.Context) (types.Version, error)
 	ConfigFile(ctx context.Context, image string) (*v1.ConfigFile, error)
 	Build(ctx context.Context, out io.Writer, workspace string, artifact string, a *latest.DockerArtifact, opts BuildOptions) (string, error)
+	Label(ctx context.Context, ref string, labels map[string]string) (string, error)
 	Push(ctx context.Context, out io.Writer, ref string) (string, error)
 	Pull(ctx context.Context, out io.Writer, ref string) error
 	Load(ctx context.Context, out io.Writer, input io.Reader, ref string) (string, error)
@@ -75,6 +82,7 @@ type LocalDaemon interface {
 	ImageRemove(ctx context.Context, image string, opts types.ImageRemoveOptions) ([]types.ImageDeleteResponseItem, error)
 	ImageExists(ctx context.Context, ref string) bool
 	ImageList(ctx context.Context, ref string) ([]types.ImageSummary, error)
+	LabeledImages(ctx context.Context, label string) ([]types.ImageSummary, error)
 	Prune(ctx context.Context, images []string, pruneChildren bool) ([]string, error)
 	DiskUsage(ctx context.Context) (uint64, error)
 	RawClient() client.CommonAPIClient
@@ -85,6 +93,9 @@ type BuildOptions struct {
 	Tag            string
 	Mode           config.RunMode
 	ExtraBuildArgs map[string]*string
+	Platform       string
+	// OutputDirs maps paths in the build context to the directories holding the files of file outputs.
+	OutputDirs map[string]string
 }
 
 type localDaemon struct {
@@ -192,7 +203,7 @@ func (l *localDaemon) Build(ctx context.Context, out io.Writer, workspace string
 	buildCtx, buildCtxWriter := io.Pipe()
 	go func() {
 		err := CreateDockerTarContext(ctx, buildCtxWriter,
-			NewBuildConfig(workspace, artifact, a.DockerfilePath, buildArgs), l.cfg)
+			NewBuildConfig(workspace, artifact, a.DockerfilePath, buildArgs).WithOutputDirs(opts.OutputDirs), l.cfg)
 		if err != nil {
 			buildCtxWriter.CloseWithError(fmt.Errorf("creating docker context: %w", err))
 			return
@@ -213,6 +224,8 @@ func (l *localDaemon) Build(ctx context.Context, out io.Writer, workspace string
 		ForceRemove: l.forceRemove,
 		NetworkMode: strings.ToLower(a.NetworkMode),
 		NoCache:     a.NoCache,
+		Platform:    opts.Platform,
+		Labels:      map[string]string{ArtifactLabel: artifact},
 	})
 	if err != nil {
 		return "", fmt.Errorf("docker build: %w", err)
@@ -249,6 +262,39 @@ func (l *localDaemon) Build(ctx context.Context, out io.Writer, workspace string
 	return imageID, nil
 }
 
+// Label adds labels to the image with the given reference, and points the reference to the labeled image.
+// Returns the ID of the labeled image.
+func (l *localDaemon) Label(ctx context.Context, ref string, labels map[string]string) (string, error) {
+	// The labels are set by a `FROM <ref>` build, which only changes the image config.
+	dockerfile := []byte(fmt.Sprintf("FROM %s\n", ref))
+	var buildCtx bytes.Buffer
+	tw := tar.NewWriter(&buildCtx)
+	if err := tw.WriteHeader(&tar.Header{Name: "Dockerfile", Mode: 0644, Size: int64(len(dockerfile))}); err != nil {
+		return "", err
+	}
+	if _, err := tw.Write(dockerfile); err != nil {
+		return "", err
+	}
+	if err := tw.Close(); err != nil {
+		return "", err
+	}
+
+	resp, err := l.apiClient.ImageBuild(ctx, &buildCtx, types.ImageBuildOptions{
+		Tags:        []string{ref},
+		Labels:      labels,
+		ForceRemove: l.forceRemove,
+	})
+	if err != nil {
+		return "", fmt.Errorf("labeling image %q: %w", ref, err)
+	}
+	defer resp.Body.Close()
+
+	if err := streamDockerMessages(ioutil.Discard, resp.Body, nil); err != nil {
+		return "", fmt.Errorf("labeling image %q: %w", ref, err)
+	}
+	return l.ImageID(ctx, ref)
+}
+
 // streamDockerMessages streams formatted json output from the docker daemon
 func streamDockerMessages(dst io.Writer, src io.Reader, auxCallback func(jsonmessage.JSONMessage)) error {
 	termFd, isTerm := util.IsTerminal(dst)
@@ -400,12 +446,11 @@ func (l *localDaemon) Tag(ctx context.Context, image, ref string) error {
 // So, the solution we chose is to create a tag, just for Skaffold, from
 // the imageID, and use that in the manifests.
 func (l *localDaemon) TagWithImageID(ctx context.Context, ref string, imageID string) (string, error) {
-	parsed, err := ParseReference(ref)
+	uniqueTag, err := imageIDTag(ref, imageID)
 	if err != nil {
 		return "", err
 	}
 
-	uniqueTag := parsed.BaseName + ":" + strings.TrimPrefix(imageID, "sha256:")
 	if err := l.Tag(ctx, imageID, uniqueTag); err != nil {
 		return "", err
 	}
@@ -413,6 +458,16 @@ func (l *localDaemon) TagWithImageID(ctx context.Context, ref string, imageID st
 	return uniqueTag, nil
 }
 
+// imageIDTag returns the tag, unique to the given imageID, used by TagWithImageID.
+func imageIDTag(ref string, imageID string) (string, error) {
+	parsed, err := ParseReference(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return parsed.BaseName + ":" + strings.TrimPrefix(imageID, "sha256:"), nil
+}
+
 // ImageID returns the image ID for a corresponding reference.
 func (l *localDaemon) ImageID(ctx context.Context, ref string) (string, error) {
 	image, _, err := l.apiClient.ImageInspectWithRaw(ctx, ref)
@@ -454,6 +509,14 @@ func (l *localDaemon) ImageList(ctx context.Context, ref string) ([]types.ImageS
 		Filters: filters.NewArgs(filters.Arg("reference", ref)),
 	})
 }
+
+// LabeledImages lists the images that carry the given label.
+func (l *localDaemon) LabeledImages(ctx context.Context, label string) ([]types.ImageSummary, error) {
+	return l.apiClient.ImageList(ctx, types.ImageListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", label)),
+	})
+}
+
 func (l *localDaemon) DiskUsage(ctx context.Context) (uint64, error) {
 	usage, err := l.apiClient.DiskUsage(ctx)
 	if err != nil {
@@ -118,6 +118,7 @@ func TestBuild(t *testing.T) {
 			expected: types.ImageBuildOptions{
 				Tags:        []string{"finalimage"},
 				AuthConfigs: allAuthConfig,
+				Labels:      map[string]string{ArtifactLabel: "final-image"},
 			},
 			mode: config.RunModes.Dev,
 		},
@@ -154,6 +155,7 @@ func TestBuild(t *testing.T) {
 				Target:      "target",
 				NetworkMode: "none",
 				NoCache:     true,
+				Labels:      map[string]string{ArtifactLabel: "final-image"},
 			},
 		},
 		{
@@ -212,6 +214,22 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestLabel(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		api := (&testutil.FakeAPIClient{}).Add("img:tag", "sha256:123abc")
+
+		localDocker := NewLocalDaemon(api, nil, false, nil)
+		imageID, err := localDocker.Label(context.Background(), "img:tag", map[string]string{ArtifactLabel: "img"})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("sha256:1", imageID)
+		t.CheckDeepEqual([]types.ImageBuildOptions{{
+			Tags:   []string{"img:tag"},
+			Labels: map[string]string{ArtifactLabel: "img"},
+		}}, api.Built)
+	})
+}
+
 func TestImageID(t *testing.T) {
 	tests := []struct {
 		description string
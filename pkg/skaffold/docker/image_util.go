@@ -19,6 +19,8 @@ package docker
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	v1 "github.com/google/go-containerregistry/pkg/v1"
@@ -74,3 +76,27 @@ func RetrieveLabels(tagged string, cfg Config) (map[string]string, error) {
 		return cf.Config.Labels, nil
 	}
 }
+
+// SaveDaemonImageArchive saves an image of the Docker daemon to an image tarball.
+func SaveDaemonImageArchive(ctx context.Context, cfg Config, ref string, tarPath string) error {
+	localDocker, err := NewAPIClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	r, err := localDocker.RawClient().ImageSave(ctx, []string{ref})
+	if err != nil {
+		return fmt.Errorf("saving image %q: %w", ref, err)
+	}
+	defer r.Close()
+
+	f, err := os.Create(tarPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("writing image %q to %s: %w", ref, tarPath, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,648 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/match"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	homedir "github.com/mitchellh/go-homedir"
+	imagespec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// For testing
+var (
+	NewLayoutDaemon = NewLayoutDaemonImpl
+)
+
+var (
+	layoutDaemonOnce sync.Once
+	layoutDaemonInst LocalDaemon
+	layoutDaemonErr  error
+)
+
+// layoutDaemon is a LocalDaemon that stores images in an OCI image layout on disk
+// instead of a Docker daemon. Images are referenced by their tags through
+// the `org.opencontainers.image.ref.name` annotation.
+type layoutDaemon struct {
+	cfg  Config
+	path layout.Path
+	lock sync.Mutex
+}
+
+// NewLayoutDaemonImpl returns the LocalDaemon backed by the OCI image layout under `~/.skaffold/images`.
+func NewLayoutDaemonImpl(cfg Config) (LocalDaemon, error) {
+	layoutDaemonOnce.Do(func() {
+		dir, err := defaultLayoutPath()
+		if err != nil {
+			layoutDaemonErr = err
+			return
+		}
+		layoutDaemonInst, layoutDaemonErr = newLayoutDaemon(dir, cfg)
+	})
+
+	return layoutDaemonInst, layoutDaemonErr
+}
+
+func defaultLayoutPath() (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("retrieving home directory: %w", err)
+	}
+	return filepath.Join(home, constants.DefaultSkaffoldDir, "images"), nil
+}
+
+func newLayoutDaemon(dir string, cfg Config) (*layoutDaemon, error) {
+	path, err := layout.FromPath(dir)
+	if err != nil {
+		if path, err = layout.Write(dir, empty.Index); err != nil {
+			return nil, fmt.Errorf("creating image layout %q: %w", dir, err)
+		}
+	}
+
+	return &layoutDaemon{
+		cfg:  cfg,
+		path: path,
+	}, nil
+}
+
+// SaveImageArchive writes an image stored in the OCI image layout to a `docker save` compatible tarball.
+func SaveImageArchive(cfg Config, ref string, tarPath string) error {
+	localDocker, err := NewLayoutDaemon(cfg)
+	if err != nil {
+		return err
+	}
+	l, ok := localDocker.(*layoutDaemon)
+	if !ok {
+		return fmt.Errorf("unexpected image store %T", localDocker)
+	}
+
+	tag, err := name.NewTag(ref, name.WeakValidation)
+	if err != nil {
+		return fmt.Errorf("parsing tag %q: %w", ref, err)
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	img, _, err := l.find(ref)
+	if err != nil {
+		return err
+	}
+	if img == nil {
+		return fmt.Errorf("image %q not found in %s", ref, l.path)
+	}
+	return tarball.WriteToFile(tarPath, tag, img)
+}
+
+func (l *layoutDaemon) Close() error {
+	return nil
+}
+
+func (l *layoutDaemon) ExtraEnv() []string {
+	return nil
+}
+
+func (l *layoutDaemon) RawClient() client.CommonAPIClient {
+	return nil
+}
+
+// ServerVersion always succeeds since there's no daemon to talk to.
+func (l *layoutDaemon) ServerVersion(context.Context) (types.Version, error) {
+	return types.Version{}, nil
+}
+
+// ConfigFile retrieves the configuration of a stored image or of a remote image.
+func (l *layoutDaemon) ConfigFile(_ context.Context, image string) (*v1.ConfigFile, error) {
+	l.lock.Lock()
+	img, _, err := l.find(image)
+	l.lock.Unlock()
+
+	if err != nil || img == nil {
+		return RetrieveRemoteConfig(image, l.cfg)
+	}
+	return img.ConfigFile()
+}
+
+func (l *layoutDaemon) Build(context.Context, io.Writer, string, string, *latest.DockerArtifact, BuildOptions) (string, error) {
+	return "", errors.New("building a Dockerfile requires a Docker daemon: remove `daemonless: true` from the local build configuration")
+}
+
+// Label adds labels to the stored image with the given reference, and points the reference to the labeled image.
+// Returns the ID of the labeled image.
+func (l *layoutDaemon) Label(_ context.Context, ref string, labels map[string]string) (string, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	img, _, err := l.find(ref)
+	if err != nil {
+		return "", err
+	}
+	if img == nil {
+		return "", fmt.Errorf("image %q not found in %s", ref, l.path)
+	}
+
+	cfg, err := img.ConfigFile()
+	if err != nil {
+		return "", err
+	}
+	cfg = cfg.DeepCopy()
+	if cfg.Config.Labels == nil {
+		cfg.Config.Labels = map[string]string{}
+	}
+	for k, v := range labels {
+		cfg.Config.Labels[k] = v
+	}
+
+	if img, err = mutate.ConfigFile(img, cfg); err != nil {
+		return "", err
+	}
+	if err := l.store(img, ref); err != nil {
+		return "", fmt.Errorf("labeling image %q: %w", ref, err)
+	}
+	return imageID(img)
+}
+
+// Push pushes a stored image to a registry. Returns the image digest.
+func (l *layoutDaemon) Push(_ context.Context, out io.Writer, ref string) (string, error) {
+	l.lock.Lock()
+	img, _, err := l.find(ref)
+	l.lock.Unlock()
+
+	if err != nil {
+		return "", err
+	}
+	if img == nil {
+		return "", fmt.Errorf("image %q not found in %s", ref, l.path)
+	}
+
+	fmt.Fprintf(out, "Pushing %s\n", ref)
+	return PushImage(img, ref, l.cfg)
+}
+
+// Pull pulls an image from a registry into the image layout.
+func (l *layoutDaemon) Pull(_ context.Context, _ io.Writer, ref string) error {
+	img, err := getRemoteImage(ref, l.cfg)
+	if err != nil {
+		return fmt.Errorf("pulling image from repository: %w", err)
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.store(img, ref)
+}
+
+// Load loads an image from a tar file. Returns the imageID for the loaded image.
+func (l *layoutDaemon) Load(_ context.Context, _ io.Writer, input io.Reader, ref string) (string, error) {
+	tmp, err := ioutil.TempFile("", "skaffold-image-*.tar")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, input)
+	tmp.Close()
+	if err != nil {
+		return "", fmt.Errorf("reading image tarball: %w", err)
+	}
+
+	var tag *name.Tag
+	if t, err := name.NewTag(ref, name.WeakValidation); err == nil {
+		tag = &t
+	}
+	img, err := tarball.ImageFromPath(tmp.Name(), tag)
+	if err != nil {
+		return "", fmt.Errorf("reading image tarball: %w", err)
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.store(img, ref); err != nil {
+		return "", fmt.Errorf("loading image into %s: %w", l.path, err)
+	}
+	return imageID(img)
+}
+
+// Tag adds a tag to an image.
+func (l *layoutDaemon) Tag(_ context.Context, image, ref string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	img, _, err := l.find(image)
+	if err != nil {
+		return err
+	}
+	if img == nil {
+		return fmt.Errorf("image %q not found in %s", image, l.path)
+	}
+	return l.store(img, ref)
+}
+
+func (l *layoutDaemon) TagWithImageID(ctx context.Context, ref string, imageID string) (string, error) {
+	uniqueTag, err := imageIDTag(ref, imageID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := l.Tag(ctx, imageID, uniqueTag); err != nil {
+		return "", err
+	}
+	return uniqueTag, nil
+}
+
+// ImageID returns the image ID for a corresponding reference, or an empty string if the image isn't stored.
+func (l *layoutDaemon) ImageID(_ context.Context, ref string) (string, error) {
+	l.lock.Lock()
+	img, _, err := l.find(ref)
+	l.lock.Unlock()
+
+	if err != nil {
+		return "", localDigestGetErr(ref, err)
+	}
+	if img == nil {
+		return "", nil
+	}
+	return imageID(img)
+}
+
+func (l *layoutDaemon) ImageExists(ctx context.Context, ref string) bool {
+	id, err := l.ImageID(ctx, ref)
+	return err == nil && id != ""
+}
+
+func (l *layoutDaemon) ImageInspectWithRaw(_ context.Context, image string) (types.ImageInspect, []byte, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	img, refs, err := l.find(image)
+	if err != nil {
+		return types.ImageInspect{}, nil, err
+	}
+	if img == nil {
+		return types.ImageInspect{}, nil, fmt.Errorf("no such image: %s", image)
+	}
+
+	id, err := imageID(img)
+	if err != nil {
+		return types.ImageInspect{}, nil, err
+	}
+	raw, err := img.RawConfigFile()
+	if err != nil {
+		return types.ImageInspect{}, nil, err
+	}
+	size, err := imageSize(img)
+	if err != nil {
+		return types.ImageInspect{}, nil, err
+	}
+
+	return types.ImageInspect{
+		ID:       id,
+		RepoTags: refs,
+		Size:     size,
+	}, raw, nil
+}
+
+// ImageRemove removes all the tags of an image.
+func (l *layoutDaemon) ImageRemove(_ context.Context, image string, _ types.ImageRemoveOptions) ([]types.ImageDeleteResponseItem, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	img, refs, err := l.find(image)
+	if err != nil {
+		return nil, err
+	}
+	if img == nil {
+		return nil, fmt.Errorf("no such image: %s", image)
+	}
+	id, err := imageID(img)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []types.ImageDeleteResponseItem
+	for _, ref := range refs {
+		if err := l.path.RemoveDescriptors(match.Name(ref)); err != nil {
+			return nil, fmt.Errorf("removing %q: %w", ref, err)
+		}
+		resp = append(resp, types.ImageDeleteResponseItem{Untagged: ref})
+	}
+	return append(resp, types.ImageDeleteResponseItem{Deleted: id}), nil
+}
+
+// ImageList lists the stored images whose repository matches the given reference.
+func (l *layoutDaemon) ImageList(_ context.Context, ref string) ([]types.ImageSummary, error) {
+	return l.listImages(func(tag string, _ *v1.ConfigFile) bool {
+		return sameRepository(tag, ref)
+	})
+}
+
+// LabeledImages lists the stored images that carry the given label.
+func (l *layoutDaemon) LabeledImages(_ context.Context, label string) ([]types.ImageSummary, error) {
+	return l.listImages(func(_ string, cfg *v1.ConfigFile) bool {
+		_, found := cfg.Config.Labels[label]
+		return found
+	})
+}
+
+// listImages lists the stored images for which match returns true.
+func (l *layoutDaemon) listImages(match func(tag string, cfg *v1.ConfigFile) bool) ([]types.ImageSummary, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	descriptors, err := l.descriptors()
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := map[string]*types.ImageSummary{}
+	var ids []string
+	for _, desc := range descriptors {
+		tag := desc.Annotations[imagespec.AnnotationRefName]
+		img, err := l.path.Image(desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		cfg, err := img.ConfigFile()
+		if err != nil {
+			return nil, err
+		}
+		if !match(tag, cfg) {
+			continue
+		}
+
+		id, err := imageID(img)
+		if err != nil {
+			return nil, err
+		}
+		if summary, found := summaries[id]; found {
+			summary.RepoTags = append(summary.RepoTags, tag)
+			continue
+		}
+
+		size, err := imageSize(img)
+		if err != nil {
+			return nil, err
+		}
+		summaries[id] = &types.ImageSummary{
+			ID:       id,
+			RepoTags: []string{tag},
+			Created:  cfg.Created.Unix(),
+			Size:     size,
+			Labels:   cfg.Config.Labels,
+		}
+		ids = append(ids, id)
+	}
+
+	var list []types.ImageSummary
+	for _, id := range ids {
+		list = append(list, *summaries[id])
+	}
+	return list, nil
+}
+
+// Prune removes the given images and deletes the blobs that are no longer referenced.
+func (l *layoutDaemon) Prune(ctx context.Context, images []string, _ bool) ([]string, error) {
+	var pruned []string
+	var errRt error
+	for _, id := range images {
+		if _, err := l.ImageRemove(ctx, id, types.ImageRemoveOptions{}); err == nil {
+			pruned = append(pruned, id)
+		} else if errRt == nil {
+			// save the first error
+			errRt = fmt.Errorf("pruning images: %w", err)
+		}
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if err := l.collectGarbage(); err != nil && errRt == nil {
+		errRt = fmt.Errorf("pruning images: %w", err)
+	}
+	return pruned, errRt
+}
+
+// DiskUsage returns the size of all the blobs in the image layout.
+func (l *layoutDaemon) DiskUsage(context.Context) (uint64, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var usage uint64
+	err := filepath.Walk(filepath.Join(string(l.path), "blobs"), func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			usage += uint64(info.Size())
+		}
+		return nil
+	})
+	return usage, err
+}
+
+// store writes an image to the layout and points the given reference to it.
+// Must be called with the lock held.
+func (l *layoutDaemon) store(img v1.Image, ref string) error {
+	return l.path.ReplaceImage(img, match.Name(ref), layout.WithAnnotations(map[string]string{
+		imagespec.AnnotationRefName: ref,
+	}))
+}
+
+// find looks up an image by reference or by image ID. It returns a nil image if nothing matches,
+// along with all the references pointing to the image.
+// Must be called with the lock held.
+func (l *layoutDaemon) find(identifier string) (v1.Image, []string, error) {
+	descriptors, err := l.descriptors()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var found v1.Image
+	var foundID string
+	for _, desc := range descriptors {
+		if sameReference(desc.Annotations[imagespec.AnnotationRefName], identifier) {
+			if found, err = l.path.Image(desc.Digest); err != nil {
+				return nil, nil, err
+			}
+			break
+		}
+	}
+
+	if found == nil {
+		for _, desc := range descriptors {
+			img, err := l.path.Image(desc.Digest)
+			if err != nil {
+				return nil, nil, err
+			}
+			id, err := imageID(img)
+			if err != nil {
+				return nil, nil, err
+			}
+			if id == identifier || strings.TrimPrefix(id, "sha256:") == identifier {
+				found = img
+				break
+			}
+		}
+	}
+	if found == nil {
+		return nil, nil, nil
+	}
+
+	if foundID, err = imageID(found); err != nil {
+		return nil, nil, err
+	}
+
+	var refs []string
+	for _, desc := range descriptors {
+		img, err := l.path.Image(desc.Digest)
+		if err != nil {
+			return nil, nil, err
+		}
+		if id, err := imageID(img); err == nil && id == foundID {
+			refs = append(refs, desc.Annotations[imagespec.AnnotationRefName])
+		}
+	}
+	return found, refs, nil
+}
+
+func (l *layoutDaemon) descriptors() ([]v1.Descriptor, error) {
+	index, err := l.path.ImageIndex()
+	if err != nil {
+		return nil, fmt.Errorf("reading image layout %s: %w", l.path, err)
+	}
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("reading image layout %s: %w", l.path, err)
+	}
+	return manifest.Manifests, nil
+}
+
+// collectGarbage deletes the blobs that are not referenced by any image.
+// Must be called with the lock held.
+func (l *layoutDaemon) collectGarbage() error {
+	descriptors, err := l.descriptors()
+	if err != nil {
+		return err
+	}
+
+	referenced := map[string]bool{}
+	for _, desc := range descriptors {
+		referenced[desc.Digest.Hex] = true
+
+		img, err := l.path.Image(desc.Digest)
+		if err != nil {
+			return err
+		}
+		manifest, err := img.Manifest()
+		if err != nil {
+			return err
+		}
+		referenced[manifest.Config.Digest.Hex] = true
+		for _, layer := range manifest.Layers {
+			referenced[layer.Digest.Hex] = true
+		}
+	}
+
+	blobs := filepath.Join(string(l.path), "blobs", "sha256")
+	files, err := ioutil.ReadDir(blobs)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if referenced[f.Name()] {
+			continue
+		}
+		logrus.Debugf("deleting unreferenced blob %s", f.Name())
+		if err := os.Remove(filepath.Join(blobs, f.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func imageID(img v1.Image) (string, error) {
+	h, err := img.ConfigName()
+	if err != nil {
+		return "", err
+	}
+	return h.String(), nil
+}
+
+func imageSize(img v1.Image) (int64, error) {
+	layers, err := img.Layers()
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, layer := range layers {
+		s, err := layer.Size()
+		if err != nil {
+			return 0, err
+		}
+		size += s
+	}
+	return size, nil
+}
+
+// sameReference checks if two image references point to the same repository and tag.
+func sameReference(a, b string) bool {
+	if a == "" || b == "" {
+		return false
+	}
+	if a == b {
+		return true
+	}
+	refA, errA := name.ParseReference(a, name.WeakValidation)
+	refB, errB := name.ParseReference(b, name.WeakValidation)
+	return errA == nil && errB == nil && refA.Name() == refB.Name()
+}
+
+// sameRepository checks if an image reference belongs to the given repository, or is the given reference.
+func sameRepository(ref, repository string) bool {
+	if sameReference(ref, repository) {
+		return true
+	}
+	parsed, err := name.ParseReference(ref, name.WeakValidation)
+	if err != nil {
+		return false
+	}
+	repo, err := name.NewRepository(repository, name.WeakValidation)
+	return err == nil && parsed.Context().Name() == repo.Name()
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLayoutDaemon(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		ctx := context.Background()
+		l, err := newLayoutDaemon(t.NewTempDir().Root(), nil)
+		t.CheckNoError(err)
+
+		img, err := random.Image(1024, 2)
+		t.CheckNoError(err)
+		configName, err := img.ConfigName()
+		t.CheckNoError(err)
+		tag, err := name.NewTag("img:tag")
+		t.CheckNoError(err)
+		var tar bytes.Buffer
+		t.CheckNoError(tarball.Write(tag, img, &tar))
+
+		id, err := l.Load(ctx, ioutil.Discard, &tar, "img:tag")
+		t.CheckNoError(err)
+		t.CheckDeepEqual(configName.String(), id)
+		t.CheckTrue(l.ImageExists(ctx, "img:tag"))
+		t.CheckTrue(l.ImageExists(ctx, id))
+		t.CheckFalse(l.ImageExists(ctx, "img:other"))
+
+		uniqueTag, err := l.TagWithImageID(ctx, "img:tag", id)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("img:"+strings.TrimPrefix(id, "sha256:"), uniqueTag)
+		uniqueID, err := l.ImageID(ctx, uniqueTag)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(id, uniqueID)
+
+		images, err := l.ImageList(ctx, "img")
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(images))
+		t.CheckDeepEqual(id, images[0].ID)
+		t.CheckDeepEqual([]string{"img:tag", uniqueTag}, images[0].RepoTags)
+
+		inspect, _, err := l.ImageInspectWithRaw(ctx, uniqueTag)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(id, inspect.ID)
+
+		usage, err := l.DiskUsage(ctx)
+		t.CheckNoError(err)
+		t.CheckTrue(usage > 0)
+
+		pruned, err := l.Prune(ctx, []string{id}, true)
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{id}, pruned)
+		t.CheckFalse(l.ImageExists(ctx, "img:tag"))
+
+		usage, err = l.DiskUsage(ctx)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(uint64(0), usage)
+	})
+}
+
+func TestLayoutDaemonBuild(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		l, err := newLayoutDaemon(t.NewTempDir().Root(), nil)
+		t.CheckNoError(err)
+
+		_, err = l.Build(context.Background(), ioutil.Discard, ".", "image", nil, BuildOptions{})
+
+		t.CheckErrorContains("requires a Docker daemon", err)
+	})
+}
+
+func TestLayoutDaemonLabel(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		ctx := context.Background()
+		l, err := newLayoutDaemon(t.NewTempDir().Root(), nil)
+		t.CheckNoError(err)
+
+		img, err := random.Image(1024, 1)
+		t.CheckNoError(err)
+		tag, err := name.NewTag("img:tag")
+		t.CheckNoError(err)
+		var tar bytes.Buffer
+		t.CheckNoError(tarball.Write(tag, img, &tar))
+		id, err := l.Load(ctx, ioutil.Discard, &tar, "img:tag")
+		t.CheckNoError(err)
+
+		labeledID, err := l.Label(ctx, "img:tag", map[string]string{ArtifactLabel: "img"})
+		t.CheckNoError(err)
+		t.CheckTrue(labeledID != id)
+
+		images, err := l.LabeledImages(ctx, ArtifactLabel)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(images))
+		t.CheckDeepEqual(labeledID, images[0].ID)
+		t.CheckDeepEqual("img", images[0].Labels[ArtifactLabel])
+
+		_, err = l.Label(ctx, "img:other", map[string]string{ArtifactLabel: "img"})
+		t.CheckErrorContains("not found", err)
+	})
+}
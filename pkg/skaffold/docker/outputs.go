@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// OutputResolver provides an interface to resolve the files produced by file outputs.
+type OutputResolver interface {
+	// GetOutputDir returns the directory holding the files of the given output, once they've been produced.
+	GetOutputDir(name string) (string, bool)
+
+	// GetOutputDependencies returns the input files of the given output.
+	GetOutputDependencies(name string) ([]string, error)
+}
+
+// ResolveOutputDependencies creates a map of paths in the build context to the directories holding the files
+// of the required outputs.
+func ResolveOutputDependencies(deps []*latest.OutputDependency, r OutputResolver) (map[string]string, error) {
+	if len(deps) == 0 {
+		return nil, nil
+	}
+	if r == nil {
+		return nil, errors.New("file outputs aren't supported by this builder")
+	}
+
+	m := make(map[string]string)
+	for _, d := range deps {
+		dir, found := r.GetOutputDir(d.Name)
+		if !found {
+			return nil, fmt.Errorf("file output %q hasn't been produced", d.Name)
+		}
+		m[filepath.Clean(d.Path)] = dir
+	}
+	return m, nil
+}
+
+// OutputPaths returns the paths in the build context where the required outputs are added.
+func OutputPaths(deps []*latest.OutputDependency) []string {
+	var paths []string
+	for _, d := range deps {
+		paths = append(paths, filepath.Clean(d.Path))
+	}
+	return paths
+}
+
+// inOutputPaths checks whether a source path of the build context is provided by a file output.
+func inOutputPaths(src string, outputPaths []string) bool {
+	src = filepath.Clean(src)
+	for _, p := range outputPaths {
+		if src == p || strings.HasPrefix(src, p+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
@@ -19,12 +19,14 @@ package docker
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	v1 "github.com/google/go-containerregistry/pkg/v1"
@@ -52,6 +54,8 @@ type copyCommand struct {
 	dest string
 	// destIsDir indicates if dest must be treated as directory.
 	destIsDir bool
+	// mount indicates that the sources are bind mounted by a RUN instruction instead of being copied into the image.
+	mount bool
 }
 
 type fromTo struct {
@@ -61,18 +65,33 @@ type fromTo struct {
 	to string
 	// toIsDir indicates if the `to` path must be treated as directory
 	toIsDir bool
+	// mount indicates that the dependency is only bind mounted during the build, so it can't be synced.
+	mount bool
+}
+
+type heredoc struct {
+	word      string
+	stripTabs bool
 }
 
 var (
 	// RetrieveImage is overridden for unit testing
 	RetrieveImage = retrieveImage
+
+	// heredocMarker matches the `<<EOF`, `<<-EOF` and `<<"EOF"` heredoc redirections.
+	heredocMarker = regexp.MustCompile(`(?:^|\s)<<(-?)["']?([a-zA-Z_][a-zA-Z0-9_]*)["']?`)
+
+	// newerBuildKitFlags are instruction flags supported by recent Dockerfile frontends
+	// that the vendored parser doesn't know about yet.
+	newerBuildKitFlags = []string{"--link", "--network", "--security", "--parents", "--exclude", "--checksum", "--keep-git-dir"}
 )
 
-func readCopyCmdsFromDockerfile(onlyLastImage bool, absDockerfilePath, workspace string, buildArgs map[string]*string, cfg Config) ([]fromTo, error) {
+func readCopyCmdsFromDockerfile(onlyLastImage bool, absDockerfilePath, workspace string, buildArgs map[string]*string, outputPaths []string, cfg Config) ([]fromTo, error) {
 	r, err := ioutil.ReadFile(absDockerfilePath)
 	if err != nil {
 		return nil, err
 	}
+	r = stripHeredocs(r)
 
 	res, err := parser.Parse(bytes.NewReader(r))
 	if err != nil {
@@ -99,7 +118,41 @@ func readCopyCmdsFromDockerfile(onlyLastImage bool, absDockerfilePath, workspace
 		return nil, fmt.Errorf("listing copied files: %w", err)
 	}
 
-	return expandSrcGlobPatterns(workspace, cpCmds)
+	return expandSrcGlobPatterns(workspace, cpCmds, outputPaths)
+}
+
+// stripHeredocs blanks out the heredoc bodies of RUN, COPY and ADD instructions, which the dockerfile parser
+// would otherwise read as instructions. The lines are kept so that errors still point to the right line.
+func stripHeredocs(dockerfile []byte) []byte {
+	lines := strings.Split(string(dockerfile), "\n")
+
+	var pending []heredoc
+	for i, line := range lines {
+		if len(pending) > 0 {
+			terminator := strings.TrimRight(line, "\r")
+			if pending[0].stripTabs {
+				terminator = strings.TrimLeft(terminator, "\t")
+			}
+			if terminator == pending[0].word {
+				pending = pending[1:]
+			}
+			lines[i] = ""
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch strings.ToLower(fields[0]) {
+		case command.Run, command.Copy, command.Add:
+			for _, m := range heredocMarker.FindAllStringSubmatch(line, -1) {
+				pending = append(pending, heredoc{word: m[2], stripTabs: m[1] == "-"})
+			}
+		}
+	}
+
+	return []byte(strings.Join(lines, "\n"))
 }
 
 // filterUnusedBuildArgs removes entries from the build arguments map that are not found in the dockerfile
@@ -159,15 +212,22 @@ func expandBuildArgs(nodes []*parser.Node, buildArgs map[string]*string) error {
 	return nil
 }
 
-func expandSrcGlobPatterns(workspace string, cpCmds []*copyCommand) ([]fromTo, error) {
+// expandSrcGlobPatterns resolves the sources of the copy commands in the workspace.
+// Sources provided by file outputs aren't part of the workspace and are skipped.
+func expandSrcGlobPatterns(workspace string, cpCmds []*copyCommand, outputPaths []string) ([]fromTo, error) {
 	var fts []fromTo
 	for _, cpCmd := range cpCmds {
 		matchesOne := false
 
 		for _, p := range cpCmd.srcs {
+			if inOutputPaths(p, outputPaths) {
+				matchesOne = true
+				continue
+			}
+
 			path := filepath.Join(workspace, p)
 			if _, err := os.Stat(path); err == nil {
-				fts = append(fts, fromTo{from: filepath.Clean(p), to: cpCmd.dest, toIsDir: cpCmd.destIsDir})
+				fts = append(fts, fromTo{from: filepath.Clean(p), to: cpCmd.dest, toIsDir: cpCmd.destIsDir, mount: cpCmd.mount})
 				matchesOne = true
 				continue
 			}
@@ -186,7 +246,7 @@ func expandSrcGlobPatterns(workspace string, cpCmds []*copyCommand) ([]fromTo, e
 					return nil, fmt.Errorf("getting relative path of %s", f)
 				}
 
-				fts = append(fts, fromTo{from: rel, to: cpCmd.dest, toIsDir: cpCmd.destIsDir})
+				fts = append(fts, fromTo{from: rel, to: cpCmd.dest, toIsDir: cpCmd.destIsDir, mount: cpCmd.mount})
 			}
 			matchesOne = true
 		}
@@ -256,6 +316,13 @@ func extractCopyCommands(nodes []*parser.Node, onlyLastImage bool, cfg Config) (
 			if cpCmd != nil && len(cpCmd.srcs) > 0 {
 				copied = append(copied, cpCmd)
 			}
+		case command.Run:
+			mounts, err := readBindMounts(node, envs)
+			if err != nil {
+				return nil, err
+			}
+
+			copied = append(copied, mounts...)
 		case command.Env:
 			// one env command may define multiple variables
 			for node := node.Next; node != nil && node.Next != nil; node = node.Next.Next {
@@ -295,6 +362,10 @@ func readCopyCommand(value *parser.Node, envs []string, workdir string) (*copyCo
 			logrus.Debugln("Skipping watch on remote dependency", src)
 			continue
 		}
+		if strings.HasPrefix(src, "<<") {
+			logrus.Debugln("Skipping watch on inline heredoc source", src)
+			continue
+		}
 
 		srcs = append(srcs, src)
 	}
@@ -311,6 +382,61 @@ func readCopyCommand(value *parser.Node, envs []string, workdir string) (*copyCo
 	}, nil
 }
 
+// readBindMounts lists the build context files that a RUN instruction bind mounts with `--mount=type=bind`.
+// Mounts from other stages or images, and other types of mounts, don't imply a source dependency.
+func readBindMounts(value *parser.Node, envs []string) ([]*copyCommand, error) {
+	var mounts []*copyCommand
+	slex := shell.NewLex('\\')
+	for _, flag := range value.Flags {
+		if !strings.HasPrefix(flag, "--mount=") {
+			continue
+		}
+
+		fields, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(flag, "--mount="))).Read()
+		if err != nil {
+			return nil, fmt.Errorf("parsing mount %q: %w", flag, err)
+		}
+
+		// bind mounts of the whole context are the default
+		mountType, src, target, fromOther := "bind", ".", "", false
+		for _, field := range fields {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			switch strings.ToLower(kv[0]) {
+			case "type":
+				mountType = strings.ToLower(kv[1])
+			case "source", "src":
+				src = kv[1]
+			case "target", "dst", "destination":
+				target = kv[1]
+			case "from":
+				fromOther = true
+			}
+		}
+		if mountType != "bind" || fromOther {
+			continue
+		}
+
+		src, err = slex.ProcessWord(src, envs)
+		if err != nil {
+			return nil, fmt.Errorf("expanding mount source: %w", err)
+		}
+		// sources are always relative to the root of the build context
+		if src = strings.TrimPrefix(path.Clean("/"+src), "/"); src == "" {
+			src = "."
+		}
+
+		mounts = append(mounts, &copyCommand{
+			srcs:  []string{src},
+			dest:  target,
+			mount: true,
+		})
+	}
+	return mounts, nil
+}
+
 func expandOnbuildInstructions(nodes []*parser.Node, cfg Config) ([]*parser.Node, error) {
 	onbuildNodesCache := map[string][]*parser.Node{
 		"scratch": nil,
@@ -431,7 +557,30 @@ func validateParsedDockerfile(r io.Reader, res *parser.Result) error {
 	if _, _, _, usesSyntax := dockerfile2llb.DetectSyntax(r); usesSyntax {
 		return nil
 	}
+	// flags of newer frontends would be reported as unknown, even though Docker supports them
+	flags := make([][]string, len(res.AST.Children))
+	for i, node := range res.AST.Children {
+		flags[i] = node.Flags
+		node.Flags = withoutNewerBuildKitFlags(node.Flags)
+	}
+	defer func() {
+		for i, node := range res.AST.Children {
+			node.Flags = flags[i]
+		}
+	}()
+
 	// instructions.Parse will check for malformed Dockerfile
 	_, _, err := instructions.Parse(res.AST)
 	return err
 }
+
+func withoutNewerBuildKitFlags(flags []string) []string {
+	var kept []string
+	for _, f := range flags {
+		name := strings.SplitN(f, "=", 2)[0]
+		if !util.StrSliceContains(newerBuildKitFlags, name) {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
@@ -126,6 +126,18 @@ func TestValidateParsedDockerfile(t *testing.T) {
 			dockerfile:  `BAR foo`,
 			shouldErr:   true,
 		},
+		{
+			description: "flags of newer frontends",
+			dockerfile: `FROM foo
+COPY --link bar /bar
+RUN --network=none --mount=type=bind,target=/src make`,
+		},
+		{
+			description: "unknown flag",
+			dockerfile: `FROM foo
+COPY --foo bar /bar`,
+			shouldErr: true,
+		},
 		{
 			description: "explicit syntax directive",
 			dockerfile: `# syntax = foo/bar
@@ -144,3 +156,42 @@ func TestValidateParsedDockerfile(t *testing.T) {
 		})
 	}
 }
+
+func TestStripHeredocs(t *testing.T) {
+	tests := []struct {
+		description string
+		dockerfile  string
+		expected    string
+	}{
+		{
+			description: "no heredoc",
+			dockerfile:  "FROM foo\nCOPY bar /bar\n",
+			expected:    "FROM foo\nCOPY bar /bar\n",
+		},
+		{
+			description: "copy heredoc",
+			dockerfile:  "FROM foo\nCOPY <<EOF /bar\nhello\nEOF\nCOPY baz /baz",
+			expected:    "FROM foo\nCOPY <<EOF /bar\n\n\nCOPY baz /baz",
+		},
+		{
+			description: "run heredoc with quoted word",
+			dockerfile:  "FROM foo\nrun python3 <<'PY'\nprint(1)\nPY\n",
+			expected:    "FROM foo\nrun python3 <<'PY'\n\n\n",
+		},
+		{
+			description: "multiple heredocs with tabs stripped",
+			dockerfile:  "FROM foo\nCOPY <<-A <<B /dir/\n\ta\n\tA\nb\nB\nRUN ls",
+			expected:    "FROM foo\nCOPY <<-A <<B /dir/\n\n\n\n\nRUN ls",
+		},
+		{
+			description: "shell shift operator",
+			dockerfile:  "FROM foo\nRUN echo $((1<<2))\nRUN ls",
+			expected:    "FROM foo\nRUN echo $((1<<2))\nRUN ls",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, string(stripHeredocs([]byte(test.dockerfile))))
+		})
+	}
+}
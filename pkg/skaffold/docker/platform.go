@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sirupsen/logrus"
+)
+
+// SinglePlatformImage is an image built for a single platform, to be assembled in a manifest list.
+type SinglePlatformImage struct {
+	Platform v1.Platform
+	Image    string
+}
+
+// ParsePlatform parses a platform in the `os/arch[/variant]` format.
+func ParsePlatform(s string) (v1.Platform, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v1.Platform{}, fmt.Errorf("invalid platform %q: expected format os/arch[/variant]", s)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return v1.Platform{}, fmt.Errorf("invalid platform %q: expected format os/arch[/variant]", s)
+		}
+	}
+
+	p := v1.Platform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+// FormatPlatform returns the `os/arch[/variant]` representation of a platform.
+func FormatPlatform(p v1.Platform) string {
+	s := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+	return s
+}
+
+// PlatformTag returns the tag used to push the single platform image of a multi-platform build.
+// For example: `gcr.io/project/image:v1` becomes `gcr.io/project/image:v1_linux_arm64`.
+func PlatformTag(tag string, p v1.Platform) string {
+	suffix := p.OS + "_" + p.Architecture
+	if p.Variant != "" {
+		suffix += "_" + p.Variant
+	}
+	if ref, err := ParseReference(tag); err == nil && ref.Tag == "" {
+		return tag + ":" + suffix
+	}
+	return tag + "_" + suffix
+}
+
+// CreateManifestList assembles remote single platform images into a manifest list pushed to the given tag.
+// It returns the digest of the manifest list.
+func CreateManifestList(images []SinglePlatformImage, tag string, cfg Config) (string, error) {
+	adds := make([]mutate.IndexAddendum, len(images))
+	for i, image := range images {
+		img, err := getRemoteImage(image.Image, cfg)
+		if err != nil {
+			return "", fmt.Errorf("getting image %q: %w", image.Image, err)
+		}
+
+		platform := image.Platform
+		adds[i] = mutate.IndexAddendum{
+			Add: img,
+			Descriptor: v1.Descriptor{
+				Platform: &platform,
+			},
+		}
+	}
+
+	idx := mutate.AppendManifests(mutate.IndexMediaType(empty.Index, types.DockerManifestList), adds...)
+
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	logrus.Debugf("Pushing manifest list %s for %d platforms", tag, len(images))
+	if err := remote.WriteIndex(ref, idx, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("pushing manifest list %q: %w", tag, err)
+	}
+
+	return digest(idx)
+}
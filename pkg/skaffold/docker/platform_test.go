@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		description string
+		platform    string
+		expected    v1.Platform
+		shouldErr   bool
+	}{
+		{
+			description: "os and architecture",
+			platform:    "linux/amd64",
+			expected:    v1.Platform{OS: "linux", Architecture: "amd64"},
+		},
+		{
+			description: "with variant",
+			platform:    "linux/arm/v7",
+			expected:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+		},
+		{
+			description: "missing architecture",
+			platform:    "linux",
+			shouldErr:   true,
+		},
+		{
+			description: "empty architecture",
+			platform:    "linux/",
+			shouldErr:   true,
+		},
+		{
+			description: "too many parts",
+			platform:    "linux/arm/v7/extra",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			platform, err := ParsePlatform(test.platform)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, platform)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.platform, FormatPlatform(platform))
+			}
+		})
+	}
+}
+
+func TestPlatformTag(t *testing.T) {
+	tests := []struct {
+		description string
+		tag         string
+		platform    v1.Platform
+		expected    string
+	}{
+		{
+			description: "tag",
+			tag:         "gcr.io/project/image:v1",
+			platform:    v1.Platform{OS: "linux", Architecture: "arm64"},
+			expected:    "gcr.io/project/image:v1_linux_arm64",
+		},
+		{
+			description: "with variant",
+			tag:         "gcr.io/project/image:v1",
+			platform:    v1.Platform{OS: "linux", Architecture: "arm", Variant: "v7"},
+			expected:    "gcr.io/project/image:v1_linux_arm_v7",
+		},
+		{
+			description: "no tag",
+			tag:         "localhost:5000/image",
+			platform:    v1.Platform{OS: "linux", Architecture: "amd64"},
+			expected:    "localhost:5000/image:linux_amd64",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, PlatformTag(test.tag, test.platform))
+		})
+	}
+}
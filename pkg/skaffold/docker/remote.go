@@ -93,6 +93,36 @@ func Push(tarPath, tag string, cfg Config) (string, error) {
 	return getRemoteDigest(tag, cfg)
 }
 
+// RemotePlatformImage retrieves a remote image for the given platform,
+// selecting the matching image if the identifier points to a manifest list.
+func RemotePlatformImage(identifier string, platform v1.Platform, cfg Config) (v1.Image, error) {
+	ref, err := parseReference(identifier, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteImage(ref, remote.WithAuthFromKeychain(primaryKeychain), remote.WithPlatform(platform))
+}
+
+// PushImage pushes an image to the given tag and returns its digest.
+func PushImage(img v1.Image, tag string, cfg Config) (string, error) {
+	ref, err := parseReference(tag, cfg, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	if err := remote.Write(ref, img, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return "", fmt.Errorf("%s %q: %w", sErrors.PushImageErr, ref, err)
+	}
+
+	return digest(img)
+}
+
+// GetRemoteImage retrieves an image from its registry.
+func GetRemoteImage(identifier string, cfg Config) (v1.Image, error) {
+	return getRemoteImage(identifier, cfg)
+}
+
 func getRemoteImage(identifier string, cfg Config) (v1.Image, error) {
 	ref, err := parseReference(identifier, cfg)
 	if err != nil {
@@ -27,15 +27,16 @@ import (
 
 // SyncMap creates a map of syncable files by looking at the COPY/ADD commands in the Dockerfile.
 // All keys are relative to the Skaffold root, the destinations are absolute container paths.
+// Files copied from the given output paths aren't part of the workspace, and can't be synced.
 // TODO(corneliusweig) destinations are not resolved across stages in multistage dockerfiles. Is there a use-case for that?
-func SyncMap(workspace string, dockerfilePath string, buildArgs map[string]*string, cfg Config) (map[string][]string, error) {
+func SyncMap(workspace string, dockerfilePath string, buildArgs map[string]*string, outputPaths []string, cfg Config) (map[string][]string, error) {
 	absDockerfilePath, err := NormalizeDockerfilePath(workspace, dockerfilePath)
 	if err != nil {
 		return nil, fmt.Errorf("normalizing dockerfile path: %w", err)
 	}
 
 	// only the COPY/ADD commands from the last image are syncable
-	fts, err := readCopyCmdsFromDockerfile(true, absDockerfilePath, workspace, buildArgs, cfg)
+	fts, err := readCopyCmdsFromDockerfile(true, absDockerfilePath, workspace, buildArgs, outputPaths, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +66,10 @@ func walkWorkspaceWithDestinations(workspace string, excludes []string, fts []fr
 	// Walk the workspace
 	srcByDest := make(map[string]string)
 	for _, ft := range fts {
+		if ft.mount {
+			// bind mounted files are not part of the image
+			continue
+		}
 		absFrom := filepath.Join(workspace, ft.from)
 
 		fi, err := os.Stat(absFrom)
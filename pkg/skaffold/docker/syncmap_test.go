@@ -97,6 +97,18 @@ func TestSyncMap(t *testing.T) {
 			workspace:   ".",
 			expected:    map[string][]string{"server.go": {"/server.go"}},
 		},
+		{
+			description: "bind mounts are not synced",
+			dockerfile:  runMountDockerfile,
+			workspace:   ".",
+			expected:    map[string][]string{"server.go": {"/server.go"}},
+		},
+		{
+			description: "heredocs are not synced",
+			dockerfile:  heredocDockerfile,
+			workspace:   ".",
+			expected:    map[string][]string{"server.go": {"/app/server.go"}},
+		},
 		{
 			description: "add dependency",
 			dockerfile:  addNginx,
@@ -408,7 +420,7 @@ func TestSyncMap(t *testing.T) {
 			}
 
 			workspace := tmpDir.Path(test.workspace)
-			deps, err := SyncMap(workspace, "Dockerfile", test.buildArgs, nil)
+			deps, err := SyncMap(workspace, "Dockerfile", test.buildArgs, nil, nil)
 
 			// destinations are not sorted, but for the test assertion they must be
 			for _, dsts := range deps {
@@ -505,7 +517,7 @@ ADD * .
 				Write("Dockerfile", test.dockerfile)
 
 			for i := 0; i < repeat; i++ {
-				deps, err := SyncMap(tmpDir.Root(), "Dockerfile", nil, nil)
+				deps, err := SyncMap(tmpDir.Root(), "Dockerfile", nil, nil, nil)
 
 				// destinations are not sorted, but for the test assertion they must be
 				for _, dsts := range deps {
@@ -24,6 +24,11 @@ import (
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
+const (
+	ImageSignatureMissingErr = "no signature found for image"
+	ImageSignatureInvalidErr = "no valid signature found for image"
+)
+
 var (
 	// for testing
 	isMinikube = cluster.GetClient().IsMinikube
@@ -46,3 +51,26 @@ func suggestDeployFailedAction(runCtx runcontext.RunContext) []*proto.Suggestion
 		Action:         "Check your connection for the cluster",
 	}}
 }
+
+func suggestSignImageAction(runCtx runcontext.RunContext) []*proto.Suggestion {
+	for _, p := range runCtx.GetPipelines() {
+		if p.Build.Sign != nil {
+			return []*proto.Suggestion{{
+				SuggestionCode: proto.SuggestionCode_SIGN_IMAGE,
+				Action:         "Rebuild and push the image with `skaffold build` to sign it with the key configured in `build.sign`",
+			}}
+		}
+	}
+
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_SIGN_IMAGE,
+		Action:         "Set `build.sign.key` to sign the images when they are built, or sign the image with `cosign sign`",
+	}}
+}
+
+func suggestCheckVerificationKeyAction(runcontext.RunContext) []*proto.Suggestion {
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_VERIFICATION_KEY,
+		Action:         "Check that `deploy.verify.key` is the public key matching the key used to sign the image",
+	}}
+}
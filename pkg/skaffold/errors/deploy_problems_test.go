@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -72,3 +73,32 @@ func TestSuggestDeployFailedAction(t *testing.T) {
 		})
 	}
 }
+
+func TestSuggestSignImageAction(t *testing.T) {
+	tests := []struct {
+		description string
+		sign        *latest.SignConfig
+		expected    string
+	}{
+		{
+			description: "signing not configured",
+			expected:    "Set `build.sign.key` to sign the images when they are built, or sign the image with `cosign sign`",
+		},
+		{
+			description: "signing configured",
+			sign:        &latest.SignConfig{Key: "cosign.key"},
+			expected:    "Rebuild and push the image with `skaffold build` to sign it with the key configured in `build.sign`",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			runCtx := runcontext.RunContext{
+				Pipelines: runcontext.NewPipelines([]latest.Pipeline{{Build: latest.BuildConfig{Sign: test.sign}}}),
+			}
+
+			actual := suggestSignImageAction(runCtx)
+
+			t.CheckDeepEqual([]*proto.Suggestion{{SuggestionCode: proto.SuggestionCode_SIGN_IMAGE, Action: test.expected}}, actual)
+		})
+	}
+}
@@ -137,5 +137,29 @@ var (
 			},
 			suggestion: suggestDeployFailedAction,
 		},
+		{
+			regexp:  re(fmt.Sprintf(`.*%s "(.*?)".*`, ImageSignatureMissingErr)),
+			errCode: proto.StatusCode_DEPLOY_IMAGE_SIGNATURE_MISSING,
+			description: func(err error) string {
+				matchExp := re(fmt.Sprintf(`.*%s "(.*?)".*`, ImageSignatureMissingErr))
+				if match := matchExp.FindStringSubmatch(fmt.Sprintf("%s", err)); len(match) >= 2 {
+					return fmt.Sprintf("Deploy Failed. Image %s isn't signed", match[1])
+				}
+				return "Deploy Failed. An image isn't signed"
+			},
+			suggestion: suggestSignImageAction,
+		},
+		{
+			regexp:  re(fmt.Sprintf(`.*%s "(.*?)".*`, ImageSignatureInvalidErr)),
+			errCode: proto.StatusCode_DEPLOY_IMAGE_SIGNATURE_INVALID,
+			description: func(err error) string {
+				matchExp := re(fmt.Sprintf(`.*%s "(.*?)".*`, ImageSignatureInvalidErr))
+				if match := matchExp.FindStringSubmatch(fmt.Sprintf("%s", err)); len(match) >= 2 {
+					return fmt.Sprintf("Deploy Failed. Image %s isn't signed with the expected key", match[1])
+				}
+				return "Deploy Failed. An image isn't signed with the expected key"
+			},
+			suggestion: suggestCheckVerificationKeyAction,
+		},
 	}
 )
@@ -32,11 +32,13 @@ const (
 	// These are phases in a Skaffolld
 	Init        = Phase("Init")
 	Build       = Phase("Build")
+	Test        = Phase("Test")
 	Deploy      = Phase("Deploy")
 	StatusCheck = Phase("StatusCheck")
 	FileSync    = Phase("FileSync")
 	DevInit     = Phase("DevInit")
 	Cleanup     = Phase("Cleanup")
+	Verify      = Phase("Verify")
 
 	// Report issue text
 	reportIssueText = "If above error is unexpected, please open an issue " + constants.GithubIssueLink + " to report this error"
@@ -264,6 +264,36 @@ func TestShowAIError(t *testing.T) {
 				}},
 			},
 		},
+		{
+			description: "deploy failed because of missing signature",
+			context:     &config.ContextConfig{},
+			phase:       Deploy,
+			err:         fmt.Errorf(`no signature found for image "gcr.io/test/app:v1": MANIFEST_UNKNOWN`),
+			expected:    "Deploy Failed. Image gcr.io/test/app:v1 isn't signed. Set `build.sign.key` to sign the images when they are built, or sign the image with `cosign sign`.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_IMAGE_SIGNATURE_MISSING,
+				Message: `no signature found for image "gcr.io/test/app:v1": MANIFEST_UNKNOWN`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_SIGN_IMAGE,
+					Action:         "Set `build.sign.key` to sign the images when they are built, or sign the image with `cosign sign`",
+				}},
+			},
+		},
+		{
+			description: "deploy failed because of invalid signature",
+			context:     &config.ContextConfig{},
+			phase:       Deploy,
+			err:         fmt.Errorf(`no valid signature found for image "gcr.io/test/app:v1": none of its 1 signature(s) match the verification key`),
+			expected:    "Deploy Failed. Image gcr.io/test/app:v1 isn't signed with the expected key. Check that `deploy.verify.key` is the public key matching the key used to sign the image.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_IMAGE_SIGNATURE_INVALID,
+				Message: `no valid signature found for image "gcr.io/test/app:v1": none of its 1 signature(s) match the verification key`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_VERIFICATION_KEY,
+					Action:         "Check that `deploy.verify.key` is the public key matching the key used to sign the image",
+				}},
+			},
+		},
 	}
 	for _, test := range append(tests, initTestCases...) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -21,7 +21,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	//nolint:golint,staticcheck
 	"github.com/golang/protobuf/jsonpb"
@@ -45,6 +47,7 @@ const (
 	Succeeded  = "Succeeded"
 	Terminated = "Terminated"
 	Canceled   = "Canceled"
+	Skipped    = "Skipped"
 )
 
 var handler = newHandler()
@@ -174,6 +177,10 @@ func emptyStateWithArtifacts(builds map[string]string, metadata *proto.Metadata,
 			AutoTrigger: autoBuild,
 			StatusCode:  proto.StatusCode_OK,
 		},
+		TestState: &proto.TestState{
+			Status:     NotStarted,
+			StatusCode: proto.StatusCode_OK,
+		},
 		DeployState: &proto.DeployState{
 			Status:      NotStarted,
 			AutoTrigger: autoDeploy,
@@ -194,6 +201,45 @@ func InitializeState(cfg Config) {
 	handler.setState(emptyState(cfg))
 }
 
+// TestInProgress notifies that a test has been started.
+func TestInProgress() {
+	handler.handleTestEvent(&proto.TestEvent{Status: InProgress})
+}
+
+// TestFailed notifies that a test has failed.
+func TestFailed(err error) {
+	aiErr := sErrors.ActionableErr(sErrors.Test, err)
+	handler.stateLock.Lock()
+	handler.state.TestState.StatusCode = aiErr.ErrCode
+	handler.stateLock.Unlock()
+	handler.handleTestEvent(&proto.TestEvent{Status: Failed, ActionableErr: aiErr})
+}
+
+// TestComplete notifies that a test has completed.
+func TestComplete() {
+	handler.stateLock.Lock()
+	handler.state.TestState.StatusCode = proto.StatusCode_TEST_SUCCESS
+	handler.stateLock.Unlock()
+	handler.handleTestEvent(&proto.TestEvent{Status: Complete})
+}
+
+// TestResult notifies that a single test has finished running, with its status, duration and output.
+// It doesn't change the state of the test phase.
+func TestResult(status, name, testType, image string, duration time.Duration, output string, err error) {
+	te := &proto.TestEvent{
+		Status:         status,
+		Name:           name,
+		Type:           testType,
+		Image:          image,
+		DurationMillis: duration.Milliseconds(),
+		Output:         output,
+	}
+	if err != nil {
+		te.ActionableErr = sErrors.ActionableErr(sErrors.Test, err)
+	}
+	handler.handleTestEvent(te)
+}
+
 // DeployInProgress notifies that a deployment has been started.
 func DeployInProgress() {
 	handler.handleDeployEvent(&proto.DeployEvent{Status: InProgress})
@@ -364,6 +410,8 @@ func DevLoopFailedInPhase(iteration int, phase sErrors.Phase, err error) {
 		DevLoopFailedWithErrorCode(iteration, state.StatusCheckState.StatusCode, err)
 	case sErrors.Build:
 		DevLoopFailedWithErrorCode(iteration, state.BuildState.StatusCode, err)
+	case sErrors.Test:
+		DevLoopFailedWithErrorCode(iteration, state.TestState.StatusCode, err)
 	default:
 		ai := sErrors.ActionableErr(phase, err)
 		DevLoopFailedWithErrorCode(iteration, ai.ErrCode, err)
@@ -461,6 +509,14 @@ func (ev *eventHandler) setState(state proto.State) {
 	ev.stateLock.Unlock()
 }
 
+func (ev *eventHandler) handleTestEvent(e *proto.TestEvent) {
+	ev.handle(&proto.Event{
+		EventType: &proto.Event_TestEvent{
+			TestEvent: e,
+		},
+	})
+}
+
 func (ev *eventHandler) handleDeployEvent(e *proto.DeployEvent) {
 	ev.handle(&proto.Event{
 		EventType: &proto.Event_DeployEvent{
@@ -560,6 +616,24 @@ func (ev *eventHandler) handleExec(f firedEvent) {
 			// logEntry.Err = be.Err
 		default:
 		}
+	case *proto.Event_TestEvent:
+		te := e.TestEvent
+		if te.Name != "" {
+			logEntry.Entry = fmt.Sprintf("Test %s %s", te.Name, strings.ToLower(te.Status))
+			break
+		}
+		ev.stateLock.Lock()
+		ev.state.TestState.Status = te.Status
+		ev.stateLock.Unlock()
+		switch te.Status {
+		case InProgress:
+			logEntry.Entry = "Test started"
+		case Complete:
+			logEntry.Entry = "Test completed"
+		case Failed:
+			logEntry.Entry = "Test failed"
+		default:
+		}
 	case *proto.Event_DeployEvent:
 		de := e.DeployEvent
 		ev.stateLock.Lock()
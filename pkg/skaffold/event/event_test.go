@@ -117,6 +117,68 @@ func TestDeployComplete(t *testing.T) {
 	})
 }
 
+func TestTestInProgress(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().TestState.Status == NotStarted })
+	TestInProgress()
+	wait(t, func() bool { return handler.getState().TestState.Status == InProgress })
+}
+
+func TestTestFailed(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().TestState.Status == NotStarted })
+	TestFailed(errors.New("BUG"))
+	wait(t, func() bool {
+		tState := handler.getState().TestState
+		return tState.Status == Failed && tState.StatusCode == proto.StatusCode_UNKNOWN_ERROR
+	})
+}
+
+func TestTestComplete(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().TestState.Status == NotStarted })
+	TestComplete()
+	wait(t, func() bool {
+		tState := handler.getState().TestState
+		return tState.Status == Complete && tState.StatusCode == proto.StatusCode_TEST_SUCCESS
+	})
+}
+
+func TestTestResult(t *testing.T) {
+	defer func() { handler = newHandler() }()
+
+	handler = newHandler()
+	handler.state = emptyState(mockCfg([]latest.Pipeline{{}}, "test"))
+
+	wait(t, func() bool { return handler.getState().TestState.Status == NotStarted })
+	TestResult(Failed, "integration", "job", "img", 2*time.Second, "some output", errors.New("failed"))
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		if len(handler.eventLog) == 0 {
+			return false
+		}
+		logEntry := handler.eventLog[len(handler.eventLog)-1]
+		te := logEntry.Event.GetTestEvent()
+		return logEntry.Entry == "Test integration failed" && te.DurationMillis == 2000 && te.Output == "some output" &&
+			te.ActionableErr.ErrCode == proto.StatusCode_UNKNOWN_ERROR
+	})
+	// A single test result doesn't change the state of the test phase.
+	testutil.CheckDeepEqual(t, NotStarted, handler.getState().TestState.Status)
+}
+
 func TestBuildInProgress(t *testing.T) {
 	defer func() { handler = newHandler() }()
 
@@ -372,6 +434,7 @@ func TestResetStateOnBuild(t *testing.T) {
 				"image1": NotStarted,
 			},
 		},
+		TestState:        &proto.TestState{Status: NotStarted},
 		DeployState:      &proto.DeployState{Status: NotStarted},
 		StatusCheckState: &proto.StatusCheckState{Status: NotStarted, Resources: map[string]string{}},
 		FileSyncState:    &proto.FileSyncState{Status: NotStarted},
@@ -89,6 +89,7 @@ func NewAnalyzer(c config.Config) *ProjectAnalysis {
 			enableJibInit:        c.EnableJibInit,
 			enableJibGradleInit:  c.EnableJibGradleInit,
 			enableBuildpacksInit: c.EnableBuildpacksInit,
+			enableKoInit:         c.EnableKoInit,
 			buildpacksBuilder:    c.BuildpacksBuilder,
 		},
 		configAnalyzer: &skaffoldConfigAnalyzer{
@@ -60,7 +60,7 @@ func (d *defaultBuildInitializer) resolveBuilderImages() error {
 }
 
 func (d *defaultBuildInitializer) resolveBuilderImagesForcefully() error {
-	// In the case of 1 image and multiple builders, respects the ordering Docker > Jib > Bazel > Buildpacks
+	// In the case of 1 image and multiple builders, respects the ordering Docker > Jib > Bazel > Buildpacks > Ko
 	if len(d.unresolvedImages) == 1 {
 		image := d.unresolvedImages[0]
 		choice := d.builders[0]
@@ -89,9 +89,11 @@ func builderRank(builder InitBuilder) int {
 		return 3
 	case a.BuildpackArtifact != nil:
 		return 4
+	case a.KoArtifact != nil:
+		return 5
 	}
 
-	return 5
+	return 6
 }
 
 func (d *defaultBuildInitializer) resolveBuilderImagesInteractively() error {
@@ -32,6 +32,7 @@ type Config struct {
 	EnableJibInit            bool // TODO: Remove this parameter
 	EnableJibGradleInit      bool
 	EnableBuildpacksInit     bool
+	EnableKoInit             bool
 	EnableNewInitFormat      bool
 	EnableManifestGeneration bool
 	Opts                     config.SkaffoldOptions
@@ -111,7 +111,7 @@ func (a *LogAggregator) Start(ctx context.Context) error {
 					}
 
 					if !a.trackedContainers.add(c.ContainerID) {
-						go a.streamContainerLogs(ctx, pod, c)
+						go a.StreamContainerLogs(ctx, pod, c)
 					}
 				}
 			}
@@ -141,7 +141,9 @@ func sinceSeconds(d time.Duration) int64 {
 	return 1
 }
 
-func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, container v1.ContainerStatus) {
+// StreamContainerLogs prints the logs of a container, prefixed and colored like the other logs,
+// until the container terminates or the context is cancelled.
+func (a *LogAggregator) StreamContainerLogs(ctx context.Context, pod *v1.Pod, container v1.ContainerStatus) {
 	logrus.Infof("Streaming logs from pod: %s container: %s", pod.Name, container.Name)
 
 	// In theory, it's more precise to use --since-time='' but there can be a time
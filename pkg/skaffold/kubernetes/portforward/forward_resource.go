@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// firstLocalPort is where the search for a free local port starts when the remote port is referenced by name.
+const firstLocalPort = 4503
+
+// ForwardResource port-forwards a single resource on a free local port, independently of the
+// port forwarding of the dev loop. It returns the local port and a function that stops the port-forward.
+func ForwardResource(ctx context.Context, forwarder EntryForwarder, resource latest.PortForwardResource) (int, func(), error) {
+	localPort := resource.LocalPort
+	if localPort <= 0 {
+		localPort = resource.Port.IntVal
+	}
+	if localPort <= 0 {
+		localPort = firstLocalPort
+	}
+
+	var usedPorts util.PortSet
+	entry := newPortForwardEntry(0, resource, "", "", "", "", 0, false)
+	entry.localPort = retrieveAvailablePort(util.Loopback, localPort, &usedPorts)
+	stop := func() { forwarder.Terminate(entry) }
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- forwarder.Forward(ctx, entry)
+	}()
+
+	select {
+	case err := <-errs:
+		if err != nil {
+			stop()
+			return 0, nil, err
+		}
+		return entry.localPort, stop, nil
+	case <-ctx.Done():
+		stop()
+		return 0, nil, ctx.Err()
+	}
+}
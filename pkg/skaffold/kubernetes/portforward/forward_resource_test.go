@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type failingForwarder struct {
+	testForwarder
+}
+
+func (f *failingForwarder) Forward(context.Context, *portForwardEntry) error {
+	return errors.New("unable to forward")
+}
+
+func TestForwardResource(t *testing.T) {
+	tests := []struct {
+		description  string
+		port         schemautil.IntOrString
+		expectedPort int
+	}{
+		{
+			description:  "numbered port",
+			port:         schemautil.FromInt(8080),
+			expectedPort: 8080,
+		},
+		{
+			description:  "named port",
+			port:         schemautil.FromString("http"),
+			expectedPort: firstLocalPort,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var requested int
+			t.Override(&retrieveAvailablePort, func(_ string, port int, _ *util.PortSet) int {
+				requested = port
+				return port
+			})
+			forwarder := newTestForwarder()
+
+			localPort, stop, err := ForwardResource(context.Background(), forwarder, latest.PortForwardResource{
+				Type:      "service",
+				Name:      "frontend",
+				Namespace: "default",
+				Port:      test.port,
+			})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedPort, requested)
+			t.CheckDeepEqual(test.expectedPort, localPort)
+			t.CheckDeepEqual(1, forwarder.forwardedResources.Length())
+
+			stop()
+			t.CheckDeepEqual(0, forwarder.forwardedResources.Length())
+		})
+	}
+}
+
+func TestForwardResourceError(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&retrieveAvailablePort, func(_ string, port int, _ *util.PortSet) int { return port })
+
+		_, _, err := ForwardResource(context.Background(), &failingForwarder{}, latest.PortForwardResource{
+			Type: "service",
+			Name: "frontend",
+			Port: schemautil.FromInt(8080),
+		})
+
+		t.CheckErrorContains("unable to forward", err)
+	})
+}
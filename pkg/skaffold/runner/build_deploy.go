@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -44,7 +45,10 @@ func (r *SkaffoldRunner) Build(ctx context.Context, out io.Writer, artifacts []*
 		return nil, err
 	}
 
-	tags, err := r.imageTags(ctx, out, artifacts)
+	// Hashes are shared by the taggers and the cache, and computed again on every build.
+	r.hasher.Reset()
+
+	tags, additionalTags, err := r.imageTags(ctx, out, artifacts)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +67,17 @@ func (r *SkaffoldRunner) Build(ctx context.Context, out io.Writer, artifacts []*
 		return bRes, nil
 	}
 
-	bRes, err := r.cache.Build(ctx, out, tags, artifacts, func(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact) ([]build.Artifact, error) {
+	bRes, err := r.cache.Build(ctx, out, tags, additionalTags, artifacts, func(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact) ([]build.Artifact, error) {
 		if len(artifacts) == 0 {
 			return nil, nil
 		}
 
 		r.hasBuilt = true
 
+		if err := r.outputs.Produce(ctx, out, artifacts); err != nil {
+			return nil, err
+		}
+
 		bRes, err := r.builder.Build(ctx, out, tags, artifacts)
 		if err != nil {
 			return nil, err
@@ -81,6 +89,14 @@ func (r *SkaffoldRunner) Build(ctx context.Context, out io.Writer, artifacts []*
 		return nil, err
 	}
 
+	if err := r.sbom.Generate(ctx, out, artifacts, bRes); err != nil {
+		return nil, err
+	}
+
+	if err := r.signer.Sign(ctx, out, bRes); err != nil {
+		return nil, err
+	}
+
 	// Update which images are logged.
 	r.addTagsToPodSelector(bRes)
 
@@ -99,6 +115,15 @@ func (r *SkaffoldRunner) Test(ctx context.Context, out io.Writer, artifacts []bu
 	return nil
 }
 
+// testDeployed runs the tests that need the application to be deployed.
+func (r *SkaffoldRunner) testDeployed(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
+	if r.runCtx.SkipTests() {
+		return nil
+	}
+
+	return r.tester.TestDeployed(ctx, out, artifacts)
+}
+
 // DeployAndLog deploys a list of already built artifacts and optionally show the logs.
 func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
 	// Update which images are logged.
@@ -115,6 +140,14 @@ func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifa
 		return err
 	}
 
+	if err := r.verifyDeployed(ctx, out); err != nil {
+		return err
+	}
+
+	if err := r.testDeployed(ctx, out, artifacts); err != nil {
+		return err
+	}
+
 	forwarderManager := r.createForwarder(out)
 	defer forwarderManager.Stop()
 
@@ -143,8 +176,8 @@ func (r *SkaffoldRunner) addTagsToPodSelector(artifacts []build.Artifact) {
 }
 
 type tagErr struct {
-	tag string
-	err error
+	tags []string
+	err  error
 }
 
 // ApplyDefaultRepo applies the default repo to a given image tag.
@@ -152,8 +185,9 @@ func (r *SkaffoldRunner) ApplyDefaultRepo(tag string) (string, error) {
 	return deployutil.ApplyDefaultRepo(r.runCtx.GlobalConfig(), r.runCtx.DefaultRepo(), tag)
 }
 
-// imageTags generates tags for a list of artifacts
-func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) (tag.ImageTags, error) {
+// imageTags generates tags for a list of artifacts.
+// Besides the canonical tag of each image, it returns the additional tags generated by multiTag policies.
+func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) (tag.ImageTags, tag.AdditionalTags, error) {
 	start := time.Now()
 	color.Default.Fprintln(out, "Generating tags...")
 
@@ -164,12 +198,13 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 
 		i := i
 		go func() {
-			tag, err := tag.GenerateFullyQualifiedImageName(r.tagger, artifacts[i].Workspace, artifacts[i].ImageName)
-			tagErrs[i] <- tagErr{tag: tag, err: err}
+			tags, err := tag.GenerateFullyQualifiedImageNames(r.tagger, artifacts[i].Workspace, artifacts[i].ImageName)
+			tagErrs[i] <- tagErr{tags: tags, err: err}
 		}()
 	}
 
 	imageTags := make(tag.ImageTags, len(artifacts))
+	additionalTags := tag.AdditionalTags{}
 	showWarning := false
 
 	for i, artifact := range artifacts {
@@ -178,7 +213,7 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 
 		select {
 		case <-ctx.Done():
-			return nil, context.Canceled
+			return nil, nil, context.Canceled
 
 		case t := <-tagErrs[i]:
 			if t.err != nil {
@@ -187,20 +222,27 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 
 				fallbackTag, err := tag.GenerateFullyQualifiedImageName(&tag.ChecksumTagger{}, artifact.Workspace, imageName)
 				if err != nil {
-					return nil, fmt.Errorf("generating checksum as fall-back tag for %q: %w", imageName, err)
+					return nil, nil, fmt.Errorf("generating checksum as fall-back tag for %q: %w", imageName, err)
 				}
 
-				t.tag = fallbackTag
+				t.tags = []string{fallbackTag}
 				showWarning = true
 			}
 
-			tag, err := r.ApplyDefaultRepo(t.tag)
-			if err != nil {
-				return nil, err
+			var tags []string
+			for _, t := range t.tags {
+				tag, err := r.ApplyDefaultRepo(t)
+				if err != nil {
+					return nil, nil, err
+				}
+				tags = append(tags, tag)
 			}
 
-			fmt.Fprintln(out, tag)
-			imageTags[imageName] = tag
+			fmt.Fprintln(out, strings.Join(tags, ", "))
+			imageTags[imageName] = tags[0]
+			if len(tags) > 1 {
+				additionalTags[imageName] = tags[1:]
+			}
 		}
 	}
 
@@ -209,7 +251,7 @@ func (r *SkaffoldRunner) imageTags(ctx context.Context, out io.Writer, artifacts
 	}
 
 	logrus.Infoln("Tags generated in", util.ShowHumanizeTime(time.Since(start)))
-	return imageTags, nil
+	return imageTags, additionalTags, nil
 }
 
 func checkWorkspaces(artifacts []*latest.Artifact) error {
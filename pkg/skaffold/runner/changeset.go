@@ -26,6 +26,8 @@ type changeSet struct {
 	rebuildTracker map[string]*latest.Artifact
 	needsResync    []*sync.Item
 	resyncTracker  map[string]*sync.Item
+	needsRetest    []int
+	retestTracker  map[int]bool
 	needsRedeploy  bool
 	needsReload    bool
 }
@@ -55,6 +57,18 @@ func (c *changeSet) AddResync(s *sync.Item) {
 	c.needsResync = append(c.needsResync, s)
 }
 
+func (c *changeSet) AddRetest(id int) {
+	if c.retestTracker[id] {
+		return
+	}
+
+	if c.retestTracker == nil {
+		c.retestTracker = map[int]bool{}
+	}
+	c.retestTracker[id] = true
+	c.needsRetest = append(c.needsRetest, id)
+}
+
 func (c *changeSet) resetBuild() {
 	c.rebuildTracker = make(map[string]*latest.Artifact)
 	c.needsRebuild = nil
@@ -65,6 +79,11 @@ func (c *changeSet) resetSync() {
 	c.needsResync = nil
 }
 
+func (c *changeSet) resetTest() {
+	c.retestTracker = make(map[int]bool)
+	c.needsRetest = nil
+}
+
 func (c *changeSet) resetDeploy() {
 	c.needsRedeploy = false
 }
@@ -62,6 +62,11 @@ They are tagged and referenced by a unique, local only, tag instead.
 See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	}
 
+	if err := r.verifier.Verify(ctx, out, r.imagesToVerify(artifacts, localImages)); err != nil {
+		event.DeployFailed(err)
+		return err
+	}
+
 	// Check that the cluster is reachable.
 	// This gives a better error message when the cluster can't
 	// be reached.
@@ -102,6 +107,28 @@ See https://skaffold.dev/docs/pipeline-stages/taggers/#how-tagging-works`)
 	return sErr
 }
 
+// imagesToVerify returns the images whose signature is checked before they are deployed.
+// Local images built by this runner are left out since they never left the machine.
+func (r *SkaffoldRunner) imagesToVerify(artifacts []build.Artifact, localImages []build.Artifact) []build.Artifact {
+	builtLocally := map[string]bool{}
+	for _, a := range localImages {
+		builtLocally[a.Tag] = false
+	}
+	for _, b := range r.builds {
+		if _, found := builtLocally[b.Tag]; found {
+			builtLocally[b.Tag] = true
+		}
+	}
+
+	var images []build.Artifact
+	for _, a := range artifacts {
+		if !builtLocally[a.Tag] {
+			images = append(images, a)
+		}
+	}
+	return images
+}
+
 func (r *SkaffoldRunner) loadImagesIntoCluster(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
 	currentContext, err := r.getCurrentContext()
 	if err != nil {
@@ -163,7 +190,7 @@ func (r *SkaffoldRunner) performStatusCheck(ctx context.Context, out io.Writer)
 	start := time.Now()
 	color.Default.Fprintln(out, "Waiting for deployments to stabilize...")
 
-	s := newStatusCheck(r.runCtx, r.labeller)
+	s := newStatusCheck(r.runCtx, r.labeller, r.deployer.DeployedManifests())
 	if err := s.Check(ctx, out); err != nil {
 		return err
 	}
@@ -171,3 +198,12 @@ func (r *SkaffoldRunner) performStatusCheck(ctx context.Context, out io.Writer)
 	color.Default.Fprintln(out, "Deployments stabilized in", util.ShowHumanizeTime(time.Since(start)))
 	return nil
 }
+
+// verifyDeployed runs the smoke checks of the `verify` section against the deployed resources.
+func (r *SkaffoldRunner) verifyDeployed(ctx context.Context, out io.Writer) error {
+	if r.runCtx.RenderOnly() {
+		return nil
+	}
+
+	return newVerifyCheck(r.runCtx, r.kubectlCLI).Check(ctx, out)
+}
@@ -33,8 +33,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/status"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/verify"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -69,7 +71,7 @@ func TestDeploy(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
 			t.Override(&client.Client, mockK8sClient)
-			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller) status.Checker {
+			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller, manifest.ManifestList) status.Checker {
 				return dummyStatusChecker{}
 			})
 
@@ -119,7 +121,7 @@ func TestDeployNamespace(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
 			t.Override(&client.Client, mockK8sClient)
-			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller) status.Checker {
+			t.Override(&newStatusCheck, func(status.Config, *label.DefaultLabeller, manifest.ManifestList) status.Checker {
 				return dummyStatusChecker{}
 			})
 
@@ -136,6 +138,58 @@ func TestDeployNamespace(t *testing.T) {
 	}
 }
 
+func TestVerifyDeployed(t *testing.T) {
+	tests := []struct {
+		description string
+		renderOnly  bool
+		checkErr    error
+		shouldErr   bool
+		shouldCheck bool
+	}{
+		{
+			description: "checks succeed",
+			shouldCheck: true,
+		},
+		{
+			description: "checks fail",
+			checkErr:    errors.New("verify check frontend:80 failed"),
+			shouldErr:   true,
+			shouldCheck: true,
+		},
+		{
+			description: "no checks when rendering only",
+			renderOnly:  true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			checked := false
+			t.Override(&newVerifyCheck, func(verify.Config, *kubectl.CLI) verify.Checker {
+				return dummyVerifyChecker{checked: &checked, err: test.checkErr}
+			})
+
+			runner := createRunner(t, &TestBench{}, nil, []*latest.Artifact{{ImageName: "img1"}})
+			runner.runCtx.Opts.RenderOnly = test.renderOnly
+
+			err := runner.verifyDeployed(context.Background(), ioutil.Discard)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.shouldCheck, checked)
+		})
+	}
+}
+
+type dummyVerifyChecker struct {
+	checked *bool
+	err     error
+}
+
+func (d dummyVerifyChecker) Check(context.Context, io.Writer) error {
+	*d.checked = true
+	return d.err
+}
+
 func TestSkaffoldDeployRenderOnly(t *testing.T) {
 	testutil.Run(t, "does not make kubectl calls", func(t *testutil.T) {
 		runCtx := &runcontext.RunContext{
@@ -161,6 +215,31 @@ func TestSkaffoldDeployRenderOnly(t *testing.T) {
 	})
 }
 
+func TestImagesToVerify(t *testing.T) {
+	r := SkaffoldRunner{
+		builds: []build.Artifact{
+			{ImageName: "built-local", Tag: "built-local:abc"},
+			{ImageName: "built-remote", Tag: "gcr.io/built-remote:v1@sha256:123"},
+		},
+	}
+	artifacts := []build.Artifact{
+		{ImageName: "built-local", Tag: "built-local:abc"},
+		{ImageName: "built-remote", Tag: "gcr.io/built-remote:v1@sha256:123"},
+		{ImageName: "prebuilt-local", Tag: "prebuilt-local:def"},
+	}
+	localImages := []build.Artifact{
+		{ImageName: "built-local", Tag: "built-local:abc"},
+		{ImageName: "prebuilt-local", Tag: "prebuilt-local:def"},
+	}
+
+	images := r.imagesToVerify(artifacts, localImages)
+
+	testutil.CheckDeepEqual(t, []build.Artifact{
+		{ImageName: "built-remote", Tag: "gcr.io/built-remote:v1@sha256:123"},
+		{ImageName: "prebuilt-local", Tag: "prebuilt-local:def"},
+	}, images)
+}
+
 type dummyStatusChecker struct{}
 
 func (d dummyStatusChecker) Check(_ context.Context, _ io.Writer) error {
@@ -57,8 +57,9 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 	buildIntent, syncIntent, deployIntent := r.intents.GetIntents()
 	needsSync := syncIntent && len(r.changeSet.needsResync) > 0
 	needsBuild := buildIntent && len(r.changeSet.needsRebuild) > 0
+	needsTest := buildIntent && len(r.changeSet.needsRetest) > 0 && !r.runCtx.SkipTests()
 	needsDeploy := deployIntent && r.changeSet.needsRedeploy
-	if !needsSync && !needsBuild && !needsDeploy {
+	if !needsSync && !needsBuild && !needsTest && !needsDeploy {
 		return nil
 	}
 
@@ -111,9 +112,25 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 		}
 		// TODO(modali): Add skipTest boolean to Tester itself to avoid this check.
 		if !r.runCtx.SkipTests() {
-			if err = r.Test(ctx, out, bRes); err != nil {
+			if err = r.retest(ctx, out, bRes); err != nil {
 				logrus.Warnln("Skipping deploy due to test error:", err)
-				event.DevLoopFailedInPhase(r.devIteration, sErrors.Build, err)
+				event.DevLoopFailedInPhase(r.devIteration, sErrors.Test, err)
+				return nil
+			}
+		}
+	}
+
+	// Tests whose dependencies have changed are rerun along with the rebuilt artifacts, or on their own.
+	if needsTest {
+		defer r.changeSet.resetTest()
+		if !needsBuild {
+			if !meterUpdated {
+				instrumentation.AddDevIteration("test")
+				meterUpdated = true
+			}
+			if err := r.retest(ctx, out, nil); err != nil {
+				logrus.Warnln("Skipping deploy due to test error:", err)
+				event.DevLoopFailedInPhase(r.devIteration, sErrors.Test, err)
 				return nil
 			}
 		}
@@ -135,9 +152,19 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer, logger *kuber
 			event.DevLoopFailedInPhase(r.devIteration, sErrors.Deploy, err)
 			return nil
 		}
+		if err := r.verifyDeployed(ctx, out); err != nil {
+			logrus.Warnln("Verify checks failed after deploy:", err)
+			event.DevLoopFailedInPhase(r.devIteration, sErrors.Verify, err)
+			return nil
+		}
 		if err := forwarderManager.Start(ctx); err != nil {
 			logrus.Warnln("Port forwarding failed:", err)
 		}
+		if err := r.testDeployed(ctx, out, r.builds); err != nil {
+			logrus.Warnln("Tests failed after deploy:", err)
+			event.DevLoopFailedInPhase(r.devIteration, sErrors.Test, err)
+			return nil
+		}
 	}
 	event.DevLoopComplete(r.devIteration)
 	logger.Unmute()
@@ -168,7 +195,7 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		default:
 			if err := r.monitor.Register(
 				func() ([]string, error) {
-					return build.DependenciesForArtifact(ctx, artifact, r.runCtx, r.artifactStore)
+					return build.DependenciesForArtifact(ctx, artifact, r.runCtx, r.artifactStore, r.outputs)
 				},
 				func(e filemon.Events) {
 					s, err := sync.NewItem(ctx, artifact, e, r.builds, r.runCtx, len(g[artifact.ImageName]))
@@ -188,13 +215,19 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		}
 	}
 
-	// Watch test configuration
-	if err := r.monitor.Register(
-		r.tester.TestDependencies,
-		func(filemon.Events) { r.changeSet.needsRedeploy = true },
-	); err != nil {
-		event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_TEST_DEPS, err)
-		return fmt.Errorf("watching test files: %w", err)
+	// Watch test configuration, separately for each test
+	for _, t := range r.tester.Tests() {
+		id := t.ID
+		if err := r.monitor.Register(
+			t.Dependencies,
+			func(filemon.Events) {
+				r.changeSet.AddRetest(id)
+				r.changeSet.needsRedeploy = true
+			},
+		); err != nil {
+			event.DevLoopFailedWithErrorCode(r.devIteration, proto.StatusCode_DEVINIT_REGISTER_TEST_DEPS, err)
+			return fmt.Errorf("watching files for test %q: %w", t.Name, err)
+		}
 	}
 
 	// Watch deployment configuration
@@ -250,6 +283,14 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		event.DevLoopFailedInPhase(r.devIteration, sErrors.Deploy, err)
 		return fmt.Errorf("exiting dev mode because first deploy failed: %w", err)
 	}
+	if err := r.verifyDeployed(ctx, out); err != nil {
+		event.DevLoopFailedInPhase(r.devIteration, sErrors.Verify, err)
+		return fmt.Errorf("exiting dev mode because verify checks failed after first deploy: %w", err)
+	}
+	if err := r.testDeployed(ctx, out, r.builds); err != nil {
+		event.DevLoopFailedInPhase(r.devIteration, sErrors.Test, err)
+		return fmt.Errorf("exiting dev mode because tests failed after first deploy: %w", err)
+	}
 
 	forwarderManager := r.createForwarder(out)
 	defer forwarderManager.Stop()
@@ -273,6 +314,30 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	})
 }
 
+// retest reruns the tests of the artifacts that were just rebuilt, in the order they were built,
+// and then those whose dependencies have changed.
+func (r *SkaffoldRunner) retest(ctx context.Context, out io.Writer, bRes []build.Artifact) error {
+	tests := r.tester.Tests()
+	selected := map[int]bool{}
+	var ids []int
+	for _, b := range bRes {
+		for _, t := range tests {
+			if t.Image == b.ImageName && !selected[t.ID] {
+				selected[t.ID] = true
+				ids = append(ids, t.ID)
+			}
+		}
+	}
+	for _, id := range r.changeSet.needsRetest {
+		if !selected[id] {
+			selected[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return r.tester.TestSelected(ctx, out, r.builds, ids)
+}
+
 // graph represents the artifact graph
 type graph map[string][]*latest.Artifact
 
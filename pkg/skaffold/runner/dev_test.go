@@ -78,8 +78,12 @@ func (t *TestMonitor) Run(bool) error {
 			t.callbacks[0](evt) // 1st artifact changed
 		case "file2":
 			t.callbacks[1](evt) // 2nd artifact changed
+		case "test1.yaml":
+			t.callbacks[2](evt) // 1st artifact's test changed
+		case "test2.yaml":
+			t.callbacks[3](evt) // 2nd artifact's test changed
 		case "manifest.yaml":
-			t.callbacks[3](evt) // deployment configuration changed
+			t.callbacks[len(t.callbacks)-2](evt) // deployment configuration changed
 		}
 	}
 
@@ -122,6 +126,16 @@ func TestDevFailFirstCycle(t *testing.T) {
 				Tested: []string{"img:1"},
 			}},
 		},
+		{
+			description: "fails to test the deployed application the first time",
+			testBench:   &TestBench{deployedTestErrors: []error{errors.New("")}},
+			monitor:     &NoopMonitor{},
+			expectedActions: []Actions{{
+				Built:    []string{"img:1"},
+				Tested:   []string{"img:1"},
+				Deployed: []string{"img:1"},
+			}},
+		},
 		{
 			description: "fails to watch after first cycle",
 			testBench:   &TestBench{},
@@ -207,6 +221,31 @@ func TestDev(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "ignore subsequent errors testing the deployed application",
+			testBench:   &TestBench{deployedTestErrors: []error{nil, errors.New("")}},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"file1", "file2"}},
+				{Modified: []string{"file1", "file2"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Built:    []string{"img1:2", "img2:2"},
+					Tested:   []string{"img1:2", "img2:2"},
+					Deployed: []string{"img1:2", "img2:2"},
+				},
+				{
+					Built:    []string{"img1:3", "img2:3"},
+					Tested:   []string{"img1:3", "img2:3"},
+					Deployed: []string{"img1:3", "img2:3"},
+				},
+			},
+		},
 		{
 			description: "full cycle twice",
 			testBench:   &TestBench{},
@@ -262,6 +301,64 @@ func TestDev(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "only rerun changed test, then redeploy",
+			testBench:   &TestBench{},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"test2.yaml"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Tested:   []string{"img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+			},
+		},
+		{
+			description: "rerun changed test along with rebuilt artifact",
+			testBench:   &TestBench{},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"file2", "test1.yaml"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{
+					Built:    []string{"img2:2"},
+					Tested:   []string{"img2:2", "img1:1"},
+					Deployed: []string{"img1:1", "img2:2"},
+				},
+			},
+		},
+		{
+			description: "ignore errors of rerun test",
+			testBench:   &TestBench{testErrors: []error{nil, errors.New("")}},
+			watchEvents: []filemon.Events{
+				{Modified: []string{"test1.yaml"}},
+				{Modified: []string{"file1"}},
+			},
+			expectedActions: []Actions{
+				{
+					Built:    []string{"img1:1", "img2:1"},
+					Tested:   []string{"img1:1", "img2:1"},
+					Deployed: []string{"img1:1", "img2:1"},
+				},
+				{},
+				{
+					Built:    []string{"img1:2"},
+					Tested:   []string{"img1:2"},
+					Deployed: []string{"img1:2", "img2:1"},
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -20,7 +20,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -28,14 +31,24 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
 
+// For testing
+var (
+	saveImageArchive       = docker.SaveImageArchive
+	saveDaemonImageArchive = docker.SaveDaemonImageArchive
+)
+
 // loadImagesInKindNodes loads artifact images into every node of a kind cluster.
 func (r *SkaffoldRunner) loadImagesInKindNodes(ctx context.Context, out io.Writer, kindCluster string, artifacts []build.Artifact) error {
 	color.Default.Fprintln(out, "Loading images into kind cluster nodes...")
-	return r.loadImages(ctx, out, artifacts, func(tag string) *exec.Cmd {
+	return r.loadImages(ctx, out, artifacts, func(tag, archive string) *exec.Cmd {
+		if archive != "" {
+			return exec.CommandContext(ctx, "kind", "load", "image-archive", "--name", kindCluster, archive)
+		}
 		return exec.CommandContext(ctx, "kind", "load", "docker-image", "--name", kindCluster, tag)
 	})
 }
@@ -43,12 +56,17 @@ func (r *SkaffoldRunner) loadImagesInKindNodes(ctx context.Context, out io.Write
 // loadImagesInK3dNodes loads artifact images into every node of a k3s cluster.
 func (r *SkaffoldRunner) loadImagesInK3dNodes(ctx context.Context, out io.Writer, k3dCluster string, artifacts []build.Artifact) error {
 	color.Default.Fprintln(out, "Loading images into k3d cluster nodes...")
-	return r.loadImages(ctx, out, artifacts, func(tag string) *exec.Cmd {
+	return r.loadImages(ctx, out, artifacts, func(tag, archive string) *exec.Cmd {
+		if archive != "" {
+			return exec.CommandContext(ctx, "k3d", "image", "import", "--cluster", k3dCluster, archive)
+		}
 		return exec.CommandContext(ctx, "k3d", "image", "import", "--cluster", k3dCluster, tag)
 	})
 }
 
-func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifacts []build.Artifact, createCmd func(tag string) *exec.Cmd) error {
+// loadImages loads the built images into the cluster. Images built without a Docker daemon
+// are first saved to an image tarball, passed to createCmd as archive.
+func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifacts []build.Artifact, createCmd func(tag, archive string) *exec.Cmd) error {
 	start := time.Now()
 
 	var knownImages []string
@@ -77,10 +95,9 @@ func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifact
 			continue
 		}
 
-		cmd := createCmd(artifact.Tag)
-		if output, err := util.RunCmdOut(cmd); err != nil {
+		if err := r.loadImage(ctx, artifact, createCmd); err != nil {
 			color.Red.Fprintln(out, "Failed")
-			return fmt.Errorf("unable to load image %q into cluster: %w, %s", artifact.Tag, err, output)
+			return err
 		}
 
 		color.Green.Fprintln(out, "Loaded")
@@ -90,6 +107,43 @@ func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, artifact
 	return nil
 }
 
+func (r *SkaffoldRunner) loadImage(ctx context.Context, artifact build.Artifact, createCmd func(tag, archive string) *exec.Cmd) error {
+	var save func(archive string) error
+	switch {
+	case r.builtWithoutDaemon(artifact.ImageName):
+		save = func(archive string) error { return saveImageArchive(r.runCtx, artifact.Tag, archive) }
+	case r.runCtx.GetDockerDaemon() != nil:
+		// kind and k3d read images from the local Docker daemon, so the images of a remote daemon are loaded from an archive.
+		save = func(archive string) error { return saveDaemonImageArchive(ctx, r.runCtx, artifact.Tag, archive) }
+	}
+
+	var archive string
+	if save != nil {
+		dir, err := ioutil.TempDir("", "skaffold-images")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+
+		archive = filepath.Join(dir, "image.tar")
+		if err := save(archive); err != nil {
+			return fmt.Errorf("unable to save image %q: %w", artifact.Tag, err)
+		}
+	}
+
+	cmd := createCmd(artifact.Tag, archive)
+	if output, err := util.RunCmdOut(cmd); err != nil {
+		return fmt.Errorf("unable to load image %q into cluster: %w, %s", artifact.Tag, err, output)
+	}
+	return nil
+}
+
+// builtWithoutDaemon checks if an image is built by a daemonless local builder.
+func (r *SkaffoldRunner) builtWithoutDaemon(imageName string) bool {
+	p, found := r.runCtx.PipelineForImage(imageName)
+	return found && p.Build.LocalBuild != nil && p.Build.LocalBuild.Daemonless
+}
+
 func findKnownImages(ctx context.Context, cli *kubectl.CLI) ([]string, error) {
 	nodeGetOut, err := cli.RunOut(ctx, "get", "nodes", `-ojsonpath='{@.items[*].status.images[*].names[*]}'`)
 	if err != nil {
@@ -24,8 +24,10 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -36,6 +38,8 @@ type ImageLoadingTest = struct {
 	built         []build.Artifact
 	deployed      []build.Artifact
 	commands      util.Command
+	daemonless    bool
+	remoteDaemon  bool
 	shouldErr     bool
 	expectedError string
 }
@@ -88,6 +92,28 @@ func TestLoadImagesInKindNodes(t *testing.T) {
 			shouldErr:     true,
 			expectedError: "output: error!",
 		},
+		{
+			description: "save error for image built without daemon",
+			cluster:     "kind",
+			built:       []build.Artifact{{ImageName: "image", Tag: "tag"}},
+			deployed:    []build.Artifact{{ImageName: "image", Tag: "tag"}},
+			daemonless:  true,
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", ""),
+			shouldErr:     true,
+			expectedError: `unable to save image "tag"`,
+		},
+		{
+			description:  "save error for image built by a remote daemon",
+			cluster:      "kind",
+			built:        []build.Artifact{{ImageName: "image", Tag: "tag"}},
+			deployed:     []build.Artifact{{ImageName: "image", Tag: "tag"}},
+			remoteDaemon: true,
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", ""),
+			shouldErr:     true,
+			expectedError: `unable to save image "tag": no such remote image`,
+		},
 		{
 			description: "ignore image that's not built",
 			cluster:     "kind",
@@ -190,6 +216,8 @@ func runImageLoadingTests(t *testing.T, tests []ImageLoadingTest, loadingFunc fu
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&saveImageArchive, func(docker.Config, string, string) error { return errors.New("no such image") })
+			t.Override(&saveDaemonImageArchive, func(context.Context, docker.Config, string, string) error { return errors.New("no such remote image") })
 
 			runCtx := &runcontext.RunContext{
 				Opts: config.SkaffoldOptions{
@@ -197,6 +225,17 @@ func runImageLoadingTests(t *testing.T, tests []ImageLoadingTest, loadingFunc fu
 				},
 				KubeContext: "kubecontext",
 			}
+			if test.daemonless {
+				runCtx.Pipelines = runcontext.NewPipelines([]latest.Pipeline{{
+					Build: latest.BuildConfig{
+						Artifacts: []*latest.Artifact{{ImageName: "image"}},
+						BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{Daemonless: true}},
+					},
+				}})
+			}
+			if test.remoteDaemon {
+				runCtx.DockerDaemon = &latest.DockerDaemon{Host: "ssh://user@build-machine"}
+			}
 
 			r := &SkaffoldRunner{
 				runCtx:     runCtx,
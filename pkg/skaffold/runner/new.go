@@ -27,6 +27,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cluster"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/gcb"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/local"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/sbom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/helm"
@@ -34,6 +35,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
@@ -41,6 +43,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/server"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sign"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trigger"
@@ -52,15 +55,18 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	event.LogMetaEvent()
 	kubectlCLI := pkgkubectl.NewCLI(runCtx, "")
 
-	tagger, err := tag.NewTaggerMux(runCtx)
+	store := build.NewArtifactStore()
+	outputs, err := cache.NewOutputStore(runCtx)
 	if err != nil {
-		return nil, fmt.Errorf("creating tagger: %w", err)
+		return nil, fmt.Errorf("initializing file outputs: %w", err)
+	}
+	timingsFile, err := build.BuildTimingsFile(runCtx.CacheFile())
+	if err != nil {
+		logrus.Warnf("Error resolving build timings file, builds won't be prioritised: %v", err)
 	}
-
-	store := build.NewArtifactStore()
 	var builder build.Builder
-	builder, err = build.NewBuilderMux(runCtx, store, func(p latest.Pipeline) (build.PipelineBuilder, error) {
-		return getBuilder(runCtx, store, p)
+	builder, err = build.NewBuilderMux(runCtx, store, build.NewBuildTimings(timingsFile), func(p latest.Pipeline) (build.PipelineBuilder, error) {
+		return getBuilder(runCtx, store, outputs, p)
 	})
 	if err != nil {
 		return nil, fmt.Errorf("creating builder: %w", err)
@@ -79,8 +85,11 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating deployer: %w", err)
 	}
+	buildDepLister := func(ctx context.Context, artifact *latest.Artifact) ([]string, error) {
+		return build.DependenciesForArtifact(ctx, artifact, runCtx, store, outputs)
+	}
 	depLister := func(ctx context.Context, artifact *latest.Artifact) ([]string, error) {
-		buildDependencies, err := build.DependenciesForArtifact(ctx, artifact, runCtx, store)
+		buildDependencies, err := buildDepLister(ctx, artifact)
 		if err != nil {
 			return nil, err
 		}
@@ -94,7 +103,19 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	}
 
 	graph := build.ToArtifactGraph(runCtx.Artifacts())
-	artifactCache, err := cache.NewCache(runCtx, isLocalImage, depLister, graph, store)
+	hasher := cache.NewArtifactHasher(runCtx, depLister, graph)
+	tagger, err := tag.NewTaggerMux(runCtx, func(imageName string) (string, error) {
+		a, found := graph[imageName]
+		if !found {
+			return "", fmt.Errorf("unknown artifact %q", imageName)
+		}
+		return hasher.Hash(context.Background(), a)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating tagger: %w", err)
+	}
+
+	artifactCache, err := cache.NewCache(runCtx, isLocalImage, hasher, store)
 	if err != nil {
 		return nil, fmt.Errorf("initializing cache: %w", err)
 	}
@@ -128,6 +149,11 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		labeller:      labeller,
 		podSelector:   kubernetes.NewImageList(),
 		cache:         artifactCache,
+		hasher:        hasher,
+		outputs:       outputs,
+		sbom:          sbom.NewGenerator(runCtx, buildDepLister, isLocalImage),
+		signer:        sign.NewSigner(runCtx),
+		verifier:      sign.NewVerifier(runCtx),
 		runCtx:        runCtx,
 		intents:       intents,
 		isLocalImage:  isLocalImage,
@@ -189,7 +215,7 @@ func isImageLocal(runCtx *runcontext.RunContext, imageName string) (bool, error)
 }
 
 // getBuilder creates a builder from a given RunContext and build pipeline type.
-func getBuilder(runCtx *runcontext.RunContext, store build.ArtifactStore, p latest.Pipeline) (build.PipelineBuilder, error) {
+func getBuilder(runCtx *runcontext.RunContext, store build.ArtifactStore, outputs docker.OutputResolver, p latest.Pipeline) (build.PipelineBuilder, error) {
 	switch {
 	case p.Build.LocalBuild != nil:
 		logrus.Debugln("Using builder: local")
@@ -198,6 +224,7 @@ func getBuilder(runCtx *runcontext.RunContext, store build.ArtifactStore, p late
 			return nil, err
 		}
 		builder.ArtifactStore(store)
+		builder.OutputResolver(outputs)
 		return builder, nil
 
 	case p.Build.GoogleCloudBuild != nil:
@@ -213,6 +240,7 @@ func getBuilder(runCtx *runcontext.RunContext, store build.ArtifactStore, p late
 			return nil, err
 		}
 		builder.ArtifactStore(store)
+		builder.OutputResolver(outputs)
 		return builder, err
 
 	default:
@@ -19,9 +19,11 @@ package runcontext
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 	runnerutil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/util"
@@ -41,6 +43,8 @@ type RunContext struct {
 	WorkingDir         string
 	InsecureRegistries map[string]bool
 	Cluster            config.Cluster
+	// DockerDaemon is the remote Docker daemon used by the local builder, if any.
+	DockerDaemon *latest.DockerDaemon
 }
 
 // Pipelines encapsulates multiple config pipelines
@@ -73,6 +77,14 @@ func (ps Pipelines) PortForwardResources() []*latest.PortForwardResource {
 	return pf
 }
 
+func (ps Pipelines) VerifyChecks() []*latest.VerifyCheck {
+	var checks []*latest.VerifyCheck
+	for _, p := range ps.pipelines {
+		checks = append(checks, p.Verify...)
+	}
+	return checks
+}
+
 func (ps Pipelines) Artifacts() []*latest.Artifact {
 	var artifacts []*latest.Artifact
 	for _, p := range ps.pipelines {
@@ -107,14 +119,35 @@ func (ps Pipelines) StatusCheckDeadlineSeconds() int {
 	}
 	return c
 }
+
+func (ps Pipelines) ReadinessRules() []latest.ReadinessRule {
+	var rules []latest.ReadinessRule
+	for _, p := range ps.pipelines {
+		if p.Deploy.StatusCheck != nil {
+			rules = append(rules, p.Deploy.StatusCheck.ReadinessRules...)
+		}
+	}
+	return rules
+}
+
+// NewPipelines creates the Pipelines for the given pipeline configs, where the artifacts that have variants
+// are replaced by their variants. The pipeline of an artifact can be selected by the image name of the artifact
+// or of any of its variants.
 func NewPipelines(pipelines []latest.Pipeline) Pipelines {
 	m := make(map[string]latest.Pipeline)
-	for _, p := range pipelines {
-		for _, a := range p.Build.Artifacts {
+	expanded := make([]latest.Pipeline, len(pipelines))
+	for i, p := range pipelines {
+		p.Build.Artifacts = misc.ExpandVariants(p.Build.Artifacts)
+		expanded[i] = p
+		// Artifacts with variants stay addressable by their own image name, besides the image names of their variants.
+		for _, a := range pipelines[i].Build.Artifacts {
 			m[a.ImageName] = p
+			for _, v := range a.Variants {
+				m[misc.VariantImageName(a, v)] = p
+			}
 		}
 	}
-	return Pipelines{pipelines: pipelines, pipelinesByImageName: m}
+	return Pipelines{pipelines: expanded, pipelinesByImageName: m}
 }
 
 func (rc *RunContext) PipelineForImage(imageName string) (latest.Pipeline, bool) {
@@ -125,6 +158,10 @@ func (rc *RunContext) PortForwardResources() []*latest.PortForwardResource {
 	return rc.Pipelines.PortForwardResources()
 }
 
+func (rc *RunContext) VerifyChecks() []*latest.VerifyCheck {
+	return rc.Pipelines.VerifyChecks()
+}
+
 func (rc *RunContext) Artifacts() []*latest.Artifact { return rc.Pipelines.Artifacts() }
 
 func (rc *RunContext) Deployers() []latest.DeployType { return rc.Pipelines.Deployers() }
@@ -135,6 +172,10 @@ func (rc *RunContext) StatusCheckDeadlineSeconds() int {
 	return rc.Pipelines.StatusCheckDeadlineSeconds()
 }
 
+func (rc *RunContext) ReadinessRules() []latest.ReadinessRule {
+	return rc.Pipelines.ReadinessRules()
+}
+
 func (rc *RunContext) DefaultPipeline() latest.Pipeline          { return rc.Pipelines.Head() }
 func (rc *RunContext) GetKubeContext() string                    { return rc.KubeContext }
 func (rc *RunContext) GetNamespaces() []string                   { return rc.Namespaces }
@@ -142,12 +183,14 @@ func (rc *RunContext) GetPipelines() []latest.Pipeline           { return rc.Pip
 func (rc *RunContext) GetInsecureRegistries() map[string]bool    { return rc.InsecureRegistries }
 func (rc *RunContext) GetWorkingDir() string                     { return rc.WorkingDir }
 func (rc *RunContext) GetCluster() config.Cluster                { return rc.Cluster }
+func (rc *RunContext) GetDockerDaemon() *latest.DockerDaemon     { return rc.DockerDaemon }
 func (rc *RunContext) AddSkaffoldLabels() bool                   { return rc.Opts.AddSkaffoldLabels }
 func (rc *RunContext) AutoBuild() bool                           { return rc.Opts.AutoBuild }
 func (rc *RunContext) AutoDeploy() bool                          { return rc.Opts.AutoDeploy }
 func (rc *RunContext) AutoSync() bool                            { return rc.Opts.AutoSync }
 func (rc *RunContext) CacheArtifacts() bool                      { return rc.Opts.CacheArtifacts }
 func (rc *RunContext) CacheFile() string                         { return rc.Opts.CacheFile }
+func (rc *RunContext) CacheURL() string                          { return rc.Opts.CacheURL }
 func (rc *RunContext) ConfigurationFile() string                 { return rc.Opts.ConfigurationFile }
 func (rc *RunContext) CustomLabels() []string                    { return rc.Opts.CustomLabels }
 func (rc *RunContext) CustomTag() string                         { return rc.Opts.CustomTag }
@@ -171,10 +214,20 @@ func (rc *RunContext) SkipRender() bool                          { return rc.Opt
 func (rc *RunContext) SkipTests() bool                           { return rc.Opts.SkipTests }
 func (rc *RunContext) StatusCheck() bool                         { return rc.Opts.StatusCheck }
 func (rc *RunContext) Tail() bool                                { return rc.Opts.Tail }
+func (rc *RunContext) TestReportDir() string                     { return rc.Opts.TestReportDir }
+func (rc *RunContext) TestFilter() string                        { return rc.Opts.TestFilter }
 func (rc *RunContext) Trigger() string                           { return rc.Opts.Trigger }
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions { return rc.Opts.WaitForDeletions }
 func (rc *RunContext) WatchPollInterval() int                    { return rc.Opts.WatchPollInterval }
 
+// SBOMOutputDir is the directory where the SBOM documents are written: next to the `--file-output` file, if any.
+func (rc *RunContext) SBOMOutputDir() string {
+	if rc.Opts.BuildOutputFile == "" {
+		return ""
+	}
+	return filepath.Dir(rc.Opts.BuildOutputFile)
+}
+
 func GetRunContext(opts config.SkaffoldOptions, pipelines []latest.Pipeline) (*RunContext, error) {
 	kubeConfig, err := kubectx.CurrentConfig()
 	if err != nil {
@@ -211,6 +264,13 @@ func GetRunContext(opts config.SkaffoldOptions, pipelines []latest.Pipeline) (*R
 	}
 	ps := NewPipelines(pipelines)
 
+	// `--build-image <image>` selects all the variants of an artifact.
+	var artifacts []*latest.Artifact
+	for _, p := range pipelines {
+		artifacts = append(artifacts, p.Build.Artifacts...)
+	}
+	opts.TargetImages = misc.VariantTargets(opts.TargetImages, artifacts)
+
 	// TODO(https://github.com/GoogleContainerTools/skaffold/issues/3668):
 	// remove minikubeProfile from here and instead detect it by matching the
 	// kubecontext API Server to minikube profiles
@@ -219,6 +279,9 @@ func GetRunContext(opts config.SkaffoldOptions, pipelines []latest.Pipeline) (*R
 		return nil, fmt.Errorf("getting cluster: %w", err)
 	}
 
+	dockerDaemon := getDockerDaemon(opts.GlobalConfig, pipelines)
+	cluster.PushImages = pushImages(cluster, dockerDaemon, pipelines)
+
 	return &RunContext{
 		Opts:               opts,
 		Pipelines:          ps,
@@ -227,9 +290,49 @@ func GetRunContext(opts config.SkaffoldOptions, pipelines []latest.Pipeline) (*R
 		Namespaces:         namespaces,
 		InsecureRegistries: insecureRegistries,
 		Cluster:            cluster,
+		DockerDaemon:       dockerDaemon,
 	}, nil
 }
 
+// pushImages returns whether the images should be pushed by default for the cluster to see them.
+// A local cluster can't see the images of a remote daemon or of a daemonless build, unless they are loaded into it.
+func pushImages(cluster config.Cluster, dockerDaemon *latest.DockerDaemon, pipelines []latest.Pipeline) bool {
+	if cluster.PushImages || cluster.LoadImages {
+		return cluster.PushImages
+	}
+	if dockerDaemon != nil {
+		logrus.Debugf("pushing images built by the remote docker daemon at %s", dockerDaemon.Host)
+		return true
+	}
+	for _, p := range pipelines {
+		if p.Build.LocalBuild != nil && p.Build.LocalBuild.Daemonless {
+			logrus.Debugln("pushing images built without a docker daemon since the local cluster can't read the image layout")
+			return true
+		}
+	}
+	return false
+}
+
+// getDockerDaemon returns the remote Docker daemon set for the local builder in skaffold.yaml,
+// or else for the current kube-context in the global config.
+func getDockerDaemon(configFile string, pipelines []latest.Pipeline) *latest.DockerDaemon {
+	for _, p := range pipelines {
+		if p.Build.LocalBuild != nil && p.Build.LocalBuild.Daemon != nil {
+			return p.Build.LocalBuild.Daemon
+		}
+	}
+
+	host, certPath, err := config.GetDockerDaemon(configFile)
+	if err != nil {
+		logrus.Warnf("error retrieving docker-host from global config, using the default Docker daemon: %v", err)
+		return nil
+	}
+	if host == "" {
+		return nil
+	}
+	return &latest.DockerDaemon{Host: host, CertPath: certPath}
+}
+
 func (rc *RunContext) UpdateNamespaces(ns []string) {
 	if len(ns) == 0 {
 		return
@@ -19,6 +19,8 @@ package runcontext
 import (
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -89,3 +91,127 @@ func TestRunContext_UpdateNamespaces(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDockerDaemon(t *testing.T) {
+	tests := []struct {
+		description string
+		pipelines   []latest.Pipeline
+		globalHost  string
+		expected    *latest.DockerDaemon
+	}{
+		{
+			description: "default daemon",
+			pipelines:   []latest.Pipeline{{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}}},
+		},
+		{
+			description: "daemon from global config",
+			pipelines:   []latest.Pipeline{{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}}},
+			globalHost:  "ssh://user@global-machine",
+			expected:    &latest.DockerDaemon{Host: "ssh://user@global-machine"},
+		},
+		{
+			description: "skaffold.yaml takes precedence",
+			pipelines: []latest.Pipeline{
+				{Build: latest.BuildConfig{BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{}}}},
+				{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{Daemon: &latest.DockerDaemon{Host: "ssh://user@build-machine"}}}}},
+			},
+			globalHost: "ssh://user@global-machine",
+			expected:   &latest.DockerDaemon{Host: "ssh://user@build-machine"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&config.GetConfigForCurrentKubectx, func(string) (*config.ContextConfig, error) {
+				return &config.ContextConfig{DockerHost: test.globalHost}, nil
+			})
+
+			t.CheckDeepEqual(test.expected, getDockerDaemon("config", test.pipelines))
+		})
+	}
+}
+
+func TestPushImages(t *testing.T) {
+	local := []latest.Pipeline{{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}}}
+	daemonless := []latest.Pipeline{{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{Daemonless: true}}}}}
+
+	tests := []struct {
+		description  string
+		cluster      config.Cluster
+		dockerDaemon *latest.DockerDaemon
+		pipelines    []latest.Pipeline
+		expected     bool
+	}{
+		{
+			description: "local cluster",
+			cluster:     config.Cluster{Local: true},
+			pipelines:   local,
+		},
+		{
+			description: "remote cluster",
+			cluster:     config.Cluster{PushImages: true},
+			pipelines:   local,
+			expected:    true,
+		},
+		{
+			description:  "local cluster with a remote docker daemon",
+			cluster:      config.Cluster{Local: true},
+			dockerDaemon: &latest.DockerDaemon{Host: "ssh://user@build-machine"},
+			pipelines:    local,
+			expected:     true,
+		},
+		{
+			description: "local cluster with a daemonless build",
+			cluster:     config.Cluster{Local: true},
+			pipelines:   daemonless,
+			expected:    true,
+		},
+		{
+			description: "images loaded into kind or k3d",
+			cluster:     config.Cluster{Local: true, LoadImages: true},
+			pipelines:   daemonless,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, pushImages(test.cluster, test.dockerDaemon, test.pipelines))
+		})
+	}
+}
+
+func TestPipelinesWithVariants(t *testing.T) {
+	pipelines := NewPipelines([]latest.Pipeline{
+		{
+			Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{
+				ImageName:    "app",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				Variants:     []*latest.ArtifactVariant{{Name: "debug"}, {Name: "release", ImageName: "app"}},
+			}}},
+			Deploy: latest.DeployConfig{StatusCheckDeadlineSeconds: 1},
+		},
+		{
+			Build:  latest.BuildConfig{Artifacts: []*latest.Artifact{{ImageName: "other"}}},
+			Deploy: latest.DeployConfig{StatusCheckDeadlineSeconds: 2},
+		},
+		{
+			Build: latest.BuildConfig{Artifacts: []*latest.Artifact{{
+				ImageName:    "lib",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				Variants:     []*latest.ArtifactVariant{{Name: "slim"}},
+			}}},
+			Deploy: latest.DeployConfig{StatusCheckDeadlineSeconds: 3},
+		},
+	})
+	rc := &RunContext{Pipelines: pipelines}
+
+	var imageNames []string
+	for _, a := range rc.Artifacts() {
+		imageNames = append(imageNames, a.ImageName)
+	}
+	testutil.CheckDeepEqual(t, []string{"app-debug", "app", "other", "lib-slim"}, imageNames)
+
+	for image, deadline := range map[string]int{"app": 1, "app-debug": 1, "other": 2, "lib": 3, "lib-slim": 3} {
+		p, found := rc.PipelineForImage(image)
+		testutil.CheckDeepEqual(t, true, found)
+		testutil.CheckDeepEqual(t, deadline, p.Deploy.StatusCheckDeadlineSeconds)
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cache"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/sbom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
@@ -31,8 +32,10 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sign"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/verify"
 )
 
 const (
@@ -68,6 +71,11 @@ type SkaffoldRunner struct {
 
 	kubectlCLI    *kubectl.CLI
 	cache         cache.Cache
+	hasher        *cache.ArtifactHasher
+	outputs       *cache.OutputStore
+	sbom          sbom.Generator
+	signer        sign.Signer
+	verifier      sign.Verifier
 	changeSet     changeSet
 	runCtx        *runcontext.RunContext
 	labeller      *label.DefaultLabeller
@@ -86,6 +94,7 @@ type SkaffoldRunner struct {
 // for testing
 var (
 	newStatusCheck = status.NewStatusChecker
+	newVerifyCheck = verify.NewChecker
 )
 
 // HasDeployed returns true if this runner has deployed something.
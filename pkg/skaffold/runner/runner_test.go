@@ -32,6 +32,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kustomize"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/manifest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/defaults"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -49,11 +50,13 @@ type Actions struct {
 }
 
 type TestBench struct {
-	buildErrors  []error
-	syncErrors   []error
-	testErrors   []error
-	deployErrors []error
-	namespaces   []string
+	buildErrors        []error
+	syncErrors         []error
+	testErrors         []error
+	deployedTestErrors []error
+	deployErrors       []error
+	namespaces         []string
+	tests              []test.Info
 
 	devLoop        func(context.Context, io.Writer, func() error) error
 	firstMonitor   func(bool) error
@@ -96,6 +99,7 @@ func (t *TestBench) WithTestErrors(testErrors []error) *TestBench {
 func (t *TestBench) TestDependencies() ([]string, error)              { return nil, nil }
 func (t *TestBench) Dependencies() ([]string, error)                  { return nil, nil }
 func (t *TestBench) Cleanup(ctx context.Context, out io.Writer) error { return nil }
+func (t *TestBench) DeployedManifests() manifest.ManifestList         { return nil }
 func (t *TestBench) Prune(ctx context.Context, out io.Writer) error   { return nil }
 
 func (t *TestBench) enterNewCycle() {
@@ -152,6 +156,46 @@ func (t *TestBench) Test(_ context.Context, _ io.Writer, artifacts []build.Artif
 	return nil
 }
 
+func (t *TestBench) Tests() []test.Info { return t.tests }
+
+func (t *TestBench) TestSelected(_ context.Context, _ io.Writer, artifacts []build.Artifact, ids []int) error {
+	if len(t.testErrors) > 0 {
+		err := t.testErrors[0]
+		t.testErrors = t.testErrors[1:]
+		if err != nil {
+			return err
+		}
+	}
+
+	// Tests are run in the order of their IDs.
+	tested := []build.Artifact{}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		image := t.tests[id].Image
+		if seen[image] {
+			continue
+		}
+		seen[image] = true
+		for _, artifact := range artifacts {
+			if artifact.ImageName == image {
+				tested = append(tested, artifact)
+			}
+		}
+	}
+
+	t.currentActions.Tested = findTags(tested)
+	return nil
+}
+
+func (t *TestBench) TestDeployed(context.Context, io.Writer, []build.Artifact) error {
+	if len(t.deployedTestErrors) > 0 {
+		err := t.deployedTestErrors[0]
+		t.deployedTestErrors = t.deployedTestErrors[1:]
+		return err
+	}
+	return nil
+}
+
 func (t *TestBench) Deploy(_ context.Context, _ io.Writer, artifacts []build.Artifact) ([]string, error) {
 	if len(t.deployErrors) > 0 {
 		err := t.deployErrors[0]
@@ -231,6 +275,18 @@ func createRunner(t *testutil.T, testBench *TestBench, monitor filemon.Monitor,
 	runner, err := NewForConfig(runCtx)
 	t.CheckNoError(err)
 
+	// By default, each artifact has a single test.
+	if testBench.tests == nil {
+		for i, a := range artifacts {
+			testBench.tests = append(testBench.tests, test.Info{
+				ID:           i,
+				Name:         a.ImageName + "-test.yaml",
+				Image:        a.ImageName,
+				Dependencies: func() ([]string, error) { return nil, nil },
+			})
+		}
+	}
+
 	runner.builder = testBench
 	runner.syncer = testBench
 	runner.tester = testBench
@@ -23,7 +23,10 @@ import (
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildkit"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/sbom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -37,12 +40,15 @@ const (
 	defaultCloudBuildGradleImage = "gcr.io/cloud-builders/gradle"
 	defaultCloudBuildKanikoImage = kaniko.DefaultImage
 	defaultCloudBuildPackImage   = "gcr.io/k8s-skaffold/pack"
+	defaultClusterMavenImage     = "maven:3-openjdk-11"
+	defaultClusterGradleImage    = "gradle:jdk11"
 )
 
 // Set makes sure default values are set on a SkaffoldConfig.
 func Set(c *latest.SkaffoldConfig) error {
 	defaultToLocalBuild(c)
 	setDefaultTagger(c)
+	setDefaultSBOMFormat(c)
 	setDefaultKustomizePath(c)
 	setDefaultLogsConfig(c)
 
@@ -50,11 +56,12 @@ func Set(c *latest.SkaffoldConfig) error {
 		setDefaultWorkspace(a)
 		setDefaultSync(a)
 
-		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil {
+		if c.Build.Cluster != nil && c.Build.Cluster.BuildKit == nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil && a.JibArtifact == nil {
 			defaultToKanikoArtifact(a)
 		} else {
 			defaultToDockerArtifact(a)
 		}
+		setDefaultPlatforms(c.Build, a)
 
 		switch {
 		case a.DockerArtifact != nil:
@@ -68,11 +75,22 @@ func Set(c *latest.SkaffoldConfig) error {
 
 		case a.BuildpackArtifact != nil:
 			setBuildpackArtifactDefaults(a.BuildpackArtifact)
+
+		case a.KoArtifact != nil:
+			setKoArtifactDefaults(a.KoArtifact)
 		}
 
 		for _, d := range a.Dependencies {
 			setDefaultArtifactDependencyAlias(d)
 		}
+
+		for _, d := range a.OutputDependencies {
+			setDefaultOutputDependencyPath(d)
+		}
+	}
+
+	for _, o := range c.Build.Outputs {
+		setDefaultOutputWorkspace(o)
 	}
 
 	withLocalBuild(c,
@@ -92,6 +110,8 @@ func Set(c *latest.SkaffoldConfig) error {
 		setDefaultClusterTimeout,
 		setDefaultClusterPullSecret,
 		setDefaultClusterDockerConfigSecret,
+		setDefaultClusterBuildKitImage,
+		setDefaultClusterJibImages,
 	); err != nil {
 		return err
 	}
@@ -181,6 +201,12 @@ func setDefaultTagger(c *latest.SkaffoldConfig) {
 	c.Build.TagPolicy = latest.TagPolicy{GitTagger: &latest.GitTagger{}}
 }
 
+func setDefaultSBOMFormat(c *latest.SkaffoldConfig) {
+	if c.Build.SBOM != nil {
+		c.Build.SBOM.Format = valueOrDefault(c.Build.SBOM.Format, sbom.SPDX)
+	}
+}
+
 func setDefaultKustomizePath(c *latest.SkaffoldConfig) {
 	kustomize := c.Deploy.KustomizeDeploy
 	if kustomize == nil {
@@ -230,6 +256,11 @@ func setBuildpackArtifactDefaults(a *latest.BuildpackArtifact) {
 	}
 }
 
+func setKoArtifactDefaults(a *latest.KoArtifact) {
+	a.BaseImage = valueOrDefault(a.BaseImage, constants.DefaultKoBaseImage)
+	a.Main = valueOrDefault(a.Main, ".")
+}
+
 func setDockerArtifactDefaults(a *latest.DockerArtifact) {
 	a.DockerfilePath = valueOrDefault(a.DockerfilePath, constants.DefaultDockerfilePath)
 }
@@ -238,6 +269,14 @@ func setDefaultWorkspace(a *latest.Artifact) {
 	a.Workspace = valueOrDefault(a.Workspace, ".")
 }
 
+// setDefaultPlatforms applies the target platforms of the build configuration to the artifacts
+// that don't set their own, when their builder supports them.
+func setDefaultPlatforms(bc latest.BuildConfig, a *latest.Artifact) {
+	if len(a.Platforms) == 0 && len(bc.Platforms) > 0 && misc.SupportsPlatforms(bc, a, len(bc.Platforms)) {
+		a.Platforms = bc.Platforms
+	}
+}
+
 func setDefaultSync(a *latest.Artifact) {
 	if a.Sync != nil {
 		if len(a.Sync.Manual) == 0 && len(a.Sync.Infer) == 0 && a.Sync.Auto == nil {
@@ -327,6 +366,19 @@ func setDefaultClusterDockerConfigSecret(cluster *latest.ClusterDetails) error {
 	return nil
 }
 
+func setDefaultClusterBuildKitImage(cluster *latest.ClusterDetails) error {
+	if cluster.BuildKit != nil {
+		cluster.BuildKit.Image = valueOrDefault(cluster.BuildKit.Image, buildkit.DefaultImage)
+	}
+	return nil
+}
+
+func setDefaultClusterJibImages(cluster *latest.ClusterDetails) error {
+	cluster.MavenImage = valueOrDefault(cluster.MavenImage, defaultClusterMavenImage)
+	cluster.GradleImage = valueOrDefault(cluster.GradleImage, defaultClusterGradleImage)
+	return nil
+}
+
 func defaultToKanikoArtifact(artifact *latest.Artifact) {
 	if artifact.KanikoArtifact == nil {
 		artifact.KanikoArtifact = &latest.KanikoArtifact{}
@@ -381,3 +433,11 @@ func setDefaultArtifactDependencyAlias(d *latest.ArtifactDependency) {
 		d.Alias = d.ImageName
 	}
 }
+
+func setDefaultOutputDependencyPath(d *latest.OutputDependency) {
+	d.Path = valueOrDefault(d.Path, d.Name)
+}
+
+func setDefaultOutputWorkspace(o *latest.FileOutput) {
+	o.Workspace = valueOrDefault(o.Workspace, ".")
+}
@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/client-go/tools/clientcmd/api"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/buildkit"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
@@ -83,6 +84,12 @@ func TestSetDefaults(t *testing.T) {
 						},
 						Sync: &latest.Sync{Auto: util.BoolPtr(false)},
 					},
+					{
+						ImageName: "eighth",
+						ArtifactType: latest.ArtifactType{
+							KoArtifact: &latest.KoArtifact{},
+						},
+					},
 				},
 			},
 		},
@@ -127,6 +134,10 @@ func TestSetDefaults(t *testing.T) {
 	testutil.CheckDeepEqual(t, []string(nil), cfg.Build.Artifacts[6].BuildpackArtifact.Dependencies.Ignore)
 	testutil.CheckDeepEqual(t, "project.toml", cfg.Build.Artifacts[6].BuildpackArtifact.ProjectDescriptor)
 	testutil.CheckDeepEqual(t, util.BoolPtr(false), cfg.Build.Artifacts[6].Sync.Auto)
+
+	testutil.CheckDeepEqual(t, "eighth", cfg.Build.Artifacts[7].ImageName)
+	testutil.CheckDeepEqual(t, "gcr.io/distroless/static:nonroot", cfg.Build.Artifacts[7].KoArtifact.BaseImage)
+	testutil.CheckDeepEqual(t, ".", cfg.Build.Artifacts[7].KoArtifact.Main)
 }
 
 func TestSetDefaultsOnCluster(t *testing.T) {
@@ -284,6 +295,53 @@ func TestCustomBuildWithCluster(t *testing.T) {
 	testutil.CheckDeepEqual(t, (*latest.KanikoArtifact)(nil), cfg.Build.Artifacts[0].KanikoArtifact)
 }
 
+func TestJibBuildWithCluster(t *testing.T) {
+	cfg := &latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{
+			Build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{
+					{
+						ImageName: "image",
+						ArtifactType: latest.ArtifactType{
+							JibArtifact: &latest.JibArtifact{},
+						},
+					},
+				},
+				BuildType: latest.BuildType{
+					Cluster: &latest.ClusterDetails{},
+				},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, (*latest.KanikoArtifact)(nil), cfg.Build.Artifacts[0].KanikoArtifact)
+	testutil.CheckDeepEqual(t, "maven:3-openjdk-11", cfg.Build.Cluster.MavenImage)
+	testutil.CheckDeepEqual(t, "gradle:jdk11", cfg.Build.Cluster.GradleImage)
+}
+
+func TestDockerBuildWithClusterBuildKit(t *testing.T) {
+	cfg := &latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{
+			Build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{{ImageName: "image"}},
+				BuildType: latest.BuildType{
+					Cluster: &latest.ClusterDetails{BuildKit: &latest.BuildKitDetails{}},
+				},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, (*latest.KanikoArtifact)(nil), cfg.Build.Artifacts[0].KanikoArtifact)
+	testutil.CheckDeepEqual(t, "Dockerfile", cfg.Build.Artifacts[0].DockerArtifact.DockerfilePath)
+	testutil.CheckDeepEqual(t, buildkit.DefaultImage, cfg.Build.Cluster.BuildKit.Image)
+}
+
 func TestSetDefaultsOnCloudBuild(t *testing.T) {
 	cfg := &latest.SkaffoldConfig{
 		Pipeline: latest.Pipeline{
@@ -318,6 +376,74 @@ func TestSetDefaultsOnLocalBuild(t *testing.T) {
 	testutil.CheckDeepEqual(t, 1, *cfg.Build.LocalBuild.Concurrency)
 }
 
+func TestSetDefaultPlatforms(t *testing.T) {
+	cfg := &latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{
+			Build: latest.BuildConfig{
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+				Artifacts: []*latest.Artifact{
+					{ImageName: "inherited"},
+					{ImageName: "overridden", Platforms: []string{"linux/arm/v7"}},
+					{ImageName: "jib", ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}}},
+					{ImageName: "ko", ArtifactType: latest.ArtifactType{KoArtifact: &latest.KoArtifact{}}},
+				},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, []string{"linux/amd64", "linux/arm64"}, cfg.Build.Artifacts[0].Platforms)
+	testutil.CheckDeepEqual(t, []string{"linux/arm/v7"}, cfg.Build.Artifacts[1].Platforms)
+	// Builders that don't support these platforms build for their own platform.
+	testutil.CheckDeepEqual(t, 0, len(cfg.Build.Artifacts[2].Platforms))
+	testutil.CheckDeepEqual(t, 0, len(cfg.Build.Artifacts[3].Platforms))
+}
+
+func TestSetDefaultSBOMFormat(t *testing.T) {
+	cfg := &latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{
+			Build: latest.BuildConfig{
+				SBOM: &latest.SBOMConfig{},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, "spdx", cfg.Build.SBOM.Format)
+}
+
+func TestSetDefaultsFileOutputs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cfg := &latest.SkaffoldConfig{
+			Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{
+					Outputs: []*latest.FileOutput{{Name: "bundle", Command: "make"}},
+					Artifacts: []*latest.Artifact{{
+						ImageName: "app",
+						OutputDependencies: []*latest.OutputDependency{
+							{Name: "bundle"},
+							{Name: "bundle", Path: "static/js"},
+						},
+					}},
+				},
+			},
+		}
+
+		err := Set(cfg)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(".", cfg.Build.Outputs[0].Workspace)
+		t.CheckDeepEqual([]*latest.OutputDependency{
+			{Name: "bundle", Path: "bundle"},
+			{Name: "bundle", Path: "static/js"},
+		}, cfg.Build.Artifacts[0].OutputDependencies)
+	})
+}
+
 func TestSetPortForwardLocalPort(t *testing.T) {
 	cfg := &latest.SkaffoldConfig{
 		Pipeline: latest.Pipeline{
@@ -73,6 +73,10 @@ type Pipeline struct {
 
 	// PortForward describes user defined resources to port-forward.
 	PortForward []*PortForwardResource `yaml:"portForward,omitempty"`
+
+	// Verify *alpha* lists smoke checks run against the deployed resources, through a port-forward,
+	// once the status check has succeeded.
+	Verify []*VerifyCheck `yaml:"verify,omitempty"`
 }
 
 // GitInfo contains information on the origin of skaffold configurations cloned from a git repository.
@@ -144,11 +148,74 @@ type PortForwardResource struct {
 	LocalPort int `yaml:"localPort,omitempty"`
 }
 
+// VerifyCheck *alpha* describes a smoke check against a port of a deployed resource.
+// The check sends an HTTP request, a gRPC health check or opens a TCP connection, and is retried until it succeeds or times out.
+type VerifyCheck struct {
+	// Name identifies the check in the output. Defaults to `<resourceName>:<port>`.
+	Name string `yaml:"name,omitempty"`
+
+	// Type is the Kubernetes type of the resource to check. Defaults to `service`.
+	// Acceptable resource types are the same as for port forwarding.
+	Type ResourceType `yaml:"resourceType,omitempty"`
+
+	// ResourceName is the name of the Kubernetes resource to check.
+	ResourceName string `yaml:"resourceName" yamltags:"required"`
+
+	// Namespace is the namespace of the resource. Defaults to the namespace the application is deployed to.
+	Namespace string `yaml:"namespace,omitempty"`
+
+	// Port is the resource port the check is run against.
+	Port util.IntOrString `yaml:"port" yamltags:"required"`
+
+	// HTTP sends an HTTP request to the port. The check succeeds if the response has the expected status code.
+	HTTP *HTTPCheck `yaml:"http,omitempty" yamltags:"oneOf=check"`
+
+	// GRPC runs a gRPC health check against the port. The check succeeds if the service reports to be serving.
+	GRPC *GRPCCheck `yaml:"grpc,omitempty" yamltags:"oneOf=check"`
+
+	// TCP opens a TCP connection to the port. This is the default when neither `http` nor `grpc` is set.
+	TCP *TCPCheck `yaml:"tcp,omitempty" yamltags:"oneOf=check"`
+
+	// TimeoutSeconds is the deadline for the check to succeed, retries included. Defaults to `60` seconds.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+
+	// PeriodSeconds is how long to wait between two attempts. Defaults to `2` seconds.
+	PeriodSeconds int `yaml:"periodSeconds,omitempty"`
+}
+
+// HTTPCheck describes an HTTP smoke check.
+type HTTPCheck struct {
+	// Path is the path of the request. Defaults to `/`.
+	Path string `yaml:"path,omitempty"`
+
+	// Method is the HTTP method of the request. Defaults to `GET`.
+	Method string `yaml:"method,omitempty"`
+
+	// Headers are added to the request.
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// ExpectedStatus is the status code the response must have. Defaults to any `2xx` or `3xx` status code.
+	ExpectedStatus int `yaml:"expectedStatus,omitempty"`
+}
+
+// GRPCCheck describes a smoke check using the gRPC health checking protocol.
+type GRPCCheck struct {
+	// Service is the name of the service to check. Defaults to the overall health of the server.
+	Service string `yaml:"service,omitempty"`
+}
+
+// TCPCheck describes a smoke check that opens a TCP connection.
+type TCPCheck struct{}
+
 // BuildConfig contains all the configuration for the build steps.
 type BuildConfig struct {
 	// Artifacts lists the images you're going to be building.
 	Artifacts []*Artifact `yaml:"artifacts,omitempty"`
 
+	// Outputs *alpha* lists build steps that produce files instead of images, like a compiled frontend bundle
+	// or a binary shared by several images. Artifacts add these files to their build context with `requiresOutputs`.
+	Outputs []*FileOutput `yaml:"outputs,omitempty"`
+
 	// InsecureRegistries is a list of registries declared by the user to be insecure.
 	// These registries will be connected to via HTTP instead of HTTPS.
 	InsecureRegistries []string `yaml:"insecureRegistries,omitempty"`
@@ -158,9 +225,58 @@ type BuildConfig struct {
 	// If not specified, it defaults to `gitCommit: {variant: Tags}`.
 	TagPolicy TagPolicy `yaml:"tagPolicy,omitempty"`
 
+	// Platforms is the list of target platforms to build the artifacts for, in the `os/arch[/variant]` format.
+	// When more than one platform is listed, the images are pushed as a manifest list.
+	// Only applied to the artifacts whose builder supports target platforms.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	// Defaults to the platform of the builder.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// SBOM *alpha* generates a Software Bill of Materials for each built image, from its layers and its source files.
+	// The documents are written next to the `--file-output` file of `skaffold build`,
+	// and attached to pushed images as an OCI artifact tagged `sha256-<digest>.sbom`.
+	SBOM *SBOMConfig `yaml:"sbom,omitempty"`
+
+	// Sign *alpha* signs the pushed images with a private key. The signatures are compatible with `cosign`
+	// and pushed next to the images, tagged `sha256-<digest>.sig`.
+	Sign *SignConfig `yaml:"sign,omitempty"`
+
 	BuildType `yaml:",inline"`
 }
 
+// FileOutput *alpha* describes a build step that produces files used in the build context of other artifacts.
+// The files are stored by the hash of the step's inputs, and only produced again when these inputs change.
+type FileOutput struct {
+	// Name identifies the output in the artifacts that require it.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Workspace is the directory the command is run in.
+	// Defaults to `.`.
+	Workspace string `yaml:"context,omitempty" skaffold:"filepath"`
+
+	// Command produces the files. It's run with `sh -c` and must write the files
+	// to the directory given by the `$OUTPUT_DIR` environment variable.
+	// For example: `npm run build -- --output-path=$OUTPUT_DIR`.
+	Command string `yaml:"command" yamltags:"required"`
+
+	// Dependencies are the paths or glob patterns, relative to the workspace, of the files read by the command.
+	// Defaults to all the files in the workspace.
+	Dependencies []string `yaml:"dependencies,omitempty"`
+}
+
+// SBOMConfig *alpha* configures the Software Bill of Materials of the built images.
+type SBOMConfig struct {
+	// Format is the format of the SBOM documents: `spdx` or `cyclonedx`.
+	// Defaults to `spdx`.
+	Format string `yaml:"format,omitempty"`
+}
+
+// SignConfig *alpha* configures how the built images are signed.
+type SignConfig struct {
+	// Key is the path to the PEM encoded ECDSA private key used to sign the images.
+	Key string `yaml:"key" yamltags:"required" skaffold:"filepath"`
+}
+
 // TagPolicy contains all the configuration for the tagging step.
 type TagPolicy struct {
 	// GitTagger *beta* tags images with the git tag or commit of the artifact's workspace.
@@ -177,6 +293,15 @@ type TagPolicy struct {
 
 	// CustomTemplateTagger *beta* tags images with a configurable template string *composed of other taggers*.
 	CustomTemplateTagger *CustomTemplateTagger `yaml:"customTemplate,omitempty" yamltags:"oneOf=tag"`
+
+	// SemVerTagger *alpha* tags images with the next semantic version, derived from the latest git tag and the commit messages since.
+	SemVerTagger *SemVerTagger `yaml:"semver,omitempty" yamltags:"oneOf=tag"`
+
+	// MultiTagger *alpha* tags images with several tags, each generated by a tag policy.
+	MultiTagger *MultiTagger `yaml:"multiTag,omitempty" yamltags:"oneOf=tag"`
+
+	// InputDigestTagger *alpha* tags images with the digest of their inputs.
+	InputDigestTagger *InputDigestTagger `yaml:"inputDigest,omitempty" yamltags:"oneOf=tag"`
 }
 
 // ShaTagger *beta* tags images with their sha256 digest.
@@ -199,6 +324,33 @@ type GitTagger struct {
 	IgnoreChanges bool `yaml:"ignoreChanges,omitempty"`
 }
 
+// SemVerTagger *alpha* tags images with the next semantic version, derived from the latest annotated git tag
+// and the [conventional commit](https://www.conventionalcommits.org) messages since that tag.
+type SemVerTagger struct {
+	// TagPrefix is the prefix of the git tags holding versions. Defaults to `v`.
+	TagPrefix string `yaml:"tagPrefix,omitempty"`
+
+	// ReleaseBranches lists the branches producing release versions.
+	// Commits on other branches get a prerelease version named after the branch.
+	// Defaults to `["main", "master"]`.
+	ReleaseBranches []string `yaml:"releaseBranches,omitempty"`
+
+	// IgnoreChanges specifies whether to omit the `dirty` build metadata if there are uncommitted changes.
+	IgnoreChanges bool `yaml:"ignoreChanges,omitempty"`
+}
+
+// InputDigestTagger *alpha* tags images with the digest of their inputs: the source files, build args and configuration
+// of the artifact and of the artifacts it requires. This is the hash used as key by the artifact cache.
+type InputDigestTagger struct{}
+
+// MultiTagger *alpha* tags images with several tags, each generated by a tag policy.
+type MultiTagger struct {
+	// Tags lists the tag policies generating the tags.
+	// The first one is used to reference the image in manifests, the others are also applied to the built image.
+	// For example: `[{gitCommit: {}}, {envTemplate: {template: "latest"}}]`.
+	Tags []TagPolicy `yaml:"tags,omitempty" yamltags:"required"`
+}
+
 // EnvTemplateTagger *beta* tags images with a configurable template string.
 type EnvTemplateTagger struct {
 	// Template used to produce the image name and tag.
@@ -276,9 +428,49 @@ type LocalBuild struct {
 	// UseBuildkit use BuildKit to build Docker images.
 	UseBuildkit bool `yaml:"useBuildkit,omitempty"`
 
+	// Daemonless builds images without a Docker daemon.
+	// Images are stored in an OCI image layout under `~/.skaffold/images`,
+	// pushed directly to the registry and loaded into `kind` and `k3d` clusters from image tarballs.
+	// Only `bazel`, `jib` and `ko` artifacts can be built in this mode.
+	Daemonless bool `yaml:"daemonless,omitempty"`
+
 	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
 	// Defaults to `1`.
 	Concurrency *int `yaml:"concurrency,omitempty"`
+
+	// Daemon is the remote Docker daemon used to build the images.
+	// Defaults to the `docker-host` of the current kube-context in the global config,
+	// then to the daemon of the minikube cluster or of the environment.
+	Daemon *DockerDaemon `yaml:"daemon,omitempty"`
+
+	// Retention decides which of the images previously built by Skaffold are removed when pruning.
+	// By default, only the images replaced by new builds are removed.
+	Retention *ImageRetention `yaml:"retention,omitempty"`
+}
+
+// DockerDaemon describes how to connect to a remote Docker daemon.
+type DockerDaemon struct {
+	// Host is the endpoint of the daemon, for example `ssh://user@build-machine` or `tcp://build-machine:2376`.
+	Host string `yaml:"host" yamltags:"required"`
+
+	// CertPath is the directory holding the `ca.pem`, `cert.pem` and `key.pem` files
+	// used to connect to a `tcp` endpoint over TLS.
+	CertPath string `yaml:"certPath,omitempty"`
+}
+
+// ImageRetention describes which of the images built by Skaffold on the local Docker daemon are kept when pruning.
+// An image is removed as soon as it breaks one of the limits.
+type ImageRetention struct {
+	// KeepLast is the number of most recent images kept for each artifact.
+	// Defaults to `1`.
+	KeepLast *int `yaml:"keepLast,omitempty"`
+
+	// MaxAge is the age after which images are removed, for example `72h`.
+	MaxAge string `yaml:"maxAge,omitempty"`
+
+	// MaxDiskUsage is the maximum total size of the images built by Skaffold, for example `10GB`.
+	// The oldest images are removed first.
+	MaxDiskUsage string `yaml:"maxDiskUsage,omitempty"`
 }
 
 // GoogleCloudBuild *beta* describes how to do a remote build on
@@ -428,6 +620,25 @@ type ClusterDetails struct {
 
 	// RandomDockerConfigSecret adds a random UUID postfix to the default name of the docker secret to facilitate parallel builds, e.g. docker-cfgfd154022-c761-416f-8eb3-cf8258450b85.
 	RandomDockerConfigSecret bool `yaml:"randomDockerConfigSecret,omitempty"`
+
+	// MavenImage is the image that runs a Jib Maven build in the cluster.
+	// Defaults to `maven:3-openjdk-11`.
+	MavenImage string `yaml:"mavenImage,omitempty"`
+
+	// GradleImage is the image that runs a Jib Gradle build in the cluster.
+	// Defaults to `gradle:jdk11`.
+	GradleImage string `yaml:"gradleImage,omitempty"`
+
+	// BuildKit *alpha* builds `docker` artifacts with a long-lived BuildKit daemon running in the build namespace,
+	// instead of building them with kaniko.
+	BuildKit *BuildKitDetails `yaml:"buildkit,omitempty"`
+}
+
+// BuildKitDetails *alpha* describes the BuildKit daemon used to build `docker` artifacts in the cluster.
+type BuildKitDetails struct {
+	// Image is the image running the BuildKit daemon and the `buildctl` client.
+	// Defaults to `moby/buildkit:v0.8.3`.
+	Image string `yaml:"image,omitempty"`
 }
 
 // DockerConfig contains information about the docker `config.json` to mount.
@@ -481,6 +692,35 @@ type TestCase struct {
 	// to run on that artifact.
 	// For example: `["./test/*"]`.
 	StructureTests []string `yaml:"structureTests,omitempty"`
+
+	// JobTests *alpha* lists the tests to run as Kubernetes Jobs in the deployed namespace, after the deploy phase.
+	JobTests []JobTest `yaml:"jobs,omitempty"`
+}
+
+// JobTest *alpha* describes a test that runs as a Kubernetes Job against the deployed application.
+// If the Job fails, the test is considered to have failed.
+type JobTest struct {
+	// Name is the name of the test. It is used as prefix for the name of the Job and as name of its container,
+	// so it must be a valid DNS-1123 label.
+	// For example: `integration-tests`.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Image is the image run by the Job. Defaults to the image of the test case.
+	// Images built by Skaffold are referenced by their image name and replaced by the built tag.
+	Image string `yaml:"image,omitempty"`
+
+	// Command is the entrypoint of the container. Defaults to the entrypoint of the image.
+	// For example: `["go", "test", "./integration/..."]`.
+	Command []string `yaml:"command,omitempty"`
+
+	// Args are the arguments passed to the entrypoint.
+	Args []string `yaml:"args,omitempty"`
+
+	// Env are environment variables set in the container.
+	Env []v1.EnvVar `yaml:"env,omitempty"`
+
+	// TimeoutSeconds is how long Skaffold waits for the Job to complete. Defaults to `600` seconds.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
 }
 
 // DeployConfig contains all the configuration needed by the deploy steps.
@@ -496,6 +736,61 @@ type DeployConfig struct {
 
 	// Logs configures how container logs are printed as a result of a deployment.
 	Logs LogsConfig `yaml:"logs,omitempty"`
+
+	// Verify *alpha* refuses to deploy images that don't carry a valid signature.
+	// Only local images built by Skaffold in the same session are deployed without being verified.
+	Verify *VerifyConfig `yaml:"verify,omitempty"`
+
+	// StatusCheck *alpha* configures how the status of the deployed resources is checked.
+	StatusCheck *StatusCheckConfig `yaml:"statusCheck,omitempty"`
+}
+
+// StatusCheckConfig *alpha* configures how the status of the deployed resources is checked.
+type StatusCheckConfig struct {
+	// ReadinessRules tell when the resources of a given kind are ready, or have failed.
+	// They replace the built-in checks for that kind. Resources of a kind with a rule are checked
+	// even if that kind is built into Kubernetes, like `PersistentVolumeClaim`.
+	ReadinessRules []ReadinessRule `yaml:"readinessRules,omitempty"`
+}
+
+// ReadinessRule tells when the resources of a given kind are ready, or have failed, based on the value of their fields.
+type ReadinessRule struct {
+	// Group is the API group of the resources, empty for the core API group.
+	// For example: `cert-manager.io`.
+	Group string `yaml:"group,omitempty"`
+
+	// Kind is the kind of the resources.
+	// For example: `Certificate`.
+	Kind string `yaml:"kind" yamltags:"required"`
+
+	// Success lists the conditions that must all hold for a resource to be ready.
+	// If empty, resources are ready as soon as they are created, unless they have failed.
+	Success []ResourceCondition `yaml:"success,omitempty"`
+
+	// Failure lists conditions that each make the status check fail, as soon as they hold.
+	Failure []ResourceCondition `yaml:"failure,omitempty"`
+}
+
+// ResourceCondition is a condition on the value of a field of a resource.
+type ResourceCondition struct {
+	// JSONPath selects the field, with the JSONPath syntax supported by `kubectl`.
+	// For example: `{.status.conditions[?(@.type=="Ready")].status}`.
+	JSONPath string `yaml:"jsonPath" yamltags:"required"`
+
+	// Value is the value that the field must have for the condition to hold.
+	// If empty, the condition holds when the field has any non-empty value.
+	// For example: `True`.
+	Value string `yaml:"value,omitempty"`
+
+	// MessagePath selects the field shown to explain why a resource isn't ready or has failed, with the JSONPath syntax supported by `kubectl`.
+	// For example: `{.status.conditions[?(@.type=="Ready")].message}`.
+	MessagePath string `yaml:"messagePath,omitempty"`
+}
+
+// VerifyConfig *alpha* configures how the signatures of the deployed images are verified.
+type VerifyConfig struct {
+	// Key is the path to the PEM encoded ECDSA public key that the image signatures are checked against.
+	Key string `yaml:"key" yamltags:"required" skaffold:"filepath"`
 }
 
 // DeployType contains the specific implementation and parameters needed
@@ -824,6 +1119,43 @@ type Artifact struct {
 
 	// Dependencies describes build artifacts that this artifact depends on.
 	Dependencies []*ArtifactDependency `yaml:"requires,omitempty"`
+
+	// OutputDependencies *alpha* lists the file outputs added to the build context of this artifact.
+	// Only supported for Docker and Kaniko artifacts.
+	OutputDependencies []*OutputDependency `yaml:"requiresOutputs,omitempty"`
+
+	// Platforms is the list of target platforms to build this artifact for, in the `os/arch[/variant]` format.
+	// Overrides the `platforms` of the build configuration.
+	// For example: `["linux/amd64", "linux/arm64"]`.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// Timeout is the amount of time a single build attempt of this artifact is allowed to run.
+	// For example: `10m`. Defaults to no timeout.
+	Timeout string `yaml:"timeout,omitempty"`
+
+	// Retries is the number of times a failed or timed out build of this artifact is retried,
+	// waiting a little longer before each new attempt. Defaults to `0`.
+	Retries int `yaml:"retries,omitempty"`
+
+	// Variants *alpha* builds the artifact several times, with different build args or target stages, as separately named images.
+	// Only the variants are built; `--build-image <image>` builds all of them. Only supported for Docker and Kaniko artifacts.
+	Variants []*ArtifactVariant `yaml:"variants,omitempty"`
+}
+
+// ArtifactVariant *alpha* is a variant of an artifact, built from the same sources with different settings.
+type ArtifactVariant struct {
+	// Name identifies the variant. Unless `image` is set, the variant is built as `<artifact image>-<name>`.
+	// For example: `debug`.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// ImageName overrides the image name of the variant.
+	ImageName string `yaml:"image,omitempty"`
+
+	// BuildArgs are added to the `buildArgs` of the artifact, replacing the ones with the same key.
+	BuildArgs map[string]*string `yaml:"buildArgs,omitempty"`
+
+	// Target overrides the Dockerfile target stage of the artifact.
+	Target string `yaml:"target,omitempty"`
 }
 
 // Sync *beta* specifies what files to sync into the container.
@@ -941,6 +1273,10 @@ type ArtifactType struct {
 
 	// CustomArtifact *beta* builds images using a custom build script written by the user.
 	CustomArtifact *CustomArtifact `yaml:"custom,omitempty" yamltags:"oneOf=artifact"`
+
+	// KoArtifact *alpha* builds images from Go sources by compiling a main package
+	// and layering the binary on a base image, without a Docker daemon.
+	KoArtifact *KoArtifact `yaml:"ko,omitempty" yamltags:"oneOf=artifact"`
 }
 
 // ArtifactDependency describes a specific build dependency for an artifact.
@@ -953,6 +1289,16 @@ type ArtifactDependency struct {
 	Alias string `yaml:"alias,omitempty"`
 }
 
+// OutputDependency describes a file output added to the build context of an artifact.
+type OutputDependency struct {
+	// Name is a reference to a file output's name.
+	Name string `yaml:"name" yamltags:"required"`
+
+	// Path is the directory, relative to the root of the build context, the files are added to.
+	// Defaults to the value of `name`.
+	Path string `yaml:"path,omitempty"`
+}
+
 // BuildpackArtifact *alpha* describes an artifact built using [Cloud Native Buildpacks](https://buildpacks.io/).
 // It can be used to build images out of project's sources without any additional configuration.
 type BuildpackArtifact struct {
@@ -1000,6 +1346,9 @@ type CustomArtifact struct {
 	BuildCommand string `yaml:"buildCommand,omitempty"`
 	// Dependencies are the file dependencies that skaffold should watch for both rebuilding and file syncing for this artifact.
 	Dependencies *CustomDependencies `yaml:"dependencies,omitempty"`
+	// ReportResult *alpha* lets the build command report the result of the build as JSON, in the file at `$RESULT_FILE`:
+	// the digest or image ID of the image it built, the other tags it produced and an actionable error if the build failed.
+	ReportResult bool `yaml:"reportResult,omitempty"`
 }
 
 // CustomDependencies *beta* is used to specify dependencies for an artifact built by a custom build script.
@@ -1264,6 +1613,31 @@ type JibArtifact struct {
 	BaseImage string `yaml:"fromImage,omitempty"`
 }
 
+// KoArtifact *alpha* builds images from Go sources, in the style of [ko](https://github.com/google/ko).
+// The main package is compiled with the local Go toolchain and the binary is added as a single layer on top of a base image.
+type KoArtifact struct {
+	// BaseImage is the image the Go binary is layered on.
+	// Defaults to `gcr.io/distroless/static:nonroot`.
+	BaseImage string `yaml:"fromImage,omitempty"`
+
+	// Main is the import path or the path relative to the workspace of the main package to build.
+	// Defaults to `.`.
+	Main string `yaml:"main,omitempty"`
+
+	// Flags are additional build flags passed to `go build`.
+	// For example: `["-trimpath", "-tags=netgo"]`.
+	Flags []string `yaml:"flags,omitempty"`
+
+	// Ldflags are linker flags passed to `go build`.
+	// For example: `["-s", "-w"]`.
+	Ldflags []string `yaml:"ldflags,omitempty"`
+
+	// Env are environment variables, in the `key=value` form, passed to `go build`.
+	// Values can use the go template syntax.
+	// For example: `["GOPRIVATE=source.developers.google.com", "GOARM={{.GOARM}}"]`.
+	Env []string `yaml:"env,omitempty"`
+}
+
 // UnmarshalYAML provides a custom unmarshaller to deal with
 // https://github.com/GoogleContainerTools/skaffold/issues/4175
 func (clusterDetails *ClusterDetails) UnmarshalYAML(value *yaml.Node) error {
@@ -20,14 +20,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
+	"github.com/dustin/go-humanize"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/jsonpath"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/misc"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/sbom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
@@ -56,10 +62,20 @@ func Process(configs []*latest.SkaffoldConfig) error {
 		errs = append(errs, validateLogPrefix(config.Deploy.Logs)...)
 		errs = append(errs, validateArtifactTypes(config.Build)...)
 		errs = append(errs, validateTaggingPolicy(config.Build)...)
+		errs = append(errs, validatePlatforms(config.Build)...)
+		errs = append(errs, validateSBOM(config.Build)...)
+		errs = append(errs, validateBuildRetries(config.Build.Artifacts)...)
+		errs = append(errs, validateArtifactVariants(config.Build.Artifacts)...)
+		errs = append(errs, validateImageRetention(config.Build)...)
 		errs = append(errs, validateCustomTest(config.Test)...)
+		errs = append(errs, validateJobTests(config.Test)...)
+		errs = append(errs, validateReadinessRules(config.Deploy.StatusCheck)...)
 	}
 	errs = append(errs, validateArtifactDependencies(configs)...)
+	errs = append(errs, validateFileOutputs(configs)...)
 	errs = append(errs, validateSingleKubeContext(configs)...)
+	errs = append(errs, validateDaemonless(configs)...)
+	errs = append(errs, validateDockerDaemon(configs)...)
 	if len(errs) == 0 {
 		return nil
 	}
@@ -98,31 +114,63 @@ func validateTaggingPolicy(bc latest.BuildConfig) (errs []error) {
 	return
 }
 
-// validateImageNames makes sure the artifact image names are unique and valid base names,
+// validateImageNames makes sure the artifact and variant image names are unique and valid base names,
 // without tags nor digests.
 func validateImageNames(configs []*latest.SkaffoldConfig) (errs []error) {
 	seen := make(map[string]bool)
 	for _, c := range configs {
 		for _, a := range c.Build.Artifacts {
-			if seen[a.ImageName] {
-				errs = append(errs, fmt.Errorf("found duplicate images %q: artifact image names must be unique across all configurations", a.ImageName))
-				continue
+			imageNames := []string{a.ImageName}
+			for _, v := range a.Variants {
+				// a variant can keep the image name of its artifact.
+				if name := misc.VariantImageName(a, v); name != a.ImageName {
+					imageNames = append(imageNames, name)
+				}
 			}
 
-			seen[a.ImageName] = true
-			parsed, err := docker.ParseReference(a.ImageName)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("invalid image %q: %w", a.ImageName, err))
-				continue
-			}
+			for _, imageName := range imageNames {
+				if seen[imageName] {
+					errs = append(errs, fmt.Errorf("found duplicate images %q: artifact image names must be unique across all configurations", imageName))
+					continue
+				}
+
+				seen[imageName] = true
+				parsed, err := docker.ParseReference(imageName)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("invalid image %q: %w", imageName, err))
+					continue
+				}
+
+				if parsed.Tag != "" {
+					errs = append(errs, fmt.Errorf("invalid image %q: no tag should be specified. Use taggers instead: https://skaffold.dev/docs/how-tos/taggers/", imageName))
+				}
 
-			if parsed.Tag != "" {
-				errs = append(errs, fmt.Errorf("invalid image %q: no tag should be specified. Use taggers instead: https://skaffold.dev/docs/how-tos/taggers/", a.ImageName))
+				if parsed.Digest != "" {
+					errs = append(errs, fmt.Errorf("invalid image %q: no digest should be specified. Use taggers instead: https://skaffold.dev/docs/how-tos/taggers/", imageName))
+				}
 			}
+		}
+	}
+	return
+}
 
-			if parsed.Digest != "" {
-				errs = append(errs, fmt.Errorf("invalid image %q: no digest should be specified. Use taggers instead: https://skaffold.dev/docs/how-tos/taggers/", a.ImageName))
+// validateArtifactVariants makes sure that only docker and kaniko artifacts have variants, and that the variant names are unique.
+func validateArtifactVariants(artifacts []*latest.Artifact) (errs []error) {
+	for _, a := range artifacts {
+		if len(a.Variants) == 0 {
+			continue
+		}
+		if a.DockerArtifact == nil && a.KanikoArtifact == nil {
+			errs = append(errs, fmt.Errorf("artifact %q: variants are only supported for docker and kaniko artifacts", a.ImageName))
+			continue
+		}
+
+		seen := make(map[string]bool)
+		for _, v := range a.Variants {
+			if seen[v.Name] {
+				errs = append(errs, fmt.Errorf("artifact %q: duplicate variant %q", a.ImageName, v.Name))
 			}
+			seen[v.Name] = true
 		}
 	}
 	return
@@ -133,12 +181,46 @@ func validateArtifactDependencies(configs []*latest.SkaffoldConfig) (errs []erro
 	for _, c := range configs {
 		artifacts = append(artifacts, c.Build.Artifacts...)
 	}
+	if errs = validateVariantDependencies(artifacts); len(errs) > 0 {
+		return
+	}
+
+	// the variants of an artifact are built, and required, as separate artifacts.
+	artifacts = misc.ExpandVariants(artifacts)
 	errs = append(errs, validateUniqueDependencyAliases(artifacts)...)
 	errs = append(errs, validateAcyclicDependencies(artifacts)...)
 	errs = append(errs, validateValidDependencyAliases(artifacts)...)
 	return
 }
 
+// validateVariantDependencies makes sure that artifacts don't require an artifact with variants, since only its variants are built,
+// unless one of the variants keeps the image name of the artifact.
+func validateVariantDependencies(artifacts []*latest.Artifact) (errs []error) {
+	variants := make(map[string][]string)
+	for _, a := range artifacts {
+		var names []string
+		for _, v := range a.Variants {
+			name := misc.VariantImageName(a, v)
+			if name == a.ImageName {
+				names = nil
+				break
+			}
+			names = append(names, name)
+		}
+		if len(names) > 0 {
+			variants[a.ImageName] = names
+		}
+	}
+	for _, a := range artifacts {
+		for _, d := range a.Dependencies {
+			if names, found := variants[d.ImageName]; found {
+				errs = append(errs, fmt.Errorf("artifact %q requires %q, which is built as the variants %v: require one of the variants instead", a.ImageName, d.ImageName, names))
+			}
+		}
+	}
+	return
+}
+
 // validateAcyclicDependencies makes sure all artifact dependencies are found and don't have cyclic references
 func validateAcyclicDependencies(artifacts []*latest.Artifact) (errs []error) {
 	m := make(map[string]*latest.Artifact)
@@ -436,8 +518,11 @@ func validateArtifactTypes(bc latest.BuildConfig) (errs []error) {
 	switch {
 	case bc.LocalBuild != nil:
 		for _, a := range bc.Artifacts {
-			if misc.ArtifactType(a) == misc.Kaniko {
+			at := misc.ArtifactType(a)
+			if at == misc.Kaniko {
 				errs = append(errs, fmt.Errorf("found a '%s' artifact, which is incompatible with the 'local' builder:\n\n%s\n\nTo use the '%s' builder, add the 'cluster' stanza to the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)))
+			} else if bc.LocalBuild.Daemonless && at != misc.Bazel && at != misc.Jib && at != misc.Ko {
+				errs = append(errs, fmt.Errorf("found a '%s' artifact, which can't be built by the 'local' builder with 'daemonless: true':\n\n%s\n\nOnly 'bazel', 'jib' and 'ko' artifacts can be built without a Docker daemon. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", at, misc.FormatArtifact(a)))
 			}
 		}
 	case bc.GoogleCloudBuild != nil:
@@ -449,7 +534,17 @@ func validateArtifactTypes(bc latest.BuildConfig) (errs []error) {
 		}
 	case bc.Cluster != nil:
 		for _, a := range bc.Artifacts {
-			if misc.ArtifactType(a) != misc.Kaniko && misc.ArtifactType(a) != misc.Custom {
+			at := misc.ArtifactType(a)
+			if at == misc.Docker && bc.Cluster.BuildKit != nil {
+				if a.DockerArtifact.NetworkMode != "" || a.DockerArtifact.Squash {
+					errs = append(errs, fmt.Errorf("artifact %s: 'network' and 'squash' aren't supported when building with BuildKit in the cluster", a.ImageName))
+				}
+				continue
+			}
+			if at == misc.Buildpack && len(a.BuildpackArtifact.Buildpacks) > 0 {
+				errs = append(errs, fmt.Errorf("artifact %s: 'buildpacks' aren't supported when building in the cluster, they must be part of the builder image", a.ImageName))
+			}
+			if at != misc.Kaniko && at != misc.Custom && at != misc.Jib && at != misc.Buildpack {
 				errs = append(errs, fmt.Errorf("found a '%s' artifact, which is incompatible with the 'cluster' builder:\n\n%s\n\nTo use the '%s' builder, remove the 'cluster' stanza from the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)))
 			}
 		}
@@ -457,6 +552,118 @@ func validateArtifactTypes(bc latest.BuildConfig) (errs []error) {
 	return
 }
 
+// validateFileOutputs makes sure that file output names are unique across all configurations,
+// and that they're only required by Docker and Kaniko artifacts, in paths that stay inside the build context.
+func validateFileOutputs(configs []*latest.SkaffoldConfig) (errs []error) {
+	outputs := make(map[string]bool)
+	for _, c := range configs {
+		for _, o := range c.Build.Outputs {
+			if outputs[o.Name] {
+				errs = append(errs, fmt.Errorf("found duplicate file outputs %q: output names must be unique across all configurations", o.Name))
+			}
+			outputs[o.Name] = true
+		}
+	}
+
+	for _, c := range configs {
+		for _, a := range c.Build.Artifacts {
+			if len(a.OutputDependencies) == 0 {
+				continue
+			}
+			at := misc.ArtifactType(a)
+			if (at != misc.Docker && at != misc.Kaniko) || c.Build.GoogleCloudBuild != nil {
+				errs = append(errs, fmt.Errorf("artifact %s requires file outputs, which are only supported for 'docker' and 'kaniko' artifacts built with the 'local' or 'cluster' builders", a.ImageName))
+			}
+			for _, d := range a.OutputDependencies {
+				if !outputs[d.Name] {
+					errs = append(errs, fmt.Errorf("artifact %s requires file output %q, which isn't defined in any configuration", a.ImageName, d.Name))
+				}
+				if p := filepath.Clean(d.Path); filepath.IsAbs(p) || p == "." || p == ".." || strings.HasPrefix(p, ".."+string(filepath.Separator)) {
+					errs = append(errs, fmt.Errorf("artifact %s adds file output %q to invalid path %q: it must be a relative path inside the build context", a.ImageName, d.Name, d.Path))
+				}
+			}
+		}
+	}
+	return
+}
+
+// validateSBOM makes sure that the SBOM format is supported.
+func validateSBOM(bc latest.BuildConfig) (errs []error) {
+	if bc.SBOM == nil {
+		return
+	}
+	switch bc.SBOM.Format {
+	case sbom.SPDX, sbom.CycloneDX:
+	default:
+		errs = append(errs, fmt.Errorf("unsupported SBOM format %q, must be one of '%s' or '%s'", bc.SBOM.Format, sbom.SPDX, sbom.CycloneDX))
+	}
+	return
+}
+
+// validatePlatforms checks that target platforms are well formed, and only set on artifacts
+// built by builders that support cross-platform builds.
+// The platforms of the build configuration are only applied to those artifacts, so they are never rejected.
+func validatePlatforms(bc latest.BuildConfig) (errs []error) {
+	for _, p := range bc.Platforms {
+		if _, err := docker.ParsePlatform(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, a := range bc.Artifacts {
+		if len(a.Platforms) == 0 {
+			continue
+		}
+		for _, p := range a.Platforms {
+			if _, err := docker.ParsePlatform(p); err != nil {
+				errs = append(errs, fmt.Errorf("artifact %s: %w", a.ImageName, err))
+			}
+		}
+
+		if !misc.SupportsPlatforms(bc, a, len(a.Platforms)) {
+			errs = append(errs, fmt.Errorf("artifact %s sets target platforms, which are only supported for 'docker' artifacts with the 'local' builder, 'kaniko' artifacts with the 'cluster' builder, 'docker' artifacts with the 'cluster' builder using BuildKit and a single platform for 'ko' artifacts", a.ImageName))
+		}
+	}
+	return
+}
+
+// validateBuildRetries checks that artifact build timeouts are valid durations and that retries aren't negative.
+func validateBuildRetries(artifacts []*latest.Artifact) (errs []error) {
+	for _, a := range artifacts {
+		if a.Timeout != "" {
+			if d, err := time.ParseDuration(a.Timeout); err != nil || d <= 0 {
+				errs = append(errs, fmt.Errorf("artifact %s has invalid timeout %q, it should be a positive duration such as '10m'", a.ImageName, a.Timeout))
+			}
+		}
+		if a.Retries < 0 {
+			errs = append(errs, fmt.Errorf("artifact %s has negative retries %d", a.ImageName, a.Retries))
+		}
+	}
+	return
+}
+
+// validateImageRetention checks that the retention policy of locally built images has valid limits.
+func validateImageRetention(bc latest.BuildConfig) (errs []error) {
+	if bc.LocalBuild == nil || bc.LocalBuild.Retention == nil {
+		return
+	}
+	r := bc.LocalBuild.Retention
+	if r.KeepLast != nil && *r.KeepLast < 0 {
+		errs = append(errs, fmt.Errorf("image retention has negative keepLast %d", *r.KeepLast))
+	}
+	if r.MaxAge != "" {
+		if d, err := time.ParseDuration(r.MaxAge); err != nil || d <= 0 {
+			errs = append(errs, fmt.Errorf("image retention has invalid maxAge %q, it should be a positive duration such as '72h'", r.MaxAge))
+		}
+	}
+	if r.MaxDiskUsage != "" {
+		if _, err := humanize.ParseBytes(r.MaxDiskUsage); err != nil {
+			errs = append(errs, fmt.Errorf("image retention has invalid maxDiskUsage %q, it should be a size such as '10GB'", r.MaxDiskUsage))
+		}
+	}
+	return
+}
+
 // validateLogPrefix checks that logs are configured with a valid prefix.
 func validateLogPrefix(lc latest.LogsConfig) []error {
 	validPrefixes := []string{"", "auto", "container", "podAndContainer", "none"}
@@ -481,6 +688,49 @@ func validateSingleKubeContext(configs []*latest.SkaffoldConfig) []error {
 	return nil
 }
 
+// validateDaemonless checks that all the configs building locally agree on whether to use a Docker daemon,
+// since they share the same local image store.
+func validateDaemonless(configs []*latest.SkaffoldConfig) []error {
+	var local []*latest.LocalBuild
+	for _, c := range configs {
+		if c.Build.LocalBuild != nil {
+			local = append(local, c.Build.LocalBuild)
+		}
+	}
+	for _, l := range local {
+		if l.Daemonless != local[0].Daemonless {
+			return []error{errors.New("all configs using the 'local' builder should have the same value for `build.local.daemonless`")}
+		}
+	}
+	return nil
+}
+
+// validateDockerDaemon checks that the remote Docker daemon has a supported endpoint, isn't used by daemonless builds,
+// and is the same for all the configs, since they share the same Docker client.
+func validateDockerDaemon(configs []*latest.SkaffoldConfig) (errs []error) {
+	var daemon *latest.DockerDaemon
+	for _, c := range configs {
+		l := c.Build.LocalBuild
+		if l == nil || l.Daemon == nil {
+			continue
+		}
+		if l.Daemonless {
+			errs = append(errs, errors.New("`build.local.daemon` can't be used with `build.local.daemonless: true`"))
+			continue
+		}
+		if u, err := url.Parse(l.Daemon.Host); err != nil || !util.StrSliceContains([]string{"ssh", "tcp", "unix"}, u.Scheme) {
+			errs = append(errs, fmt.Errorf("invalid docker daemon host %q, it should be an ssh://, tcp:// or unix:// endpoint", l.Daemon.Host))
+			continue
+		}
+		if daemon != nil && *daemon != *l.Daemon {
+			errs = append(errs, errors.New("all configs using the 'local' builder should have the same value for `build.local.daemon`"))
+			continue
+		}
+		daemon = l.Daemon
+	}
+	return
+}
+
 // validateCustomTest
 // - makes sure that command is not empty
 // - makes sure that dependencies.ignore is only used in conjunction with dependencies.paths
@@ -505,3 +755,49 @@ func validateCustomTest(tcs []*latest.TestCase) (errs []error) {
 	}
 	return
 }
+
+// validateJobTests makes sure that the name of each job test is a valid DNS-1123 label,
+// since it is used as the name of the Job's container and as prefix of the Job's name.
+func validateJobTests(tcs []*latest.TestCase) (errs []error) {
+	for _, tc := range tcs {
+		for _, jt := range tc.JobTests {
+			if msgs := k8svalidation.IsDNS1123Label(jt.Name); len(msgs) > 0 {
+				errs = append(errs, fmt.Errorf("invalid job test name %q: %s", jt.Name, strings.Join(msgs, ", ")))
+			}
+		}
+	}
+	return
+}
+
+// validateReadinessRules makes sure that each kind has a single readiness rule, with valid JSONPath expressions.
+// Kinds and groups are compared case-insensitively, like the resource types passed to kubectl.
+func validateReadinessRules(sc *latest.StatusCheckConfig) (errs []error) {
+	if sc == nil {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, rule := range sc.ReadinessRules {
+		kind := rule.Kind
+		if rule.Group != "" {
+			kind = rule.Kind + "." + rule.Group
+		}
+		key := strings.ToLower(kind)
+		if seen[key] {
+			errs = append(errs, fmt.Errorf("found duplicate readiness rules for %q", kind))
+		}
+		seen[key] = true
+
+		for _, c := range append(rule.Success, rule.Failure...) {
+			for _, expr := range []string{c.JSONPath, c.MessagePath} {
+				if expr == "" {
+					continue
+				}
+				if err := jsonpath.New(kind).Parse(expr); err != nil {
+					errs = append(errs, fmt.Errorf("invalid JSONPath %q in the readiness rule for %q: %w", expr, kind, err))
+				}
+			}
+		}
+	}
+	return
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/docker/docker/api/types"
@@ -797,6 +798,33 @@ func TestValidateImageNames(t *testing.T) {
 			}},
 			shouldErr: true,
 		},
+		{
+			description: "variants",
+			artifacts: []*latest.Artifact{{
+				ImageName:    "img",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				Variants:     []*latest.ArtifactVariant{{Name: "debug"}, {Name: "release", ImageName: "img"}},
+			}},
+			shouldErr: false,
+		},
+		{
+			description: "duplicate variant images",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Variants:  []*latest.ArtifactVariant{{Name: "debug"}},
+			}, {
+				ImageName: "img-debug",
+			}},
+			shouldErr: true,
+		},
+		{
+			description: "variant shouldn't have a tag",
+			artifacts: []*latest.Artifact{{
+				ImageName: "img",
+				Variants:  []*latest.ArtifactVariant{{Name: "debug", ImageName: "img:debug"}},
+			}},
+			shouldErr: true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -1152,6 +1180,178 @@ func TestValidateSingleKubeContext(t *testing.T) {
 	}
 }
 
+func TestValidateDaemonless(t *testing.T) {
+	localConfig := func(image string, daemonless bool, artifactType latest.ArtifactType) *latest.SkaffoldConfig {
+		return &latest.SkaffoldConfig{
+			Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{
+					BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{Daemonless: daemonless}},
+					Artifacts: []*latest.Artifact{{ImageName: image, ArtifactType: artifactType}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		description string
+		configs     []*latest.SkaffoldConfig
+		shouldErr   bool
+	}{
+		{
+			description: "daemonless ko artifact",
+			configs:     []*latest.SkaffoldConfig{localConfig("image", true, latest.ArtifactType{KoArtifact: &latest.KoArtifact{}})},
+		},
+		{
+			description: "daemonless jib artifact",
+			configs:     []*latest.SkaffoldConfig{localConfig("image", true, latest.ArtifactType{JibArtifact: &latest.JibArtifact{}})},
+		},
+		{
+			description: "daemonless bazel artifact",
+			configs:     []*latest.SkaffoldConfig{localConfig("image", true, latest.ArtifactType{BazelArtifact: &latest.BazelArtifact{}})},
+		},
+		{
+			description: "daemonless docker artifact",
+			configs:     []*latest.SkaffoldConfig{localConfig("image", true, latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}})},
+			shouldErr:   true,
+		},
+		{
+			description: "daemonless buildpacks artifact",
+			configs:     []*latest.SkaffoldConfig{localConfig("image", true, latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{}})},
+			shouldErr:   true,
+		},
+		{
+			description: "mixed daemonless configs",
+			configs: []*latest.SkaffoldConfig{
+				localConfig("image1", true, latest.ArtifactType{KoArtifact: &latest.KoArtifact{}}),
+				localConfig("image2", false, latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}),
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(test.configs)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateDockerDaemon(t *testing.T) {
+	localConfig := func(daemonless bool, daemon *latest.DockerDaemon) *latest.SkaffoldConfig {
+		return &latest.SkaffoldConfig{
+			Pipeline: latest.Pipeline{
+				Build: latest.BuildConfig{
+					BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{Daemonless: daemonless, Daemon: daemon}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		description string
+		configs     []*latest.SkaffoldConfig
+		shouldErr   bool
+	}{
+		{
+			description: "no daemon",
+			configs:     []*latest.SkaffoldConfig{localConfig(false, nil)},
+		},
+		{
+			description: "ssh daemon",
+			configs:     []*latest.SkaffoldConfig{localConfig(false, &latest.DockerDaemon{Host: "ssh://user@build-machine"})},
+		},
+		{
+			description: "tcp daemon with tls",
+			configs:     []*latest.SkaffoldConfig{localConfig(false, &latest.DockerDaemon{Host: "tcp://build-machine:2376", CertPath: "certs"})},
+		},
+		{
+			description: "unsupported endpoint",
+			configs:     []*latest.SkaffoldConfig{localConfig(false, &latest.DockerDaemon{Host: "build-machine:2376"})},
+			shouldErr:   true,
+		},
+		{
+			description: "daemonless",
+			configs:     []*latest.SkaffoldConfig{localConfig(true, &latest.DockerDaemon{Host: "ssh://user@build-machine"})},
+			shouldErr:   true,
+		},
+		{
+			description: "same daemon in all configs",
+			configs: []*latest.SkaffoldConfig{
+				localConfig(false, &latest.DockerDaemon{Host: "ssh://user@build-machine"}),
+				localConfig(false, nil),
+				localConfig(false, &latest.DockerDaemon{Host: "ssh://user@build-machine"}),
+			},
+		},
+		{
+			description: "different daemons",
+			configs: []*latest.SkaffoldConfig{
+				localConfig(false, &latest.DockerDaemon{Host: "ssh://user@build-machine"}),
+				localConfig(false, &latest.DockerDaemon{Host: "ssh://user@other-machine"}),
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateDockerDaemon(test.configs)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateClusterArtifactTypes(t *testing.T) {
+	tests := []struct {
+		description  string
+		artifactType latest.ArtifactType
+		shouldErr    bool
+	}{
+		{
+			description:  "kaniko artifact",
+			artifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{}},
+		},
+		{
+			description:  "jib artifact",
+			artifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}},
+		},
+		{
+			description:  "buildpacks artifact",
+			artifactType: latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{Builder: "builder"}},
+		},
+		{
+			description:  "buildpacks artifact with additional buildpacks",
+			artifactType: latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{Builder: "builder", Buildpacks: []string{"buildpack"}}},
+			shouldErr:    true,
+		},
+		{
+			description:  "bazel artifact",
+			artifactType: latest.ArtifactType{BazelArtifact: &latest.BazelArtifact{}},
+			shouldErr:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process([]*latest.SkaffoldConfig{{
+				Pipeline: latest.Pipeline{
+					Build: latest.BuildConfig{
+						BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{}},
+						Artifacts: []*latest.Artifact{{ImageName: "image", ArtifactType: test.artifactType}},
+					},
+				},
+			}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
 func TestValidateValidDependencyAliases(t *testing.T) {
 	cfgs := []*latest.SkaffoldConfig{
 		{
@@ -1277,6 +1477,501 @@ func TestValidateTaggingPolicy(t *testing.T) {
 	}
 }
 
+func TestValidateBuildRetries(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latest.Artifact
+		shouldErr   bool
+	}{
+		{
+			description: "no timeout or retries",
+			artifact:    latest.Artifact{ImageName: "image"},
+		},
+		{
+			description: "valid timeout and retries",
+			artifact:    latest.Artifact{ImageName: "image", Timeout: "10m", Retries: 3},
+		},
+		{
+			description: "invalid timeout",
+			artifact:    latest.Artifact{ImageName: "image", Timeout: "ten minutes"},
+			shouldErr:   true,
+		},
+		{
+			description: "negative timeout",
+			artifact:    latest.Artifact{ImageName: "image", Timeout: "-1m"},
+			shouldErr:   true,
+		},
+		{
+			description: "negative retries",
+			artifact:    latest.Artifact{ImageName: "image", Retries: -1},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateBuildRetries([]*latest.Artifact{&test.artifact})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateArtifactVariants(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latest.Artifact
+		shouldErr   bool
+	}{
+		{
+			description: "no variants",
+			artifact:    latest.Artifact{ImageName: "image"},
+		},
+		{
+			description: "docker variants",
+			artifact: latest.Artifact{
+				ImageName:    "image",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				Variants:     []*latest.ArtifactVariant{{Name: "debug"}, {Name: "release"}},
+			},
+		},
+		{
+			description: "kaniko variants",
+			artifact: latest.Artifact{
+				ImageName:    "image",
+				ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{}},
+				Variants:     []*latest.ArtifactVariant{{Name: "debug"}},
+			},
+		},
+		{
+			description: "unsupported artifact type",
+			artifact: latest.Artifact{
+				ImageName:    "image",
+				ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}},
+				Variants:     []*latest.ArtifactVariant{{Name: "debug"}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "duplicate variant",
+			artifact: latest.Artifact{
+				ImageName:    "image",
+				ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				Variants:     []*latest.ArtifactVariant{{Name: "debug"}, {Name: "debug"}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateArtifactVariants([]*latest.Artifact{&test.artifact})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateVariantDependencies(t *testing.T) {
+	tests := []struct {
+		description string
+		variants    []*latest.ArtifactVariant
+		requires    string
+		expected    string
+	}{
+		{
+			description: "requires a variant",
+			variants:    []*latest.ArtifactVariant{{Name: "debug"}, {Name: "release"}},
+			requires:    "base-release",
+		},
+		{
+			description: "requires an artifact with variants",
+			variants:    []*latest.ArtifactVariant{{Name: "debug"}, {Name: "release"}},
+			requires:    "base",
+			expected:    `artifact "app" requires "base", which is built as the variants [base-debug base-release]: require one of the variants instead`,
+		},
+		{
+			description: "requires an artifact with a variant keeping its image",
+			variants:    []*latest.ArtifactVariant{{Name: "debug"}, {Name: "release", ImageName: "base"}},
+			requires:    "base",
+		},
+		{
+			description: "requires an unknown variant",
+			variants:    []*latest.ArtifactVariant{{Name: "debug"}},
+			requires:    "base-release",
+			expected:    `unknown build dependency "base-release" for artifact "app"`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateArtifactDependencies([]*latest.SkaffoldConfig{{
+				Pipeline: latest.Pipeline{
+					Build: latest.BuildConfig{
+						Artifacts: []*latest.Artifact{
+							{
+								ImageName:    "app",
+								ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+								Dependencies: []*latest.ArtifactDependency{{ImageName: test.requires, Alias: "BASE"}},
+							},
+							{
+								ImageName:    "base",
+								ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+								Variants:     test.variants,
+							},
+						},
+					},
+				},
+			}})
+
+			if test.expected == "" {
+				t.CheckEmpty(errs)
+			} else {
+				t.CheckDeepEqual(1, len(errs))
+				t.CheckErrorContains(test.expected, errs[0])
+			}
+		})
+	}
+}
+
+func TestValidateImageRetention(t *testing.T) {
+	negative := -1
+	tests := []struct {
+		description string
+		retention   *latest.ImageRetention
+		shouldErr   bool
+	}{
+		{
+			description: "no retention",
+		},
+		{
+			description: "valid retention",
+			retention:   &latest.ImageRetention{MaxAge: "72h", MaxDiskUsage: "10GB"},
+		},
+		{
+			description: "negative keepLast",
+			retention:   &latest.ImageRetention{KeepLast: &negative},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid maxAge",
+			retention:   &latest.ImageRetention{MaxAge: "3 days"},
+			shouldErr:   true,
+		},
+		{
+			description: "negative maxAge",
+			retention:   &latest.ImageRetention{MaxAge: "-1h"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid maxDiskUsage",
+			retention:   &latest.ImageRetention{MaxDiskUsage: "a lot"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateImageRetention(latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{Retention: test.retention}},
+			})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidatePlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         latest.BuildConfig
+		shouldErr   bool
+	}{
+		{
+			description: "docker artifact with local builder",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/amd64", "linux/arm/v7"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+		},
+		{
+			description: "kaniko artifact with cluster builder",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/arm64"},
+					ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{}},
+				}},
+			},
+		},
+		{
+			description: "docker artifact with cluster builder using BuildKit",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{BuildKit: &latest.BuildKitDetails{}}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/amd64", "linux/arm64"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+		},
+		{
+			description: "docker artifact with cluster builder",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/arm64"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "squash isn't supported with BuildKit",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{BuildKit: &latest.BuildKitDetails{}}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{Squash: true}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "ko artifact with a single platform",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/arm64"},
+					ArtifactType: latest.ArtifactType{KoArtifact: &latest.KoArtifact{}},
+				}},
+			},
+		},
+		{
+			description: "ko artifact with multiple platforms",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/amd64", "linux/arm64"},
+					ArtifactType: latest.ArtifactType{KoArtifact: &latest.KoArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "invalid platform",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"arm64"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "unsupported artifact type",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/arm64"},
+					ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "build platforms with unsupported artifact types",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+				Artifacts: []*latest.Artifact{
+					{
+						ImageName:    "docker",
+						Platforms:    []string{"linux/amd64", "linux/arm64"},
+						ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+					},
+					{
+						ImageName:    "jib",
+						ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}},
+					},
+				},
+			},
+		},
+		{
+			description: "invalid build platform",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}},
+				Platforms: []string{"arm64"},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "unsupported builder",
+			cfg: latest.BuildConfig{
+				BuildType: latest.BuildType{GoogleCloudBuild: &latest.GoogleCloudBuild{}},
+				Artifacts: []*latest.Artifact{{
+					ImageName:    "image",
+					Platforms:    []string{"linux/arm64"},
+					ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+				}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// disable yamltags validation
+			t.Override(&validateYamltags, func(interface{}) error { return nil })
+
+			err := Process(
+				[]*latest.SkaffoldConfig{{
+					Pipeline: latest.Pipeline{
+						Build: test.cfg,
+					},
+				}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestValidateSBOM(t *testing.T) {
+	tests := []struct {
+		description string
+		sbom        *latest.SBOMConfig
+		shouldErr   bool
+	}{
+		{
+			description: "disabled",
+		},
+		{
+			description: "spdx",
+			sbom:        &latest.SBOMConfig{Format: "spdx"},
+		},
+		{
+			description: "cyclonedx",
+			sbom:        &latest.SBOMConfig{Format: "cyclonedx"},
+		},
+		{
+			description: "unknown format",
+			sbom:        &latest.SBOMConfig{Format: "swid"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateSBOM(latest.BuildConfig{SBOM: test.sbom})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateFileOutputs(t *testing.T) {
+	bundle := []*latest.FileOutput{{Name: "bundle", Command: "npm run build"}}
+	docker := latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}
+	local := latest.BuildType{LocalBuild: &latest.LocalBuild{}}
+
+	tests := []struct {
+		description string
+		configs     []latest.BuildConfig
+		expected    []string
+	}{
+		{
+			description: "output required by docker artifact",
+			configs: []latest.BuildConfig{{
+				Outputs:   bundle,
+				BuildType: local,
+				Artifacts: []*latest.Artifact{{ImageName: "app", ArtifactType: docker, OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "dist"}}}},
+			}},
+		},
+		{
+			description: "output defined in another config",
+			configs: []latest.BuildConfig{
+				{Outputs: bundle},
+				{
+					BuildType: latest.BuildType{Cluster: &latest.ClusterDetails{}},
+					Artifacts: []*latest.Artifact{{ImageName: "app", ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{}}, OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "static/js"}}}},
+				},
+			},
+		},
+		{
+			description: "duplicate outputs",
+			configs:     []latest.BuildConfig{{Outputs: bundle}, {Outputs: bundle}},
+			expected:    []string{`found duplicate file outputs "bundle": output names must be unique across all configurations`},
+		},
+		{
+			description: "unknown output",
+			configs: []latest.BuildConfig{{
+				BuildType: local,
+				Artifacts: []*latest.Artifact{{ImageName: "app", ArtifactType: docker, OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "bundle"}}}},
+			}},
+			expected: []string{`artifact app requires file output "bundle", which isn't defined in any configuration`},
+		},
+		{
+			description: "unsupported artifact type",
+			configs: []latest.BuildConfig{{
+				Outputs:   bundle,
+				BuildType: local,
+				Artifacts: []*latest.Artifact{{ImageName: "app", ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}}, OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "bundle"}}}},
+			}},
+			expected: []string{"artifact app requires file outputs, which are only supported for 'docker' and 'kaniko' artifacts built with the 'local' or 'cluster' builders"},
+		},
+		{
+			description: "unsupported builder",
+			configs: []latest.BuildConfig{{
+				Outputs:   bundle,
+				BuildType: latest.BuildType{GoogleCloudBuild: &latest.GoogleCloudBuild{}},
+				Artifacts: []*latest.Artifact{{ImageName: "app", ArtifactType: docker, OutputDependencies: []*latest.OutputDependency{{Name: "bundle", Path: "bundle"}}}},
+			}},
+			expected: []string{"artifact app requires file outputs, which are only supported for 'docker' and 'kaniko' artifacts built with the 'local' or 'cluster' builders"},
+		},
+		{
+			description: "paths outside of the build context",
+			configs: []latest.BuildConfig{{
+				Outputs:   bundle,
+				BuildType: local,
+				Artifacts: []*latest.Artifact{{ImageName: "app", ArtifactType: docker, OutputDependencies: []*latest.OutputDependency{
+					{Name: "bundle", Path: "../dist"},
+					{Name: "bundle", Path: "/dist"},
+					{Name: "bundle", Path: "./"},
+				}}},
+			}},
+			expected: []string{
+				`artifact app adds file output "bundle" to invalid path "../dist": it must be a relative path inside the build context`,
+				`artifact app adds file output "bundle" to invalid path "/dist": it must be a relative path inside the build context`,
+				`artifact app adds file output "bundle" to invalid path "./": it must be a relative path inside the build context`,
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var configs []*latest.SkaffoldConfig
+			for _, bc := range test.configs {
+				configs = append(configs, &latest.SkaffoldConfig{Pipeline: latest.Pipeline{Build: bc}})
+			}
+
+			var messages []string
+			for _, err := range validateFileOutputs(configs) {
+				messages = append(messages, err.Error())
+			}
+
+			t.CheckDeepEqual(test.expected, messages)
+		})
+	}
+}
+
 func TestValidateCustomTest(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -1336,3 +2031,98 @@ func TestValidateCustomTest(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateJobTests(t *testing.T) {
+	tests := []struct {
+		description    string
+		name           string
+		expectedErrors int
+	}{
+		{
+			description: "valid name",
+			name:        "integration-tests",
+		},
+		{
+			description:    "uppercase",
+			name:           "IntegrationTests",
+			expectedErrors: 1,
+		},
+		{
+			description:    "underscore",
+			name:           "integration_tests",
+			expectedErrors: 1,
+		},
+		{
+			description:    "too long",
+			name:           strings.Repeat("a", 64),
+			expectedErrors: 1,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testCase := &latest.TestCase{
+				ImageName: "image",
+				JobTests:  []latest.JobTest{{Name: test.name}},
+			}
+
+			errs := validateJobTests([]*latest.TestCase{testCase})
+			t.CheckDeepEqual(test.expectedErrors, len(errs))
+		})
+	}
+}
+
+func TestValidateReadinessRules(t *testing.T) {
+	tests := []struct {
+		description    string
+		statusCheck    *latest.StatusCheckConfig
+		expectedErrors int
+	}{
+		{
+			description: "no status check config",
+		},
+		{
+			description: "valid rules",
+			statusCheck: &latest.StatusCheckConfig{ReadinessRules: []latest.ReadinessRule{
+				{
+					Group:   "cert-manager.io",
+					Kind:    "Certificate",
+					Success: []latest.ResourceCondition{{JSONPath: `{.status.conditions[?(@.type=="Ready")].status}`, Value: "True"}},
+					Failure: []latest.ResourceCondition{{JSONPath: "{.status.failedIssuanceAttempts}", MessagePath: "{.status.conditions[0].message}"}},
+				},
+				{Kind: "PersistentVolumeClaim", Success: []latest.ResourceCondition{{JSONPath: "{.status.phase}", Value: "Bound"}}},
+			}},
+		},
+		{
+			description: "duplicate rules",
+			statusCheck: &latest.StatusCheckConfig{ReadinessRules: []latest.ReadinessRule{
+				{Group: "cert-manager.io", Kind: "Certificate"},
+				{Group: "cert-manager.io", Kind: "Certificate"},
+			}},
+			expectedErrors: 1,
+		},
+		{
+			description: "duplicate rules with a different case",
+			statusCheck: &latest.StatusCheckConfig{ReadinessRules: []latest.ReadinessRule{
+				{Group: "cert-manager.io", Kind: "Certificate"},
+				{Group: "Cert-Manager.io", Kind: "certificate"},
+			}},
+			expectedErrors: 1,
+		},
+		{
+			description: "invalid JSONPath",
+			statusCheck: &latest.StatusCheckConfig{ReadinessRules: []latest.ReadinessRule{
+				{
+					Kind:    "PersistentVolumeClaim",
+					Success: []latest.ResourceCondition{{JSONPath: "{.status.phase", MessagePath: "{.status.message"}},
+				},
+			}},
+			expectedErrors: 2,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateReadinessRules(test.statusCheck)
+			t.CheckDeepEqual(test.expectedErrors, len(errs))
+		})
+	}
+}
@@ -515,6 +515,8 @@ func withClusterBuild(secretName, mountPath, namespace, secret string, timeout s
 			PullSecretPath:      secret,
 			PullSecretMountPath: mountPath,
 			Timeout:             timeout,
+			MavenImage:          "maven:3-openjdk-11",
+			GradleImage:         "gradle:jdk11",
 		}}}
 		for _, op := range ops {
 			op(&b)
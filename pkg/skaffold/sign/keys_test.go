@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLoadPrivateKey(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	sec1, _ := x509.MarshalECPrivateKey(ecKey)
+	pkcs8, _ := x509.MarshalPKCS8PrivateKey(ecKey)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 1024)
+	rsaPKCS8, _ := x509.MarshalPKCS8PrivateKey(rsaKey)
+
+	tests := []struct {
+		description string
+		content     string
+		shouldErr   bool
+	}{
+		{
+			description: "SEC 1",
+			content:     string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1})),
+		},
+		{
+			description: "PKCS #8",
+			content:     string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+		},
+		{
+			description: "RSA",
+			content:     string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rsaPKCS8})),
+			shouldErr:   true,
+		},
+		{
+			description: "encrypted cosign key",
+			content:     string(pem.EncodeToMemory(&pem.Block{Type: encryptedCosignKey, Bytes: []byte("encrypted")})),
+			shouldErr:   true,
+		},
+		{
+			description: "not PEM",
+			content:     "not a key",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("key", test.content)
+
+			key, err := loadPrivateKey(tmpDir.Path("key"))
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckTrue(key.PublicKey.Equal(&ecKey.PublicKey))
+			}
+		})
+	}
+}
+
+func TestLoadPublicKey(t *testing.T) {
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	pkix, _ := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+
+	testutil.Run(t, "PKIX", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("key.pub", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix})))
+
+		key, err := loadPublicKey(tmpDir.Path("key.pub"))
+
+		t.CheckNoError(err)
+		t.CheckTrue(key.Equal(&ecKey.PublicKey))
+	})
+	testutil.Run(t, "missing file", func(t *testutil.T) {
+		_, err := loadPublicKey("missing.pub")
+
+		t.CheckError(true, err)
+	})
+}
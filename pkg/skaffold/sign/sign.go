@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+type signer struct {
+	cfg  Config
+	keys map[string]*ecdsa.PrivateKey
+}
+
+// Sign signs the pushed images of the pipelines that have `build.sign` set.
+// Images that were not pushed to a registry are skipped since they can't carry a signature.
+func (s *signer) Sign(ctx context.Context, out io.Writer, builds []build.Artifact) error {
+	for _, b := range builds {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		p, found := s.cfg.PipelineForImage(b.ImageName)
+		if !found || p.Build.Sign == nil {
+			continue
+		}
+
+		d, err := name.NewDigest(b.Tag, name.WeakValidation)
+		if err != nil {
+			logrus.Debugf("Not signing %s since it wasn't pushed", b.Tag)
+			continue
+		}
+
+		key, err := s.key(p.Build.Sign.Key)
+		if err != nil {
+			return fmt.Errorf("loading signing key: %w", err)
+		}
+
+		signed, err := sign(d, key, s.cfg)
+		if err != nil {
+			return fmt.Errorf("signing %s: %w", b.Tag, err)
+		}
+		if signed {
+			color.Default.Fprintf(out, "Signed %s as %s\n", b.ImageName, signatureTag(d))
+		}
+	}
+
+	return nil
+}
+
+func (s *signer) key(path string) (*ecdsa.PrivateKey, error) {
+	if key, found := s.keys[path]; found {
+		return key, nil
+	}
+	key, err := loadPrivateKey(path)
+	if err != nil {
+		return nil, err
+	}
+	if s.keys == nil {
+		s.keys = map[string]*ecdsa.PrivateKey{}
+	}
+	s.keys[path] = key
+	return key, nil
+}
+
+// sign adds a signature to the signature image of the given image, unless the image
+// is already signed with the same key. It returns true if a signature was pushed.
+func sign(d name.Digest, key *ecdsa.PrivateKey, cfg Config) (bool, error) {
+	doc, err := newPayload(d)
+	if err != nil {
+		return false, err
+	}
+
+	img, sigs, err := signatures(d, cfg)
+	switch {
+	case isNotFound(err):
+		img = empty.Image
+	case err != nil:
+		return false, fmt.Errorf("reading existing signatures: %w", err)
+	}
+	for _, sig := range sigs {
+		if bytes.Equal(sig.payload, doc) && sig.verify(&key.PublicKey, d) == nil {
+			logrus.Debugf("%s is already signed", d)
+			return false, nil
+		}
+	}
+
+	h := sha256.Sum256(doc)
+	raw, err := ecdsa.SignASN1(rand.Reader, key, h[:])
+	if err != nil {
+		return false, err
+	}
+
+	img, err = mutate.Append(img, mutate.Addendum{
+		Layer:       docker.NewStaticLayer(doc, simpleSigningMediaType),
+		Annotations: map[string]string{signatureAnnotation: base64.StdEncoding.EncodeToString(raw)},
+		MediaType:   simpleSigningMediaType,
+	})
+	if err != nil {
+		return false, err
+	}
+	img = mutate.MediaType(img, types.OCIManifestSchema1)
+
+	if _, err := pushImage(img, signatureTag(d), cfg); err != nil {
+		return false, err
+	}
+	return true, nil
+}
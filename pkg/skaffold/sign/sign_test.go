@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const testDigest = "sha256:d3d0c5ab7f1b7b9e5b8d1ea2a0a1c5f0c2c8b6a1f0e6d2b3c4a5968778695a4b"
+
+type mockConfig struct {
+	runcontext.RunContext // Embedded to provide the default values.
+	pipeline              latest.Pipeline
+}
+
+func (c *mockConfig) PipelineForImage(string) (latest.Pipeline, bool) {
+	return c.pipeline, true
+}
+
+// fakeRegistry stores the pushed images in memory.
+type fakeRegistry map[string]v1.Image
+
+func (r fakeRegistry) push(img v1.Image, tag string, _ docker.Config) (string, error) {
+	r[tag] = img
+	return "", nil
+}
+
+func (r fakeRegistry) get(tag string, _ docker.Config) (v1.Image, error) {
+	if img, found := r[tag]; found {
+		return img, nil
+	}
+	return nil, &transport.Error{StatusCode: http.StatusNotFound}
+}
+
+func writeKeys(t *testutil.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	t.CheckNoError(err)
+	private, err := x509.MarshalECPrivateKey(key)
+	t.CheckNoError(err)
+	public, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	t.CheckNoError(err)
+
+	tmpDir := t.NewTempDir().
+		Write("cosign.key", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: private}))).
+		Write("cosign.pub", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public})))
+	return tmpDir.Path("cosign.key"), tmpDir.Path("cosign.pub")
+}
+
+func TestSign(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		registry := fakeRegistry{}
+		t.Override(&pushImage, registry.push)
+		t.Override(&getRemoteImage, registry.get)
+		privateKey, publicKey := writeKeys(t)
+
+		signCfg := &mockConfig{pipeline: latest.Pipeline{Build: latest.BuildConfig{Sign: &latest.SignConfig{Key: privateKey}}}}
+		builds := []build.Artifact{
+			{ImageName: "gcr.io/project/app", Tag: "gcr.io/project/app:v1@" + testDigest},
+			{ImageName: "local", Tag: "local:v1"},
+		}
+
+		// Signing twice with the same key only pushes one signature.
+		for i := 0; i < 2; i++ {
+			err := NewSigner(signCfg).Sign(context.Background(), ioutil.Discard, builds)
+			t.CheckNoError(err)
+		}
+
+		t.CheckDeepEqual(1, len(registry))
+		sigImage := registry["gcr.io/project/app:sha256-d3d0c5ab7f1b7b9e5b8d1ea2a0a1c5f0c2c8b6a1f0e6d2b3c4a5968778695a4b.sig"]
+		t.CheckTrue(sigImage != nil)
+		manifest, err := sigImage.Manifest()
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(manifest.Layers))
+		t.CheckDeepEqual(simpleSigningMediaType, string(manifest.Layers[0].MediaType))
+		t.CheckTrue(manifest.Layers[0].Annotations[signatureAnnotation] != "")
+
+		verifyCfg := &mockConfig{pipeline: latest.Pipeline{Deploy: latest.DeployConfig{Verify: &latest.VerifyConfig{Key: publicKey}}}}
+		err = NewVerifier(verifyCfg).Verify(context.Background(), ioutil.Discard, builds[:1])
+		t.CheckNoError(err)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	image := "gcr.io/project/app:v1@" + testDigest
+
+	tests := []struct {
+		description   string
+		signWithOther bool
+		signOther     bool
+		signOtherRepo bool
+		unsigned      bool
+		tag           string
+		expectedErr   string
+	}{
+		{
+			description: "signed",
+		},
+		{
+			description: "signed, referenced by tag",
+			tag:         "gcr.io/project/app:v1",
+		},
+		{
+			description: "unsigned",
+			unsigned:    true,
+			expectedErr: `no signature found for image "` + image + `"`,
+		},
+		{
+			description:   "signed with another key",
+			signWithOther: true,
+			expectedErr:   `no valid signature found for image "` + image + `"`,
+		},
+		{
+			description: "signature of another image",
+			signOther:   true,
+			expectedErr: `no valid signature found for image "` + image + `"`,
+		},
+		{
+			description:   "signature of the same digest in another repository",
+			signOtherRepo: true,
+			expectedErr:   `no valid signature found for image "` + image + `"`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			registry := fakeRegistry{}
+			t.Override(&pushImage, registry.push)
+			t.Override(&getRemoteImage, registry.get)
+			t.Override(&remoteDigest, func(string, docker.Config) (string, error) { return testDigest, nil })
+			privateKey, publicKey := writeKeys(t)
+			if test.signWithOther {
+				privateKey, _ = writeKeys(t)
+			}
+
+			if !test.unsigned {
+				key, err := loadPrivateKey(privateKey)
+				t.CheckNoError(err)
+				d, err := resolveDigest(image, &mockConfig{})
+				t.CheckNoError(err)
+				switch {
+				case test.signOther, test.signOtherRepo:
+					// push a valid signature of another image under the tag of the image.
+					otherImage := "gcr.io/project/app@sha256:0000000000000000000000000000000000000000000000000000000000000000"
+					if test.signOtherRepo {
+						otherImage = "gcr.io/other/app@" + testDigest
+					}
+					other, err := resolveDigest(otherImage, &mockConfig{})
+					t.CheckNoError(err)
+					_, err = sign(other, key, &mockConfig{})
+					t.CheckNoError(err)
+					registry[signatureTag(d)] = registry[signatureTag(other)]
+				default:
+					_, err = sign(d, key, &mockConfig{})
+					t.CheckNoError(err)
+				}
+			}
+
+			tag := image
+			if test.tag != "" {
+				tag = test.tag
+			}
+			cfg := &mockConfig{pipeline: latest.Pipeline{Deploy: latest.DeployConfig{Verify: &latest.VerifyConfig{Key: publicKey}}}}
+			err := NewVerifier(cfg).Verify(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "gcr.io/project/app", Tag: tag}})
+
+			if test.expectedErr == "" {
+				t.CheckNoError(err)
+			} else {
+				t.CheckErrorContains(test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestVerifyDisabled(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&getRemoteImage, func(string, docker.Config) (v1.Image, error) {
+			return nil, fmt.Errorf("should not be called")
+		})
+
+		err := NewVerifier(&mockConfig{}).Verify(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "app", Tag: "app:v1@" + testDigest}})
+
+		t.CheckNoError(err)
+	})
+}
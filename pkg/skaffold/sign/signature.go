@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+const (
+	// simpleSigningMediaType is the media type of the signed payloads, as defined by `cosign`.
+	simpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// signatureAnnotation is the layer annotation that holds the base64 encoded signature of the payload.
+	signatureAnnotation = "dev.cosignproject.cosign/signature"
+	signatureType       = "cosign container image signature"
+)
+
+// for testing
+var (
+	pushImage      = docker.PushImage
+	getRemoteImage = docker.GetRemoteImage
+	remoteDigest   = docker.RemoteDigest
+)
+
+// payload is the document that gets signed, in the "simple signing" format used by `cosign`.
+type payload struct {
+	Critical critical               `json:"critical"`
+	Optional map[string]interface{} `json:"optional"`
+}
+
+type critical struct {
+	Identity identity `json:"identity"`
+	Image    image    `json:"image"`
+	Type     string   `json:"type"`
+}
+
+type identity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type image struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+func newPayload(d name.Digest) ([]byte, error) {
+	return json.Marshal(payload{
+		Critical: critical{
+			Identity: identity{DockerReference: d.Context().Name()},
+			Image:    image{DockerManifestDigest: d.DigestStr()},
+			Type:     signatureType,
+		},
+	})
+}
+
+// signature is a signed payload, as stored in a layer of the signature image.
+type signature struct {
+	payload []byte
+	value   string
+}
+
+func (s signature) verify(key *ecdsa.PublicKey, d name.Digest) error {
+	raw, err := base64.StdEncoding.DecodeString(s.value)
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+	h := sha256.Sum256(s.payload)
+	if !ecdsa.VerifyASN1(key, h[:], raw) {
+		return errors.New("signature doesn't match the key")
+	}
+
+	var p payload
+	if err := json.Unmarshal(s.payload, &p); err != nil {
+		return fmt.Errorf("parsing signed payload: %w", err)
+	}
+	if p.Critical.Type != signatureType {
+		return fmt.Errorf("unexpected signature type %q", p.Critical.Type)
+	}
+	if p.Critical.Image.DockerManifestDigest != d.DigestStr() {
+		return fmt.Errorf("signature is for digest %s", p.Critical.Image.DockerManifestDigest)
+	}
+	// A signature of the same digest pushed to another repository doesn't sign this image.
+	if p.Critical.Identity.DockerReference != d.Context().Name() {
+		return fmt.Errorf("signature is for repository %s", p.Critical.Identity.DockerReference)
+	}
+	return nil
+}
+
+// signatureTag returns the tag under which the signatures of an image are pushed, following the convention of `cosign`:
+// `<repository>:sha256-<image digest hex>.sig`.
+func signatureTag(d name.Digest) string {
+	return fmt.Sprintf("%s:%s.sig", d.Context().Name(), strings.Replace(d.DigestStr(), ":", "-", 1))
+}
+
+// resolveDigest returns the digest reference of an image. Images that are only referenced by tag
+// are looked up in their registry.
+func resolveDigest(tag string, cfg docker.Config) (name.Digest, error) {
+	if d, err := name.NewDigest(tag, name.WeakValidation); err == nil {
+		return d, nil
+	}
+
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	if err != nil {
+		return name.Digest{}, err
+	}
+	digest, err := remoteDigest(tag, cfg)
+	if err != nil {
+		return name.Digest{}, fmt.Errorf("resolving digest: %w", err)
+	}
+	return ref.Context().Digest(digest), nil
+}
+
+// signatures returns the image holding the signatures of an image, and the signatures it contains.
+func signatures(d name.Digest, cfg docker.Config) (v1.Image, []signature, error) {
+	img, err := getRemoteImage(signatureTag(d), cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, nil, err
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sigs []signature
+	for i, layer := range layers {
+		desc := manifest.Layers[i]
+		if desc.MediaType != simpleSigningMediaType {
+			continue
+		}
+		r, err := layer.Compressed()
+		if err != nil {
+			return nil, nil, err
+		}
+		content, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		sigs = append(sigs, signature{payload: content, value: desc.Annotations[signatureAnnotation]})
+	}
+	return img, sigs, nil
+}
+
+func isNotFound(err error) bool {
+	var tErr *transport.Error
+	return errors.As(err, &tErr) && tErr.StatusCode == http.StatusNotFound
+}
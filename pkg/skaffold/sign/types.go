@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"context"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// Signer signs the images that were just built and pushed.
+type Signer interface {
+	Sign(ctx context.Context, out io.Writer, builds []build.Artifact) error
+}
+
+// Verifier checks that images carry a valid signature before they are deployed.
+type Verifier interface {
+	Verify(ctx context.Context, out io.Writer, builds []build.Artifact) error
+}
+
+// Config is the configuration needed to sign and verify images.
+type Config interface {
+	docker.Config
+
+	PipelineForImage(imageName string) (latest.Pipeline, bool)
+}
+
+// NewSigner returns a Signer that uses the `build.sign` key of each artifact's pipeline.
+func NewSigner(cfg Config) Signer {
+	return &signer{cfg: cfg}
+}
+
+// NewVerifier returns a Verifier that uses the `deploy.verify` key of each artifact's pipeline.
+func NewVerifier(cfg Config) Verifier {
+	return &verifier{cfg: cfg}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sign
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+)
+
+type verifier struct {
+	cfg  Config
+	keys map[string]*ecdsa.PublicKey
+}
+
+// Verify checks that each image of the pipelines that have `deploy.verify` set
+// carries at least one signature that matches the configured public key.
+func (v *verifier) Verify(ctx context.Context, out io.Writer, builds []build.Artifact) error {
+	for _, b := range builds {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		p, found := v.cfg.PipelineForImage(b.ImageName)
+		if !found || p.Deploy.Verify == nil {
+			continue
+		}
+
+		key, err := v.key(p.Deploy.Verify.Key)
+		if err != nil {
+			return fmt.Errorf("loading verification key: %w", err)
+		}
+
+		if err := verify(b.Tag, key, v.cfg); err != nil {
+			return err
+		}
+		color.Default.Fprintf(out, "Verified signature of %s\n", b.Tag)
+	}
+
+	return nil
+}
+
+func (v *verifier) key(path string) (*ecdsa.PublicKey, error) {
+	if key, found := v.keys[path]; found {
+		return key, nil
+	}
+	key, err := loadPublicKey(path)
+	if err != nil {
+		return nil, err
+	}
+	if v.keys == nil {
+		v.keys = map[string]*ecdsa.PublicKey{}
+	}
+	v.keys[path] = key
+	return key, nil
+}
+
+func verify(tag string, key *ecdsa.PublicKey, cfg Config) error {
+	d, err := resolveDigest(tag, cfg)
+	if err != nil {
+		return fmt.Errorf("%s %q: %w", sErrors.ImageSignatureMissingErr, tag, err)
+	}
+
+	_, sigs, err := signatures(d, cfg)
+	if err != nil {
+		return fmt.Errorf("%s %q: %w", sErrors.ImageSignatureMissingErr, tag, err)
+	}
+	if len(sigs) == 0 {
+		return fmt.Errorf("%s %q: %s has no signature layer", sErrors.ImageSignatureMissingErr, tag, signatureTag(d))
+	}
+
+	for _, sig := range sigs {
+		err := sig.verify(key, d)
+		if err == nil {
+			return nil
+		}
+		logrus.Debugf("Ignoring signature of %s: %v", tag, err)
+	}
+	return fmt.Errorf("%s %q: none of its %d signature(s) match the verification key", sErrors.ImageSignatureInvalidErr, tag, len(sigs))
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"fmt"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+func jobCreateErr(name string, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("creating test job %s: %s", name, err),
+			ErrCode: proto.StatusCode_TEST_JOB_CREATE_ERR,
+		},
+	)
+}
+
+func jobFailedErr(name string, reason string) error {
+	return sErrors.NewError(fmt.Errorf("test job %s failed: %s", name, reason),
+		proto.ActionableErr{
+			Message: fmt.Sprintf("test job %s failed: %s", name, reason),
+			ErrCode: proto.StatusCode_TEST_JOB_FAILED_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_TEST_JOB_LOGS,
+					Action:         fmt.Sprintf("Check the logs of the test job %q", name),
+				},
+			},
+		},
+	)
+}
+
+func jobImagePullErr(name string, image string, reason string) error {
+	return sErrors.NewError(fmt.Errorf("test job %s could not pull image %s: %s", name, image, reason),
+		proto.ActionableErr{
+			Message: fmt.Sprintf("test job %s could not pull image %s: %s", name, image, reason),
+			ErrCode: proto.StatusCode_TEST_JOB_IMAGE_PULL_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_IMAGE,
+					Action:         fmt.Sprintf("Check that the image %s of the test job %q exists and can be pulled from the cluster", image, name),
+				},
+			},
+		},
+	)
+}
+
+func jobTimedOutErr(name string, timeoutSeconds int) error {
+	return sErrors.NewError(fmt.Errorf("test job %s timed out", name),
+		proto.ActionableErr{
+			Message: fmt.Sprintf("test job %s timed out", name),
+			ErrCode: proto.StatusCode_TEST_JOB_TIMEDOUT_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_FIX_TEST_JOB_TIMEOUT,
+					Action:         fmt.Sprintf("Fix the test job timeoutSeconds: %d", timeoutSeconds),
+				},
+			},
+		},
+	)
+}
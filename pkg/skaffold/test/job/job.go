@@ -0,0 +1,290 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	batchclient "k8s.io/client-go/kubernetes/typed/batch/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+const (
+	// defaultTimeout is how long to wait for a Job that doesn't set `timeoutSeconds`.
+	defaultTimeout = 10 * time.Minute
+
+	// jobNameLabel is set by the Job controller on the pods of a Job.
+	jobNameLabel = "job-name"
+
+	// Reasons why a container is waiting because its image cannot be pulled.
+	imagePullBackOff = "ImagePullBackOff"
+	errImagePull     = "ErrImagePull"
+)
+
+// for tests
+var pollInterval = time.Second
+
+// Config contains the configuration needed to run Kubernetes Job tests.
+type Config interface {
+	kubectl.Config
+	kubernetes.Config
+}
+
+// Runner runs a test as a Kubernetes Job, once the application is deployed.
+type Runner struct {
+	cfg       Config
+	imageName string
+	jobTest   latest.JobTest
+}
+
+// New creates a new job.Runner for a Job test of the given test case.
+func New(cfg Config, imageName string, jt latest.JobTest) *Runner {
+	return &Runner{
+		cfg:       cfg,
+		imageName: imageName,
+		jobTest:   jt,
+	}
+}
+
+// Test creates the Job in the deployed namespace, streams its logs and waits for it to complete.
+// The Job is deleted once it has completed.
+func (r *Runner) Test(ctx context.Context, out io.Writer, bRes []build.Artifact) error {
+	namespace, err := resolveNamespace(r.cfg.GetKubeNamespace())
+	if err != nil {
+		return err
+	}
+	client, err := kubernetesclient.Client()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout())
+	defer cancel()
+
+	image := resolveImage(r.image(), bRes)
+	color.Default.Fprintf(out, "Running test job %q with image %s in namespace %s\n", r.jobTest.Name, image, namespace)
+
+	logger := kubernetes.NewLogAggregator(out, kubectl.NewCLI(r.cfg, ""), []string{image}, nil, nil, r.cfg)
+	logger.SetSince(time.Now())
+
+	jobs := client.BatchV1().Jobs(namespace)
+	job, err := jobs.Create(ctx, r.jobSpec(image), metav1.CreateOptions{})
+	if err != nil {
+		return jobCreateErr(r.jobTest.Name, err)
+	}
+	defer func() {
+		propagation := metav1.DeletePropagationBackground
+		if err := jobs.Delete(context.Background(), job.Name, metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil {
+			logrus.Warnf("Unable to delete test job %s: %v", job.Name, err)
+		}
+	}()
+
+	pods := client.CoreV1().Pods(namespace)
+	pod, container, err := waitForContainerStart(ctx, pods, job.Name, r.jobTest.Name)
+	if err != nil {
+		return r.waitErr(ctx, err)
+	}
+
+	logsDone := make(chan struct{})
+	go func() {
+		defer close(logsDone)
+		logger.StreamContainerLogs(ctx, pod, container)
+	}()
+
+	failure, err := waitForCompletion(ctx, jobs, job.Name)
+	<-logsDone
+	if err != nil {
+		return r.waitErr(ctx, err)
+	}
+	if failure != "" {
+		color.Red.Fprintf(out, "Test job %q failed\n", r.jobTest.Name)
+		return jobFailedErr(r.jobTest.Name, failure)
+	}
+
+	color.Green.Fprintf(out, "Test job %q finished successfully\n", r.jobTest.Name)
+	return nil
+}
+
+// TestDependencies returns no dependencies: the Job tests are run again whenever the application is redeployed.
+func (r *Runner) TestDependencies() ([]string, error) {
+	return nil, nil
+}
+
+func (r *Runner) image() string {
+	if r.jobTest.Image != "" {
+		return r.jobTest.Image
+	}
+	return r.imageName
+}
+
+func (r *Runner) timeout() time.Duration {
+	if r.jobTest.TimeoutSeconds > 0 {
+		return time.Duration(r.jobTest.TimeoutSeconds) * time.Second
+	}
+	return defaultTimeout
+}
+
+func (r *Runner) waitErr(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return jobTimedOutErr(r.jobTest.Name, int(r.timeout().Seconds()))
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func (r *Runner) jobSpec(image string) *batchv1.Job {
+	var backoffLimit int32
+	labels := map[string]string{
+		label.RunIDLabel: label.NewLabeller(false, nil).GetRunID(),
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: r.jobTest.Name + "-",
+			Labels:       labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoffLimit,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					Containers: []v1.Container{{
+						Name:    r.jobTest.Name,
+						Image:   image,
+						Command: r.jobTest.Command,
+						Args:    r.jobTest.Args,
+						Env:     r.jobTest.Env,
+					}},
+				},
+			},
+		},
+	}
+}
+
+// resolveImage replaces the name of an image built by Skaffold with its tag.
+func resolveImage(image string, bRes []build.Artifact) string {
+	for _, b := range bRes {
+		if b.ImageName == image {
+			return b.Tag
+		}
+	}
+	return image
+}
+
+// waitForContainerStart waits for the test container of the Job's pod to be running or terminated,
+// and returns that pod and the status of the container.
+// It fails fast if the image of the container cannot be pulled.
+func waitForContainerStart(ctx context.Context, pods corev1.PodInterface, jobName, containerName string) (*v1.Pod, v1.ContainerStatus, error) {
+	var started *v1.Pod
+	var status v1.ContainerStatus
+	err := wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		list, err := pods.List(ctx, metav1.ListOptions{LabelSelector: jobNameLabel + "=" + jobName})
+		if err != nil {
+			return false, fmt.Errorf("listing pods of job %s: %w", jobName, err)
+		}
+		for i, pod := range list.Items {
+			for _, c := range pod.Status.ContainerStatuses {
+				if c.Name != containerName {
+					continue
+				}
+				if c.State.Waiting != nil && isImagePullFailure(c.State.Waiting.Reason) {
+					reason := c.State.Waiting.Message
+					if reason == "" {
+						reason = c.State.Waiting.Reason
+					}
+					return false, jobImagePullErr(containerName, c.Image, reason)
+				}
+				if c.State.Running != nil || c.State.Terminated != nil {
+					started, status = &list.Items[i], c
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}, ctx.Done())
+	return started, status, err
+}
+
+func isImagePullFailure(reason string) bool {
+	return reason == imagePullBackOff || reason == errImagePull
+}
+
+// waitForCompletion waits for the Job to complete, and returns the reason why it failed, if it did.
+func waitForCompletion(ctx context.Context, jobs batchclient.JobInterface, jobName string) (string, error) {
+	var failure string
+	err := wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		job, err := jobs.Get(ctx, jobName, metav1.GetOptions{})
+		if err != nil {
+			return false, fmt.Errorf("getting job %s: %w", jobName, err)
+		}
+		for _, c := range job.Status.Conditions {
+			if c.Status != v1.ConditionTrue {
+				continue
+			}
+			switch c.Type {
+			case batchv1.JobComplete:
+				return true, nil
+			case batchv1.JobFailed:
+				failure = c.Message
+				if failure == "" {
+					failure = c.Reason
+				}
+				return true, nil
+			}
+		}
+		return false, nil
+	}, ctx.Done())
+	return failure, err
+}
+
+func resolveNamespace(ns string) (string, error) {
+	if ns != "" {
+		return ns, nil
+	}
+	cfg, err := kubectx.CurrentConfig()
+	if err != nil {
+		return "", fmt.Errorf("getting kubeconfig: %w", err)
+	}
+
+	current, present := cfg.Contexts[cfg.CurrentContext]
+	if present && current.Namespace != "" {
+		return current.Namespace, nil
+	}
+	return "default", nil
+}
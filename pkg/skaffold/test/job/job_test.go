@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package job
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/label"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockConfig struct{}
+
+func (mockConfig) GetKubeContext() string   { return "kubecontext" }
+func (mockConfig) GetKubeConfig() string    { return "" }
+func (mockConfig) GetKubeNamespace() string { return "ns" }
+func (mockConfig) PipelineForImage(string) (latest.Pipeline, bool) {
+	return latest.Pipeline{}, false
+}
+func (mockConfig) DefaultPipeline() latest.Pipeline {
+	return latest.Pipeline{Deploy: latest.DeployConfig{Logs: latest.LogsConfig{Prefix: "container"}}}
+}
+
+func TestJobRunner(t *testing.T) {
+	tests := []struct {
+		description    string
+		jobTest        latest.JobTest
+		condition      *batchv1.JobCondition
+		createErr      error
+		podStarted     bool
+		podWaiting     string
+		expectedImage  string
+		expectedOutput string
+		expectedCode   proto.StatusCode
+	}{
+		{
+			description:    "job succeeds",
+			jobTest:        latest.JobTest{Name: "it"},
+			condition:      &batchv1.JobCondition{Type: batchv1.JobComplete, Status: v1.ConditionTrue},
+			podStarted:     true,
+			expectedImage:  "app:tag",
+			expectedOutput: "[it] fake logs",
+		},
+		{
+			description:    "other image",
+			jobTest:        latest.JobTest{Name: "it", Image: "tests"},
+			condition:      &batchv1.JobCondition{Type: batchv1.JobComplete, Status: v1.ConditionTrue},
+			podStarted:     true,
+			expectedImage:  "tests:tag",
+			expectedOutput: "[it] fake logs",
+		},
+		{
+			description:   "image not built by skaffold",
+			jobTest:       latest.JobTest{Name: "it", Image: "busybox"},
+			condition:     &batchv1.JobCondition{Type: batchv1.JobComplete, Status: v1.ConditionTrue},
+			podStarted:    true,
+			expectedImage: "busybox",
+		},
+		{
+			description:    "job fails",
+			jobTest:        latest.JobTest{Name: "it"},
+			condition:      &batchv1.JobCondition{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+			podStarted:     true,
+			expectedImage:  "app:tag",
+			expectedOutput: "[it] fake logs",
+			expectedCode:   proto.StatusCode_TEST_JOB_FAILED_ERR,
+		},
+		{
+			description:   "pod never starts",
+			jobTest:       latest.JobTest{Name: "it", TimeoutSeconds: 1},
+			expectedImage: "app:tag",
+			expectedCode:  proto.StatusCode_TEST_JOB_TIMEDOUT_ERR,
+		},
+		{
+			description:   "job never completes",
+			jobTest:       latest.JobTest{Name: "it", TimeoutSeconds: 1},
+			podStarted:    true,
+			expectedImage: "app:tag",
+			expectedCode:  proto.StatusCode_TEST_JOB_TIMEDOUT_ERR,
+		},
+		{
+			description:   "image cannot be pulled",
+			jobTest:       latest.JobTest{Name: "it", Image: "unknown"},
+			podWaiting:    "ImagePullBackOff",
+			expectedImage: "unknown",
+			expectedCode:  proto.StatusCode_TEST_JOB_IMAGE_PULL_ERR,
+		},
+		{
+			description:   "image cannot be pulled yet",
+			jobTest:       latest.JobTest{Name: "it", Image: "unknown"},
+			podWaiting:    "ErrImagePull",
+			expectedImage: "unknown",
+			expectedCode:  proto.StatusCode_TEST_JOB_IMAGE_PULL_ERR,
+		},
+		{
+			description:  "job cannot be created",
+			jobTest:      latest.JobTest{Name: "it"},
+			createErr:    errors.New("forbidden"),
+			expectedCode: proto.StatusCode_TEST_JOB_CREATE_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var objects []runtime.Object
+			if test.podStarted {
+				objects = append(objects, &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "it-abcde-xyz", Namespace: "ns", Labels: map[string]string{"job-name": "it-abcde"}},
+					Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{{
+						Name:  "it",
+						State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{}},
+					}}},
+				})
+				t.Override(&util.DefaultExecCommand, testutil.CmdRunWithOutput(
+					"kubectl --context kubecontext --namespace ns logs --since=1s -f it-abcde-xyz -c it --namespace ns",
+					"fake logs\n",
+				))
+			}
+			if test.podWaiting != "" {
+				objects = append(objects, &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "it-abcde-xyz", Namespace: "ns", Labels: map[string]string{"job-name": "it-abcde"}},
+					Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{{
+						Name:  "it",
+						Image: test.expectedImage,
+						State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: test.podWaiting}},
+					}}},
+				})
+			}
+			client := fakekubeclientset.NewSimpleClientset(objects...)
+			var created *batchv1.Job
+			client.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if test.createErr != nil {
+					return true, nil, test.createErr
+				}
+				created = action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+				created.Name = created.GenerateName + "abcde"
+				if test.condition != nil {
+					created.Status.Conditions = []batchv1.JobCondition{*test.condition}
+				}
+				return false, nil, nil
+			})
+			t.Override(&kubernetesclient.Client, func() (kubernetes.Interface, error) { return client, nil })
+			t.Override(&pollInterval, 10*time.Millisecond)
+
+			var out bytes.Buffer
+			err := New(mockConfig{}, "app", test.jobTest).Test(context.Background(), &out, []build.Artifact{
+				{ImageName: "app", Tag: "app:tag"},
+				{ImageName: "tests", Tag: "tests:tag"},
+			})
+
+			if test.expectedCode != proto.StatusCode_OK {
+				t.CheckError(true, err)
+				var sErr sErrors.Error
+				t.CheckTrue(errors.As(err, &sErr))
+				t.CheckDeepEqual(test.expectedCode, sErr.StatusCode())
+			} else {
+				t.CheckNoError(err)
+			}
+			t.CheckContains(test.expectedOutput, out.String())
+			if created != nil {
+				t.CheckDeepEqual(test.expectedImage, created.Spec.Template.Spec.Containers[0].Image)
+				t.CheckDeepEqual(v1.RestartPolicyNever, created.Spec.Template.Spec.RestartPolicy)
+				runID := label.NewLabeller(false, nil).GetRunID()
+				t.CheckDeepEqual(runID, created.Labels[label.RunIDLabel])
+				t.CheckDeepEqual(runID, created.Spec.Template.Labels[label.RunIDLabel])
+			}
+
+			jobs, err := client.BatchV1().Jobs("ns").List(context.Background(), metav1.ListOptions{})
+			t.CheckNoError(err)
+			t.CheckEmpty(jobs.Items)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+)
+
+const (
+	structureTestType = "structure"
+	customTestType    = "custom"
+	jobTestType       = "job"
+)
+
+var colorCodes = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// Result is the result of a single test, run against a single artifact.
+type Result struct {
+	Name     string
+	Type     string
+	Image    string
+	Status   string // one of Succeeded, Failed or Skipped
+	Duration time.Duration
+	ExitCode int
+	Err      error
+	Output   string
+}
+
+type jsonReport struct {
+	Tests []jsonResult `json:"tests"`
+}
+
+type jsonResult struct {
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Image    string  `json:"image,omitempty"`
+	Status   string  `json:"status"`
+	Duration float64 `json:"durationSeconds"`
+	ExitCode int     `json:"exitCode,omitempty"`
+	ErrCode  string  `json:"errCode,omitempty"`
+	Error    string  `json:"error,omitempty"`
+	Output   string  `json:"output,omitempty"`
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *struct{}     `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// wasBuilt tells whether the artifact under test was built. Structure tests are skipped otherwise.
+func (r testRun) wasBuilt(bRes []build.Artifact) bool {
+	if r.testType != structureTestType {
+		return true
+	}
+	for _, b := range bRes {
+		if b.ImageName == r.image {
+			return true
+		}
+	}
+	return false
+}
+
+// writeReports writes the test results as a JUnit XML report and as a JSON report.
+func writeReports(dir, name string, results []Result) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating test report directory %q: %w", dir, err)
+	}
+
+	junit, err := xml.MarshalIndent(junitReport(name, results), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".xml"), append([]byte(xml.Header), junit...), 0644); err != nil {
+		return fmt.Errorf("writing JUnit test report: %w", err)
+	}
+
+	js, err := json.MarshalIndent(jsonReportOf(results), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".json"), js, 0644); err != nil {
+		return fmt.Errorf("writing JSON test report: %w", err)
+	}
+	return nil
+}
+
+func junitReport(name string, results []Result) junitTestSuites {
+	suite := junitTestSuite{
+		Name:  name,
+		Tests: len(results),
+	}
+
+	var total time.Duration
+	for _, r := range results {
+		total += r.Duration
+
+		className := r.Image
+		if className == "" {
+			className = r.Type
+		}
+		tc := junitTestCase{
+			Name:      r.Name,
+			ClassName: className,
+			Time:      seconds(r.Duration),
+			SystemOut: r.Output,
+		}
+		switch r.Status {
+		case event.Failed:
+			suite.Failures++
+			tc.Failure = &junitFailure{
+				Message: r.Err.Error(),
+				Type:    errCode(r.Err),
+				Text:    r.Output,
+			}
+		case event.Skipped:
+			suite.Skipped++
+			tc.Skipped = &struct{}{}
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	suite.Time = seconds(total)
+
+	return junitTestSuites{Suites: []junitTestSuite{suite}}
+}
+
+func jsonReportOf(results []Result) jsonReport {
+	report := jsonReport{Tests: []jsonResult{}}
+	for _, r := range results {
+		jr := jsonResult{
+			Name:     r.Name,
+			Type:     r.Type,
+			Image:    r.Image,
+			Status:   r.Status,
+			Duration: r.Duration.Seconds(),
+			ExitCode: r.ExitCode,
+			Output:   r.Output,
+		}
+		if r.Err != nil {
+			jr.ErrCode = errCode(r.Err)
+			jr.Error = r.Err.Error()
+		}
+		report.Tests = append(report.Tests, jr)
+	}
+	return report
+}
+
+func errCode(err error) string {
+	return sErrors.ActionableErr(sErrors.Test, err).ErrCode.String()
+}
+
+// exitCode returns the exit code of the test command that failed, if any.
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return 0
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+func stripColors(s string) string {
+	return colorCodes.ReplaceAllString(s, "")
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type fakeRunner struct {
+	output string
+	err    error
+}
+
+func (r fakeRunner) Test(_ context.Context, out io.Writer, _ []build.Artifact) error {
+	color.Green.Fprintln(out, r.output)
+	return r.err
+}
+
+func (r fakeRunner) TestDependencies() ([]string, error) { return nil, nil }
+
+func TestRunTests(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		runs := []testRun{
+			{runner: fakeRunner{output: "ok"}, name: "unit", testType: customTestType, image: "app"},
+			{runner: fakeRunner{output: "not built"}, name: "structure.yaml", testType: structureTestType, image: "other"},
+			{runner: fakeRunner{output: "ko", err: errors.New("boom")}, name: "lint", testType: customTestType, image: "app"},
+			{runner: fakeRunner{output: "never run"}, name: "e2e", testType: customTestType, image: "app"},
+		}
+
+		defer color.SetupColors(nil, color.DefaultColorCode, false)
+		var out bytes.Buffer
+		results, err := runTests(context.Background(), color.SetupColors(&out, color.DefaultColorCode, true), []build.Artifact{{ImageName: "app", Tag: "app:tag"}}, runs)
+
+		t.CheckErrorContains("running tests: boom", err)
+		t.CheckDeepEqual(4, len(results))
+		t.CheckDeepEqual([]string{event.Succeeded, event.Skipped, event.Failed, event.Skipped},
+			[]string{results[0].Status, results[1].Status, results[2].Status, results[3].Status})
+		t.CheckDeepEqual("ok\n", results[0].Output)
+		t.CheckDeepEqual("ko\n", results[2].Output)
+		t.CheckDeepEqual("boom", results[2].Err.Error())
+		t.CheckEmpty(results[3].Output)
+
+		// Colors are kept on the console, but not in the captured output.
+		t.CheckContains("\x1b[", out.String())
+		t.CheckContains("ok", out.String())
+	})
+}
+
+func TestWriteReports(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		dir := t.NewTempDir()
+		results := []Result{
+			{Name: "unit", Type: customTestType, Image: "app", Status: event.Succeeded, Duration: 1500 * time.Millisecond, Output: "ok"},
+			{Name: "lint", Type: customTestType, Image: "app", Status: event.Failed, Duration: time.Second, ExitCode: 2, Err: errors.New("boom"), Output: "ko"},
+			{Name: "e2e", Type: jobTestType, Status: event.Skipped},
+		}
+
+		err := writeReports(dir.Path("reports"), "tests", results)
+		t.CheckNoError(err)
+
+		junit, err := ioutil.ReadFile(dir.Path("reports/tests.xml"))
+		t.CheckNoError(err)
+		t.CheckContains(`<testsuite name="tests" tests="3" failures="1" skipped="1" time="2.500">`, string(junit))
+		t.CheckContains(`<testcase name="unit" classname="app" time="1.500">`, string(junit))
+		t.CheckContains(`<failure message="boom" type="UNKNOWN_ERROR">ko</failure>`, string(junit))
+		t.CheckContains(`<testcase name="e2e" classname="job" time="0.000">`, string(junit))
+		t.CheckContains(`<skipped></skipped>`, string(junit))
+
+		js, err := ioutil.ReadFile(dir.Path("reports/tests.json"))
+		t.CheckNoError(err)
+		var report jsonReport
+		t.CheckNoError(json.Unmarshal(js, &report))
+		t.CheckDeepEqual(jsonReport{Tests: []jsonResult{
+			{Name: "unit", Type: customTestType, Image: "app", Status: event.Succeeded, Duration: 1.5, Output: "ok"},
+			{Name: "lint", Type: customTestType, Image: "app", Status: event.Failed, Duration: 1, ExitCode: 2, ErrCode: "UNKNOWN_ERROR", Error: "boom", Output: "ko"},
+			{Name: "e2e", Type: jobTestType, Status: event.Skipped},
+		}}, report)
+	})
+}
+
+func TestExitCode(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.CheckDeepEqual(0, exitCode(errors.New("not a command")))
+		t.CheckDeepEqual(0, exitCode(fmt.Errorf("wrapped: %w", errors.New("not a command"))))
+	})
+}
@@ -21,22 +21,32 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"regexp"
+	"time"
+
+	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/logfile"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/job"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/structure"
 )
 
 type Config interface {
 	docker.Config
+	job.Config
 
 	TestCases() []*latest.TestCase
 	GetWorkingDir() string
+	GetKubeNamespace() string
+	TestReportDir() string
+	TestFilter() string
 	Muted() config.Muted
 }
 
@@ -44,21 +54,31 @@ type Config interface {
 // and returns a Tester instance with all the necessary test runners
 // to run all specified tests.
 func NewTester(cfg Config, imagesAreLocal func(imageName string) (bool, error)) (Tester, error) {
-	runner, err := getRunner(cfg, imagesAreLocal, cfg.TestCases())
+	var filter *regexp.Regexp
+	if cfg.TestFilter() != "" {
+		var err error
+		if filter, err = regexp.Compile(cfg.TestFilter()); err != nil {
+			return nil, fmt.Errorf("invalid test filter %q: %w", cfg.TestFilter(), err)
+		}
+	}
+
+	runners, deployedRunners, err := getRunners(cfg, imagesAreLocal, cfg.TestCases(), filter)
 	if err != nil {
 		return nil, err
 	}
 
 	return FullTester{
-		runners: runner,
-		muted:   cfg.Muted(),
+		runners:         runners,
+		deployedRunners: deployedRunners,
+		muted:           cfg.Muted(),
+		reportDir:       cfg.TestReportDir(),
 	}, nil
 }
 
 // TestDependencies returns the watch dependencies to the runner.
 func (t FullTester) TestDependencies() ([]string, error) {
 	var deps []string
-	for _, tester := range t.runners {
+	for _, tester := range append(t.runners, t.deployedRunners...) {
 		result, err := tester.TestDependencies()
 		if err != nil {
 			return nil, err
@@ -68,6 +88,20 @@ func (t FullTester) TestDependencies() ([]string, error) {
 	return deps, nil
 }
 
+// Tests describes the tests that run before deploying.
+func (t FullTester) Tests() []Info {
+	var tests []Info
+	for i, run := range t.runners {
+		tests = append(tests, Info{
+			ID:           i,
+			Name:         run.name,
+			Image:        run.image,
+			Dependencies: run.TestDependencies,
+		})
+	}
+	return tests
+}
+
 // Test is the top level testing execution call. It serves as the
 // entrypoint to all individual tests.
 func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []build.Artifact) error {
@@ -76,11 +110,64 @@ func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []build.Artifa
 	}
 
 	color.Default.Fprintln(out, "Testing images...")
+	return t.run(ctx, out, bRes, "tests", t.runners)
+}
 
+// TestSelected runs the selected tests, among those that run before deploying.
+// It's used by `dev` to only rerun the tests whose artifact or dependencies have changed.
+func (t FullTester) TestSelected(ctx context.Context, out io.Writer, bRes []build.Artifact, ids []int) error {
+	var runs []testRun
+	for _, id := range ids {
+		if id >= 0 && id < len(t.runners) {
+			runs = append(runs, t.runners[id])
+		}
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintln(out, "Testing images...")
+	return t.run(ctx, out, bRes, "tests", runs)
+}
+
+// TestDeployed runs the tests that need the application to be deployed, like Kubernetes Job tests.
+func (t FullTester) TestDeployed(ctx context.Context, out io.Writer, bRes []build.Artifact) error {
+	if len(t.deployedRunners) == 0 {
+		return nil
+	}
+
+	color.Default.Fprintln(out, "Testing deployed application...")
+	event.TestInProgress()
+	if err := t.run(ctx, out, bRes, "deployed-tests", t.deployedRunners); err != nil {
+		event.TestFailed(err)
+		return err
+	}
+	event.TestComplete()
+	return nil
+}
+
+// run runs the tests and reports their results, as events and, if a report directory is configured,
+// as `<reportName>.xml` and `<reportName>.json` files.
+func (t FullTester) run(ctx context.Context, out io.Writer, bRes []build.Artifact, reportName string, runs []testRun) error {
+	results, err := t.runMuted(ctx, out, bRes, runs)
+
+	for _, r := range results {
+		event.TestResult(r.Status, r.Name, r.Type, r.Image, r.Duration, r.Output, r.Err)
+	}
+	if t.reportDir != "" {
+		if err := writeReports(t.reportDir, reportName, results); err != nil {
+			logrus.Warnf("Unable to write test reports: %v", err)
+		}
+	}
+
+	return err
+}
+
+func (t FullTester) runMuted(ctx context.Context, out io.Writer, bRes []build.Artifact, runs []testRun) ([]Result, error) {
 	if t.muted.MuteTest() {
 		file, err := logfile.Create("test.log")
 		if err != nil {
-			return fmt.Errorf("unable to create log file for tests: %w", err)
+			return nil, fmt.Errorf("unable to create log file for tests: %w", err)
 		}
 		fmt.Fprintln(out, " - writing logs to", file.Name())
 
@@ -89,7 +176,7 @@ func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []build.Artifa
 		w := io.MultiWriter(file, &buf)
 
 		// Run the tests.
-		err = t.runTests(ctx, w, bRes)
+		results, err := runTests(ctx, w, bRes, runs)
 
 		// After the test finish, close the log file. If the tests failed, print the full log to the console.
 		file.Close()
@@ -97,39 +184,102 @@ func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []build.Artifa
 			buf.WriteTo(out)
 		}
 
-		return err
+		return results, err
 	}
 
-	return t.runTests(ctx, out, bRes)
+	return runTests(ctx, out, bRes, runs)
 }
 
-func (t FullTester) runTests(ctx context.Context, out io.Writer, bRes []build.Artifact) error {
-	for _, tester := range t.runners {
-		if err := tester.Test(ctx, out, bRes); err != nil {
-			return fmt.Errorf("running tests: %w", err)
+// runTests runs the tests one after the other and returns the result of each of them.
+// Once a test has failed, the following ones are reported as skipped.
+func runTests(ctx context.Context, out io.Writer, bRes []build.Artifact, runs []testRun) ([]Result, error) {
+	var results []Result
+	var err error
+	for _, run := range runs {
+		result := Result{
+			Name:  run.name,
+			Type:  run.testType,
+			Image: run.image,
+		}
+		if err != nil || !run.wasBuilt(bRes) {
+			result.Status = event.Skipped
+			results = append(results, result)
+			continue
+		}
+
+		var buf bytes.Buffer
+		w := io.MultiWriter(out, &buf)
+		if color.IsColorable(out) {
+			w = color.NewWriter(w)
+		}
+
+		start := time.Now()
+		testErr := run.Test(ctx, w, bRes)
+		result.Duration = time.Since(start)
+		result.Output = stripColors(buf.String())
+		if testErr != nil {
+			result.Status = event.Failed
+			result.Err = testErr
+			result.ExitCode = exitCode(testErr)
+			err = fmt.Errorf("running tests: %w", testErr)
+		} else {
+			result.Status = event.Succeeded
 		}
+		results = append(results, result)
 	}
-	return nil
+	return results, err
 }
 
-func getRunner(cfg Config, imagesAreLocal func(imageName string) (bool, error), tcs []*latest.TestCase) ([]runner, error) {
-	var runners []runner
+// getRunners returns the runners of the tests to run before deploying, and those to run once the application is deployed.
+// Each structure test file gets its own runner, so that it can be rerun on its own. Tests whose name doesn't match the filter are left out.
+func getRunners(cfg Config, imagesAreLocal func(imageName string) (bool, error), tcs []*latest.TestCase, filter *regexp.Regexp) ([]testRun, []testRun, error) {
+	selected := func(name string) bool { return filter == nil || filter.MatchString(name) }
+
+	var runners, deployedRunners []testRun
 	for _, tc := range tcs {
-		if len(tc.StructureTests) != 0 {
-			structureRunner, err := structure.New(cfg, cfg.GetWorkingDir(), tc, imagesAreLocal)
+		for _, file := range tc.StructureTests {
+			if !selected(file) {
+				continue
+			}
+			structureRunner, err := structure.New(cfg, cfg.GetWorkingDir(), &latest.TestCase{ImageName: tc.ImageName, StructureTests: []string{file}}, imagesAreLocal)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			runners = append(runners, structureRunner)
+			runners = append(runners, testRun{
+				runner:   structureRunner,
+				name:     file,
+				testType: structureTestType,
+				image:    tc.ImageName,
+			})
 		}
 
 		for _, customTest := range tc.CustomTests {
+			if !selected(customTest.Command) {
+				continue
+			}
 			customRunner, err := custom.New(cfg, cfg.GetWorkingDir(), customTest)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
+			}
+			runners = append(runners, testRun{
+				runner:   customRunner,
+				name:     customTest.Command,
+				testType: customTestType,
+				image:    tc.ImageName,
+			})
+		}
+
+		for _, jobTest := range tc.JobTests {
+			if !selected(jobTest.Name) {
+				continue
 			}
-			runners = append(runners, customRunner)
+			deployedRunners = append(deployedRunners, testRun{
+				runner:   job.New(cfg, tc.ImageName, jobTest),
+				name:     jobTest.Name,
+				testType: jobTestType,
+				image:    tc.ImageName,
+			})
 		}
 	}
-	return runners, nil
+	return runners, deployedRunners, nil
 }
@@ -108,6 +108,28 @@ func TestNoTest(t *testing.T) {
 	})
 }
 
+func TestJobTestsRunAfterDeploy(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cfg := &mockConfig{
+			tests: []*latest.TestCase{{
+				ImageName: "image",
+				JobTests:  []latest.JobTest{{Name: "integration"}},
+			}},
+		}
+
+		tester, err := NewTester(cfg, func(imageName string) (bool, error) { return true, nil })
+		t.CheckNoError(err)
+
+		fullTester := tester.(FullTester)
+		t.CheckEmpty(fullTester.runners)
+		t.CheckDeepEqual(1, len(fullTester.deployedRunners))
+
+		// Job tests don't run before the deployment.
+		err = tester.Test(context.Background(), ioutil.Discard, nil)
+		t.CheckNoError(err)
+	})
+}
+
 func TestTestSuccess(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().Touch("tests/test1.yaml", "tests/test2.yaml", "test3.yaml")
@@ -262,6 +284,132 @@ func TestTestMuted(t *testing.T) {
 	})
 }
 
+func TestTestReports(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("test.yaml")
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("container-structure-test test -v warn --image image:tag --config "+tmpDir.Path("test.yaml")))
+
+		cfg := &mockConfig{
+			workingDir: tmpDir.Root(),
+			tests: []*latest.TestCase{{
+				ImageName:      "image",
+				StructureTests: []string{"test.yaml"},
+			}},
+			reportDir: tmpDir.Path("reports"),
+		}
+
+		tester, err := NewTester(cfg, func(imageName string) (bool, error) { return true, nil })
+		t.CheckNoError(err)
+
+		err = tester.Test(context.Background(), ioutil.Discard, []build.Artifact{{
+			ImageName: "image",
+			Tag:       "image:tag",
+		}})
+		t.CheckNoError(err)
+
+		junit, err := ioutil.ReadFile(tmpDir.Path("reports/tests.xml"))
+		t.CheckNoError(err)
+		t.CheckContains(`<testcase name="test.yaml" classname="image"`, string(junit))
+
+		js, err := ioutil.ReadFile(tmpDir.Path("reports/tests.json"))
+		t.CheckNoError(err)
+		t.CheckContains(`"status": "Succeeded"`, string(js))
+	})
+}
+
+func TestTestFilter(t *testing.T) {
+	tests := []struct {
+		description   string
+		filter        string
+		expectedTests []string
+		shouldErr     bool
+	}{
+		{
+			description:   "no filter",
+			expectedTests: []string{"structure1.yaml", "structure2.yaml", "./unit.sh", "./lint.sh", "./e2e.sh"},
+		},
+		{
+			description:   "filter on structure test file",
+			filter:        "structure2",
+			expectedTests: []string{"structure2.yaml"},
+		},
+		{
+			description:   "filter on custom test commands",
+			filter:        `^\./(unit|lint)\.sh$`,
+			expectedTests: []string{"./unit.sh", "./lint.sh"},
+		},
+		{
+			description: "invalid filter",
+			filter:      "[",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.NewAPIClient, func(docker.Config) (docker.LocalDaemon, error) { return nil, nil })
+
+			cfg := &mockConfig{
+				tests: []*latest.TestCase{
+					{
+						ImageName:      "image1",
+						StructureTests: []string{"structure1.yaml", "structure2.yaml"},
+						CustomTests:    []latest.CustomTest{{Command: "./unit.sh"}, {Command: "./lint.sh"}},
+					},
+					{
+						ImageName:   "image2",
+						CustomTests: []latest.CustomTest{{Command: "./e2e.sh"}},
+					},
+				},
+				filter: test.filter,
+			}
+
+			tester, err := NewTester(cfg, func(imageName string) (bool, error) { return true, nil })
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			var names []string
+			for i, info := range tester.Tests() {
+				t.CheckDeepEqual(i, info.ID)
+				names = append(names, info.Name)
+			}
+			t.CheckDeepEqual(test.expectedTests, names)
+		})
+	}
+}
+
+func TestTestSelected(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("test1.yaml", "test2.yaml")
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("container-structure-test test -v warn --image image:tag --config "+tmpDir.Path("test2.yaml")))
+
+		cfg := &mockConfig{
+			workingDir: tmpDir.Root(),
+			tests: []*latest.TestCase{{
+				ImageName:      "image",
+				StructureTests: []string{"test1.yaml", "test2.yaml"},
+			}},
+		}
+
+		tester, err := NewTester(cfg, func(imageName string) (bool, error) { return true, nil })
+		t.CheckNoError(err)
+
+		tests := tester.Tests()
+		t.CheckDeepEqual(2, len(tests))
+		deps, err := tests[1].Dependencies()
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{tmpDir.Path("test2.yaml")}, deps)
+
+		// Only the second structure test file is run.
+		err = tester.TestSelected(context.Background(), ioutil.Discard, []build.Artifact{{
+			ImageName: "image",
+			Tag:       "image:tag",
+		}}, []int{tests[1].ID})
+		t.CheckNoError(err)
+	})
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
@@ -271,8 +419,12 @@ type mockConfig struct {
 	workingDir            string
 	tests                 []*latest.TestCase
 	muted                 config.Muted
+	reportDir             string
+	filter                string
 }
 
 func (c *mockConfig) Muted() config.Muted           { return c.muted }
 func (c *mockConfig) GetWorkingDir() string         { return c.workingDir }
 func (c *mockConfig) TestCases() []*latest.TestCase { return c.tests }
+func (c *mockConfig) TestReportDir() string         { return c.reportDir }
+func (c *mockConfig) TestFilter() string            { return c.filter }
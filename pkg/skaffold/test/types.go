@@ -30,7 +30,31 @@ import (
 type Tester interface {
 	Test(context.Context, io.Writer, []build.Artifact) error
 
+	// TestDeployed runs the tests that need the application to be deployed.
+	TestDeployed(context.Context, io.Writer, []build.Artifact) error
+
 	TestDependencies() ([]string, error)
+
+	// Tests describes the tests that run before deploying, so that their dependencies can be watched separately.
+	Tests() []Info
+
+	// TestSelected runs the tests, identified by their ID, that run before deploying.
+	TestSelected(ctx context.Context, out io.Writer, bRes []build.Artifact, ids []int) error
+}
+
+// Info describes a single test that runs before deploying.
+type Info struct {
+	// ID identifies the test among the tests of a Tester.
+	ID int
+
+	// Name is the name of the test: the structure test file, or the custom test command.
+	Name string
+
+	// Image is the name of the artifact under test.
+	Image string
+
+	// Dependencies lists the files that the test depends on.
+	Dependencies func() ([]string, error)
 }
 
 type Muted interface {
@@ -45,8 +69,10 @@ type Muted interface {
 // FullTester should always be the ONLY implementation of the Tester interface;
 // newly added testing implementations should implement the runner interface.
 type FullTester struct {
-	runners []runner
-	muted   Muted
+	runners         []testRun
+	deployedRunners []testRun
+	muted           Muted
+	reportDir       string
 	// imagesAreLocal func(imageName string) (bool, error)
 }
 
@@ -58,3 +84,12 @@ type runner interface {
 
 	TestDependencies() ([]string, error)
 }
+
+// testRun is a runner along with a description of the test it runs, used to report its result.
+type testRun struct {
+	runner
+
+	name     string
+	testType string
+	image    string
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"fmt"
+	"strings"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+func portForwardErr(name string, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("verify check %s: unable to port-forward: %s", name, err),
+			ErrCode: proto.StatusCode_VERIFY_PORT_FORWARD_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_VERIFY_CHECK,
+					Action:         fmt.Sprintf("Check the resource and port of the verify check %q", name),
+				},
+			},
+		},
+	)
+}
+
+func namespaceErr(name string, namespaces []string) error {
+	err := fmt.Errorf("verify check %s: namespace must be set since the application is deployed to several namespaces: %s", name, strings.Join(namespaces, ", "))
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: err.Error(),
+			ErrCode: proto.StatusCode_VERIFY_PORT_FORWARD_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_VERIFY_CHECK,
+					Action:         fmt.Sprintf("Set the namespace of the verify check %q", name),
+				},
+			},
+		},
+	)
+}
+
+func checkFailedErr(name string, timeoutSeconds int, err error) error {
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: fmt.Sprintf("verify check %s didn't succeed within %d seconds: %s", name, timeoutSeconds, err),
+			ErrCode: proto.StatusCode_VERIFY_CHECK_FAILED_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CHECK_VERIFY_CHECK,
+					Action:         fmt.Sprintf("Check that the application serves traffic on the port of the verify check %q, or increase its timeoutSeconds", name),
+				},
+			},
+		},
+	)
+}
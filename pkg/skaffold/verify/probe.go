@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// probe runs a single attempt of a check against a local address.
+type probe func(ctx context.Context, address string) error
+
+func newProbe(vc *latest.VerifyCheck) probe {
+	switch {
+	case vc.HTTP != nil:
+		return httpProbe(*vc.HTTP)
+	case vc.GRPC != nil:
+		return grpcProbe(*vc.GRPC)
+	default:
+		return tcpProbe
+	}
+}
+
+// httpProbe sends an HTTP request and checks the status code of the response. Redirects are not followed.
+func httpProbe(hc latest.HTTPCheck) probe {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return func(ctx context.Context, address string) error {
+		method := hc.Method
+		if method == "" {
+			method = http.MethodGet
+		}
+		path := hc.Path
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, "http://"+address+path, nil)
+		if err != nil {
+			return err
+		}
+		for k, v := range hc.Headers {
+			req.Header.Set(k, v)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		if hc.ExpectedStatus != 0 {
+			if resp.StatusCode != hc.ExpectedStatus {
+				return fmt.Errorf("%s %s returned status %d, expected %d", method, path, resp.StatusCode, hc.ExpectedStatus)
+			}
+			return nil
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+			return fmt.Errorf("%s %s returned status %d", method, path, resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+// grpcProbe runs a check of the gRPC health checking protocol.
+func grpcProbe(gc latest.GRPCCheck) probe {
+	return func(ctx context.Context, address string) error {
+		conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: gc.Service})
+		if err != nil {
+			return err
+		}
+		if resp.Status != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("gRPC health check returned %s", resp.Status)
+		}
+		return nil
+	}
+}
+
+// tcpProbe opens a TCP connection. Because kubectl accepts connections on the local port even if nothing
+// listens on the remote port, it also checks that the connection isn't closed right away.
+func tcpProbe(ctx context.Context, address string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	conn.SetReadDeadline(time.Now().Add(tcpReadWait))
+	if _, err := conn.Read(make([]byte, 1)); err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return nil
+		}
+		return fmt.Errorf("connection closed: %w", err)
+	}
+	return nil
+}
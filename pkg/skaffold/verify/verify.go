@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	defaultResourceType   = latest.ResourceType("service")
+	defaultTimeoutSeconds = 60
+	defaultPeriodSeconds  = 2
+
+	// attemptTimeout bounds a single attempt of a check.
+	attemptTimeout = 5 * time.Second
+
+	// tcpReadWait is how long a TCP connection must stay open for a TCP check to succeed.
+	tcpReadWait = 500 * time.Millisecond
+)
+
+// for tests
+var forwardResource = portforward.ForwardResource
+
+// Config contains the configuration needed to run the verify checks.
+type Config interface {
+	GetNamespaces() []string
+	VerifyChecks() []*latest.VerifyCheck
+}
+
+// Checker runs smoke checks against the deployed application.
+type Checker interface {
+	Check(context.Context, io.Writer) error
+}
+
+type checker struct {
+	cfg     Config
+	kubectl *kubectl.CLI
+}
+
+// NewChecker returns a Checker that reaches the deployed resources through kubectl port-forwards.
+func NewChecker(cfg Config, cli *kubectl.CLI) Checker {
+	return checker{
+		cfg:     cfg,
+		kubectl: cli,
+	}
+}
+
+// Check runs all the verify checks in parallel, and returns the error of the first one that failed, if any.
+func (c checker) Check(ctx context.Context, out io.Writer) error {
+	checks := c.cfg.VerifyChecks()
+	if len(checks) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	color.Default.Fprintln(out, "Running verify checks...")
+
+	errs := make([]error, len(checks))
+	var wg sync.WaitGroup
+	for i, vc := range checks {
+		wg.Add(1)
+		go func(i int, vc *latest.VerifyCheck) {
+			defer wg.Done()
+			errs[i] = c.check(ctx, out, vc)
+		}(i, vc)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	color.Default.Fprintln(out, "Verify checks succeeded in", util.ShowHumanizeTime(time.Since(start)))
+	return nil
+}
+
+func (c checker) check(ctx context.Context, out io.Writer, vc *latest.VerifyCheck) error {
+	name := checkName(vc)
+	namespace, err := c.namespace(vc)
+	if err != nil {
+		return err
+	}
+
+	timeout := time.Duration(timeoutSeconds(vc)) * time.Second
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resourceType := vc.Type
+	if resourceType == "" {
+		resourceType = defaultResourceType
+	}
+	localPort, stop, err := forwardResource(checkCtx, portforward.NewKubectlForwarder(out, c.kubectl), latest.PortForwardResource{
+		Type:      resourceType,
+		Name:      vc.ResourceName,
+		Namespace: namespace,
+		Port:      vc.Port,
+	})
+	if err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return ctx.Err()
+		}
+		return portForwardErr(name, err)
+	}
+	defer stop()
+
+	probe := newProbe(vc)
+	address := fmt.Sprintf("%s:%d", util.Loopback, localPort)
+	var lastErr error
+	err = wait.PollImmediateUntil(period(vc), func() (bool, error) {
+		attemptCtx, cancel := context.WithTimeout(checkCtx, attemptTimeout)
+		defer cancel()
+
+		lastErr = probe(attemptCtx, address)
+		return lastErr == nil, nil
+	}, checkCtx.Done())
+	if err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return ctx.Err()
+		}
+		if lastErr == nil {
+			lastErr = err
+		}
+		color.Red.Fprintf(out, " - %s: failed\n", name)
+		return checkFailedErr(name, timeoutSeconds(vc), lastErr)
+	}
+
+	color.Green.Fprintf(out, " - %s: succeeded\n", name)
+	return nil
+}
+
+// namespace returns the namespace of the checked resource: the one that is set on the check,
+// or the one the application was deployed to.
+func (c checker) namespace(vc *latest.VerifyCheck) (string, error) {
+	if vc.Namespace != "" {
+		return vc.Namespace, nil
+	}
+
+	namespaces := c.cfg.GetNamespaces()
+	switch len(namespaces) {
+	case 0:
+		return "default", nil
+	case 1:
+		return namespaces[0], nil
+	default:
+		return "", namespaceErr(checkName(vc), namespaces)
+	}
+}
+
+func checkName(vc *latest.VerifyCheck) string {
+	if vc.Name != "" {
+		return vc.Name
+	}
+	return fmt.Sprintf("%s:%s", vc.ResourceName, vc.Port.String())
+}
+
+func timeoutSeconds(vc *latest.VerifyCheck) int {
+	if vc.TimeoutSeconds > 0 {
+		return vc.TimeoutSeconds
+	}
+	return defaultTimeoutSeconds
+}
+
+func period(vc *latest.VerifyCheck) time.Duration {
+	if vc.PeriodSeconds > 0 {
+		return time.Duration(vc.PeriodSeconds) * time.Second
+	}
+	return defaultPeriodSeconds * time.Second
+}
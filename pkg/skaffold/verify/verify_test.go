@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockConfig struct {
+	namespaces []string
+	checks     []*latest.VerifyCheck
+}
+
+func (c mockConfig) GetNamespaces() []string             { return c.namespaces }
+func (c mockConfig) VerifyChecks() []*latest.VerifyCheck { return c.checks }
+
+func TestCheck(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/redirect":
+			http.Redirect(w, r, "/healthz", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer httpServer.Close()
+	httpPort := httpServer.Listener.Addr().(*net.TCPAddr).Port
+
+	grpcListener, err := net.Listen("tcp", "127.0.0.1:0")
+	testutil.CheckError(t, false, err)
+	grpcServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("ready", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("broken", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	go grpcServer.Serve(grpcListener)
+	defer grpcServer.Stop()
+	grpcPort := grpcListener.Addr().(*net.TCPAddr).Port
+
+	closingListener, err := net.Listen("tcp", "127.0.0.1:0")
+	testutil.CheckError(t, false, err)
+	defer closingListener.Close()
+	go func() {
+		for {
+			conn, err := closingListener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	closingPort := closingListener.Addr().(*net.TCPAddr).Port
+
+	tests := []struct {
+		description       string
+		check             latest.VerifyCheck
+		localPort         int
+		namespaces        []string
+		forwardErr        error
+		expectedNamespace string
+		expectedCode      proto.StatusCode
+	}{
+		{
+			description:       "http check",
+			check:             latest.VerifyCheck{ResourceName: "frontend", Port: util.FromInt(80), HTTP: &latest.HTTPCheck{Path: "/healthz"}},
+			localPort:         httpPort,
+			namespaces:        []string{"ns"},
+			expectedNamespace: "ns",
+		},
+		{
+			description:       "http check with expected redirect",
+			check:             latest.VerifyCheck{ResourceName: "frontend", Port: util.FromInt(80), HTTP: &latest.HTTPCheck{Path: "redirect", ExpectedStatus: http.StatusFound}},
+			localPort:         httpPort,
+			expectedNamespace: "default",
+		},
+		{
+			description:  "http check with unexpected status",
+			check:        latest.VerifyCheck{ResourceName: "frontend", Port: util.FromInt(80), HTTP: &latest.HTTPCheck{Path: "/missing"}, TimeoutSeconds: 1},
+			localPort:    httpPort,
+			expectedCode: proto.StatusCode_VERIFY_CHECK_FAILED_ERR,
+		},
+		{
+			description:       "grpc check",
+			check:             latest.VerifyCheck{ResourceName: "backend", Namespace: "other", Port: util.FromString("grpc"), GRPC: &latest.GRPCCheck{Service: "ready"}},
+			localPort:         grpcPort,
+			namespaces:        []string{"ns1", "ns2"},
+			expectedNamespace: "other",
+		},
+		{
+			description:  "grpc check of a service that isn't serving",
+			check:        latest.VerifyCheck{ResourceName: "backend", Port: util.FromInt(50051), GRPC: &latest.GRPCCheck{Service: "broken"}, TimeoutSeconds: 1},
+			localPort:    grpcPort,
+			expectedCode: proto.StatusCode_VERIFY_CHECK_FAILED_ERR,
+		},
+		{
+			description:       "tcp check",
+			check:             latest.VerifyCheck{ResourceName: "frontend", Port: util.FromInt(80)},
+			localPort:         httpPort,
+			expectedNamespace: "default",
+		},
+		{
+			description:  "tcp check on a closed connection",
+			check:        latest.VerifyCheck{ResourceName: "db", Port: util.FromInt(5432), TCP: &latest.TCPCheck{}, TimeoutSeconds: 1},
+			localPort:    closingPort,
+			expectedCode: proto.StatusCode_VERIFY_CHECK_FAILED_ERR,
+		},
+		{
+			description:  "port-forward error",
+			check:        latest.VerifyCheck{ResourceName: "frontend", Port: util.FromInt(80)},
+			forwardErr:   errors.New("unable to forward"),
+			expectedCode: proto.StatusCode_VERIFY_PORT_FORWARD_ERR,
+		},
+		{
+			description:  "ambiguous namespace",
+			check:        latest.VerifyCheck{ResourceName: "frontend", Port: util.FromInt(80)},
+			namespaces:   []string{"ns1", "ns2"},
+			expectedCode: proto.StatusCode_VERIFY_PORT_FORWARD_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var forwarded latest.PortForwardResource
+			t.Override(&forwardResource, func(_ context.Context, _ portforward.EntryForwarder, resource latest.PortForwardResource) (int, func(), error) {
+				forwarded = resource
+				if test.forwardErr != nil {
+					return 0, nil, test.forwardErr
+				}
+				return test.localPort, func() {}, nil
+			})
+
+			check := test.check
+			cfg := mockConfig{namespaces: test.namespaces, checks: []*latest.VerifyCheck{&check}}
+			var out bytes.Buffer
+			err := NewChecker(cfg, nil).Check(context.Background(), &out)
+
+			if test.expectedCode != proto.StatusCode_OK {
+				t.CheckError(true, err)
+				var sErr sErrors.Error
+				t.CheckTrue(errors.As(err, &sErr))
+				t.CheckDeepEqual(test.expectedCode, sErr.StatusCode())
+				return
+			}
+			t.CheckNoError(err)
+			t.CheckContains("succeeded", out.String())
+			t.CheckDeepEqual(test.expectedNamespace, forwarded.Namespace)
+			t.CheckDeepEqual(defaultResourceType, forwarded.Type)
+		})
+	}
+}
+
+func TestNoChecks(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var out bytes.Buffer
+		err := NewChecker(mockConfig{}, nil).Check(context.Background(), &out)
+
+		t.CheckNoError(err)
+		t.CheckEmpty(out.String())
+	})
+}
+
+func TestCheckName(t *testing.T) {
+	testutil.CheckDeepEqual(t, "frontend:http", checkName(&latest.VerifyCheck{ResourceName: "frontend", Port: util.FromString("http")}))
+	testutil.CheckDeepEqual(t, "smoke", checkName(&latest.VerifyCheck{Name: "smoke", ResourceName: "frontend", Port: util.FromInt(80)}))
+}
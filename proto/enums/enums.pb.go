@@ -38,6 +38,8 @@ const (
 	BuilderType_KANIKO BuilderType = 5
 	// Docker Builder
 	BuilderType_DOCKER BuilderType = 6
+	// Ko Builder
+	BuilderType_KO BuilderType = 7
 )
 
 var BuilderType_name = map[int32]string{
@@ -48,6 +50,7 @@ var BuilderType_name = map[int32]string{
 	4: "CUSTOM",
 	5: "KANIKO",
 	6: "DOCKER",
+	7: "KO",
 }
 
 var BuilderType_value = map[string]int32{
@@ -58,6 +61,7 @@ var BuilderType_value = map[string]int32{
 	"CUSTOM":               4,
 	"KANIKO":               5,
 	"DOCKER":               6,
+	"KO":                   7,
 }
 
 func (x BuilderType) String() string {
@@ -330,6 +334,12 @@ const (
 	StatusCode_STATUSCHECK_CONTAINER_TERMINATED StatusCode = 303
 	// Deployment waiting for rollout
 	StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING StatusCode = 304
+	// Job failed
+	StatusCode_STATUSCHECK_JOB_FAILED StatusCode = 305
+	// Resource waiting to be ready
+	StatusCode_STATUSCHECK_RESOURCE_NOT_READY StatusCode = 306
+	// Resource reported a failure
+	StatusCode_STATUSCHECK_RESOURCE_FAILED StatusCode = 307
 	// Container restarting error
 	StatusCode_STATUSCHECK_CONTAINER_RESTARTING StatusCode = 356
 	// Readiness probe failed
@@ -409,6 +419,8 @@ const (
 	StatusCode_BUILD_DOCKER_CANCELLED StatusCode = 804
 	// Build error due to docker deadline was reached before the docker action completed
 	StatusCode_BUILD_DOCKER_DEADLINE StatusCode = 805
+	// Build error due to the artifact's build timeout being reached
+	StatusCode_BUILD_ARTIFACT_TIMEOUT StatusCode = 806
 	// Skaffold was unable to create the configured tagger
 	StatusCode_INIT_CREATE_TAGGER_ERROR StatusCode = 901
 	// Skaffold was unable to start as Minikube appears to be paused
@@ -471,6 +483,10 @@ const (
 	StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR StatusCode = 1021
 	// Helm config `createNamespace` not available
 	StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE StatusCode = 1022
+	// No valid signature found for a deployed image
+	StatusCode_DEPLOY_IMAGE_SIGNATURE_MISSING StatusCode = 1023
+	// A deployed image has a signature that doesn't match the verification key
+	StatusCode_DEPLOY_IMAGE_SIGNATURE_INVALID StatusCode = 1024
 	// Error expanding paths
 	StatusCode_TEST_USER_CONFIG_ERR StatusCode = 1101
 	// Error running container-structure-test
@@ -495,6 +511,14 @@ const (
 	StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR StatusCode = 1112
 	// Unmarshalling dependency output error
 	StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR StatusCode = 1113
+	// Error creating the Kubernetes Job of a test
+	StatusCode_TEST_JOB_CREATE_ERR StatusCode = 1114
+	// Kubernetes Job of a test failed
+	StatusCode_TEST_JOB_FAILED_ERR StatusCode = 1115
+	// Kubernetes Job of a test timed out
+	StatusCode_TEST_JOB_TIMEDOUT_ERR StatusCode = 1116
+	// Kubernetes Job of a test could not pull its image
+	StatusCode_TEST_JOB_IMAGE_PULL_ERR StatusCode = 1117
 	// Catch-all configuration file parsing error
 	StatusCode_CONFIG_FILE_PARSING_ERR StatusCode = 1201
 	// Main configuration file not found
@@ -517,6 +541,10 @@ const (
 	StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR StatusCode = 1210
 	// Same config imported at least twice with different set of profiles
 	StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR StatusCode = 1211
+	// Unable to port-forward the resource of a verify check
+	StatusCode_VERIFY_PORT_FORWARD_ERR StatusCode = 1301
+	// A verify check didn't succeed before its deadline
+	StatusCode_VERIFY_CHECK_FAILED_ERR StatusCode = 1302
 )
 
 var StatusCode_name = map[int32]string{
@@ -554,6 +582,9 @@ var StatusCode_name = map[int32]string{
 	302:  "STATUSCHECK_RUN_CONTAINER_ERR",
 	303:  "STATUSCHECK_CONTAINER_TERMINATED",
 	304:  "STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING",
+	305:  "STATUSCHECK_JOB_FAILED",
+	306:  "STATUSCHECK_RESOURCE_NOT_READY",
+	307:  "STATUSCHECK_RESOURCE_FAILED",
 	356:  "STATUSCHECK_CONTAINER_RESTARTING",
 	357:  "STATUSCHECK_UNHEALTHY",
 	400:  "STATUSCHECK_NODE_MEMORY_PRESSURE",
@@ -594,6 +625,7 @@ var StatusCode_name = map[int32]string{
 	803:  "DEPLOY_CANCELLED",
 	804:  "BUILD_DOCKER_CANCELLED",
 	805:  "BUILD_DOCKER_DEADLINE",
+	806:  "BUILD_ARTIFACT_TIMEOUT",
 	901:  "INIT_CREATE_TAGGER_ERROR",
 	902:  "INIT_MINIKUBE_PAUSED_ERROR",
 	903:  "INIT_MINIKUBE_NOT_RUNNING_ERROR",
@@ -625,6 +657,8 @@ var StatusCode_name = map[int32]string{
 	1020: "DEPLOY_MANIFEST_WRITE_ERR",
 	1021: "DEPLOY_PARSE_MANIFEST_IMAGES_ERR",
 	1022: "DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE",
+	1023: "DEPLOY_IMAGE_SIGNATURE_MISSING",
+	1024: "DEPLOY_IMAGE_SIGNATURE_INVALID",
 	1101: "TEST_USER_CONFIG_ERR",
 	1102: "TEST_CST_USER_ERR",
 	1103: "TEST_IMG_PULL_ERR",
@@ -637,6 +671,10 @@ var StatusCode_name = map[int32]string{
 	1111: "TEST_CUSTOM_CMD_RUN_ERR",
 	1112: "TEST_CUSTOM_DEPENDENCIES_CMD_ERR",
 	1113: "TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR",
+	1114: "TEST_JOB_CREATE_ERR",
+	1115: "TEST_JOB_FAILED_ERR",
+	1116: "TEST_JOB_TIMEDOUT_ERR",
+	1117: "TEST_JOB_IMAGE_PULL_ERR",
 	1201: "CONFIG_FILE_PARSING_ERR",
 	1202: "CONFIG_FILE_NOT_FOUND_ERR",
 	1203: "CONFIG_DEPENDENCY_NOT_FOUND_ERR",
@@ -648,6 +686,8 @@ var StatusCode_name = map[int32]string{
 	1209: "CONFIG_DEFAULT_VALUES_ERR",
 	1210: "CONFIG_FILE_PATHS_SUBSTITUTION_ERR",
 	1211: "CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR",
+	1301: "VERIFY_PORT_FORWARD_ERR",
+	1302: "VERIFY_CHECK_FAILED_ERR",
 }
 
 var StatusCode_value = map[string]int32{
@@ -685,6 +725,9 @@ var StatusCode_value = map[string]int32{
 	"STATUSCHECK_RUN_CONTAINER_ERR":                302,
 	"STATUSCHECK_CONTAINER_TERMINATED":             303,
 	"STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING":       304,
+	"STATUSCHECK_JOB_FAILED":                       305,
+	"STATUSCHECK_RESOURCE_NOT_READY":               306,
+	"STATUSCHECK_RESOURCE_FAILED":                  307,
 	"STATUSCHECK_CONTAINER_RESTARTING":             356,
 	"STATUSCHECK_UNHEALTHY":                        357,
 	"STATUSCHECK_NODE_MEMORY_PRESSURE":             400,
@@ -725,6 +768,7 @@ var StatusCode_value = map[string]int32{
 	"DEPLOY_CANCELLED":                             803,
 	"BUILD_DOCKER_CANCELLED":                       804,
 	"BUILD_DOCKER_DEADLINE":                        805,
+	"BUILD_ARTIFACT_TIMEOUT":                       806,
 	"INIT_CREATE_TAGGER_ERROR":                     901,
 	"INIT_MINIKUBE_PAUSED_ERROR":                   902,
 	"INIT_MINIKUBE_NOT_RUNNING_ERROR":              903,
@@ -756,6 +800,8 @@ var StatusCode_value = map[string]int32{
 	"DEPLOY_MANIFEST_WRITE_ERR":                    1020,
 	"DEPLOY_PARSE_MANIFEST_IMAGES_ERR":             1021,
 	"DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE":          1022,
+	"DEPLOY_IMAGE_SIGNATURE_MISSING":               1023,
+	"DEPLOY_IMAGE_SIGNATURE_INVALID":               1024,
 	"TEST_USER_CONFIG_ERR":                         1101,
 	"TEST_CST_USER_ERR":                            1102,
 	"TEST_IMG_PULL_ERR":                            1103,
@@ -768,6 +814,10 @@ var StatusCode_value = map[string]int32{
 	"TEST_CUSTOM_CMD_RUN_ERR":                      1111,
 	"TEST_CUSTOM_DEPENDENCIES_CMD_ERR":             1112,
 	"TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR":      1113,
+	"TEST_JOB_CREATE_ERR":                          1114,
+	"TEST_JOB_FAILED_ERR":                          1115,
+	"TEST_JOB_TIMEDOUT_ERR":                        1116,
+	"TEST_JOB_IMAGE_PULL_ERR":                      1117,
 	"CONFIG_FILE_PARSING_ERR":                      1201,
 	"CONFIG_FILE_NOT_FOUND_ERR":                    1202,
 	"CONFIG_DEPENDENCY_NOT_FOUND_ERR":              1203,
@@ -779,6 +829,8 @@ var StatusCode_value = map[string]int32{
 	"CONFIG_DEFAULT_VALUES_ERR":                    1209,
 	"CONFIG_FILE_PATHS_SUBSTITUTION_ERR":           1210,
 	"CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR":     1211,
+	"VERIFY_PORT_FORWARD_ERR":                      1301,
+	"VERIFY_CHECK_FAILED_ERR":                      1302,
 }
 
 func (x StatusCode) String() string {
@@ -838,6 +890,10 @@ const (
 	SuggestionCode_UPGRADE_HELM32 SuggestionCode = 206
 	// Set `releases.createNamespace` to false.
 	SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE SuggestionCode = 207
+	// Sign the image with the key configured in `build.sign`
+	SuggestionCode_SIGN_IMAGE SuggestionCode = 208
+	// Check that `deploy.verify.key` is the public key matching the signing key
+	SuggestionCode_CHECK_VERIFICATION_KEY SuggestionCode = 209
 	// Install kubectl tool
 	SuggestionCode_INSTALL_KUBECTL SuggestionCode = 220
 	// Container run error
@@ -891,6 +947,12 @@ const (
 	SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT              SuggestionCode = 1001
 	SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD   SuggestionCode = 1002
 	SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS SuggestionCode = 1003
+	// Check the logs of the test Job
+	SuggestionCode_CHECK_TEST_JOB_LOGS SuggestionCode = 1004
+	// Fix the test Job timeoutSeconds
+	SuggestionCode_FIX_TEST_JOB_TIMEOUT SuggestionCode = 1005
+	// Check the resource and port of the verify check
+	SuggestionCode_CHECK_VERIFY_CHECK SuggestionCode = 1100
 )
 
 var SuggestionCode_name = map[int32]string{
@@ -916,6 +978,8 @@ var SuggestionCode_name = map[int32]string{
 	205:  "FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES",
 	206:  "UPGRADE_HELM32",
 	207:  "FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE",
+	208:  "SIGN_IMAGE",
+	209:  "CHECK_VERIFICATION_KEY",
 	220:  "INSTALL_KUBECTL",
 	301:  "CHECK_CONTAINER_LOGS",
 	302:  "CHECK_READINESS_PROBE",
@@ -944,6 +1008,9 @@ var SuggestionCode_name = map[int32]string{
 	1001: "FIX_CUSTOM_COMMAND_TIMEOUT",
 	1002: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD",
 	1003: "CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS",
+	1004: "CHECK_TEST_JOB_LOGS",
+	1005: "FIX_TEST_JOB_TIMEOUT",
+	1100: "CHECK_VERIFY_CHECK",
 }
 
 var SuggestionCode_value = map[string]int32{
@@ -969,6 +1036,8 @@ var SuggestionCode_value = map[string]int32{
 	"FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES": 205,
 	"UPGRADE_HELM32":                              206,
 	"FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE":   207,
+	"SIGN_IMAGE":                                  208,
+	"CHECK_VERIFICATION_KEY":                      209,
 	"INSTALL_KUBECTL":                             220,
 	"CHECK_CONTAINER_LOGS":                        301,
 	"CHECK_READINESS_PROBE":                       302,
@@ -997,6 +1066,9 @@ var SuggestionCode_value = map[string]int32{
 	"FIX_CUSTOM_COMMAND_TIMEOUT":                  1001,
 	"CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD":       1002,
 	"CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS":     1003,
+	"CHECK_TEST_JOB_LOGS":                         1004,
+	"FIX_TEST_JOB_TIMEOUT":                        1005,
+	"CHECK_VERIFY_CHECK":                          1100,
 }
 
 func (x SuggestionCode) String() string {
@@ -1021,170 +1093,181 @@ func init() {
 func init() { proto.RegisterFile("enums.proto", fileDescriptor_888b6bd9597961ff) }
 
 var fileDescriptor_888b6bd9597961ff = []byte{
-	// 2626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x59, 0x59, 0x70, 0x5c, 0xc5,
-	0xd5, 0x46, 0x1a, 0x49, 0x23, 0xb5, 0x0d, 0x34, 0x8d, 0x6d, 0xe4, 0xdd, 0x06, 0xcc, 0x22, 0xf8,
-	0x6d, 0xfe, 0x9f, 0xbf, 0xf2, 0xde, 0x73, 0xef, 0x99, 0x99, 0xf6, 0xf4, 0xed, 0xbe, 0xd5, 0xdd,
-	0x57, 0xb6, 0xfc, 0x72, 0x0b, 0xe2, 0xb1, 0x30, 0xc8, 0x1e, 0x63, 0x49, 0x24, 0x24, 0x79, 0xc8,
-	0x43, 0xf6, 0xad, 0xb2, 0xef, 0x0f, 0x59, 0x2b, 0x2f, 0xd9, 0xf7, 0x3d, 0x50, 0x79, 0x09, 0x21,
-	0x40, 0xf6, 0x40, 0x55, 0x1e, 0x93, 0xaa, 0x24, 0x64, 0xab, 0x84, 0x7d, 0x4b, 0xea, 0xf4, 0x5d,
-	0x67, 0x51, 0xf2, 0xe4, 0x71, 0x7f, 0x5f, 0x9f, 0x3e, 0xe7, 0xf4, 0xe9, 0xb3, 0x5c, 0x91, 0x6d,
-	0xfd, 0xf3, 0x9b, 0xe7, 0xd6, 0x8f, 0x5e, 0xb8, 0x38, 0xd8, 0x18, 0xb0, 0x6d, 0xfe, 0x9f, 0xa3,
-	0x7e, 0x69, 0x69, 0x40, 0xb6, 0xb5, 0x36, 0xcf, 0xae, 0x9d, 0xee, 0x5f, 0x74, 0xf7, 0x5e, 0xe8,
-	0xb3, 0x45, 0xb2, 0x23, 0x51, 0x3d, 0xa5, 0x4f, 0xa8, 0xb4, 0x95, 0x08, 0x19, 0x82, 0x49, 0xdd,
-	0x4a, 0x0c, 0xf4, 0x12, 0xd6, 0x24, 0x8d, 0xe3, 0xa2, 0x45, 0xa7, 0xd8, 0x02, 0x99, 0x6d, 0xf1,
-	0x53, 0x20, 0xe9, 0x34, 0xbb, 0x8c, 0x10, 0xcf, 0x8a, 0x79, 0xd0, 0xb3, 0xb4, 0xc1, 0x08, 0x99,
-	0x0b, 0x12, 0xeb, 0x74, 0x44, 0x67, 0xf0, 0x77, 0x8f, 0x2b, 0xd1, 0xd3, 0x74, 0x16, 0x7f, 0x87,
-	0x3a, 0xe8, 0x81, 0xa1, 0x73, 0x4b, 0x21, 0x59, 0xf0, 0x07, 0xfa, 0xe3, 0x76, 0x11, 0x36, 0x74,
-	0x5c, 0x71, 0xd8, 0x36, 0xd2, 0x0c, 0x64, 0x62, 0x1d, 0x18, 0x3a, 0x85, 0x27, 0x77, 0x82, 0x16,
-	0x9d, 0xc6, 0x93, 0xa5, 0x0e, 0xb8, 0xa4, 0x8d, 0xa5, 0x1e, 0x21, 0xae, 0xbf, 0xbe, 0x91, 0x6b,
-	0xbd, 0x93, 0x5c, 0x51, 0x88, 0x71, 0x60, 0x5d, 0x21, 0x65, 0x9e, 0xcc, 0x24, 0x4a, 0x38, 0x3a,
-	0xc5, 0xf6, 0x91, 0xc5, 0x40, 0x2b, 0xc7, 0x85, 0x02, 0x93, 0x5a, 0x67, 0x92, 0xc0, 0x25, 0x06,
-	0x3c, 0x99, 0x4e, 0x2f, 0x69, 0xb2, 0x3d, 0xec, 0x5f, 0x58, 0x1b, 0xdc, 0x9b, 0x8b, 0xdb, 0x4d,
-	0x76, 0x16, 0xe2, 0x42, 0x88, 0xa5, 0x5e, 0xa9, 0xbc, 0x30, 0x4f, 0x66, 0xba, 0x20, 0x23, 0x3a,
-	0xc5, 0x2e, 0x25, 0x0b, 0x3d, 0x6f, 0xab, 0x38, 0x05, 0x74, 0x1a, 0x35, 0xee, 0x25, 0x2d, 0x08,
-	0x1c, 0x6a, 0x27, 0xc8, 0xb6, 0x60, 0x6d, 0xb3, 0x54, 0xaf, 0xe6, 0xd4, 0xdc, 0xaa, 0x42, 0xdc,
-	0x76, 0x32, 0x1f, 0x09, 0x25, 0x70, 0x67, 0x6e, 0x68, 0x0f, 0x32, 0x43, 0xb5, 0xeb, 0x82, 0xa1,
-	0x8d, 0xa5, 0xe3, 0x64, 0x5e, 0x0e, 0x56, 0x65, 0xff, 0x9e, 0xfe, 0x1a, 0x2e, 0x87, 0xd0, 0x4a,
-	0x3a, 0x99, 0x1e, 0x42, 0xb5, 0x35, 0x9d, 0xc2, 0x5f, 0x27, 0xb8, 0x51, 0xd9, 0x2e, 0x30, 0x46,
-	0x1b, 0xda, 0xc0, 0x9f, 0x6d, 0xee, 0xb8, 0xa4, 0x33, 0xf8, 0x33, 0xe6, 0x4a, 0x04, 0x74, 0x76,
-	0xe9, 0x85, 0x43, 0x84, 0xd8, 0x8d, 0xdb, 0x36, 0x36, 0xd7, 0x83, 0xc1, 0xe9, 0x3e, 0x9b, 0x23,
-	0xd3, 0xba, 0x47, 0x2f, 0x61, 0x8b, 0xe4, 0x4a, 0xeb, 0xb8, 0x4b, 0x6c, 0xd0, 0x85, 0xa0, 0x97,
-	0xda, 0x24, 0x08, 0xc0, 0x5a, 0xfa, 0xa3, 0x29, 0xc6, 0xc8, 0xa5, 0xd9, 0xb5, 0x14, 0x6b, 0x0f,
-	0x4c, 0xb1, 0x2b, 0xc9, 0x65, 0x99, 0x53, 0xca, 0xc5, 0x1f, 0x4f, 0xb1, 0x2b, 0xc8, 0x76, 0xef,
-	0xf8, 0x62, 0xe9, 0x41, 0xef, 0xf2, 0x6c, 0x6f, 0x9c, 0xd8, 0x6e, 0xca, 0xfd, 0x7a, 0x1a, 0x82,
-	0x12, 0x10, 0xd2, 0x3e, 0xdb, 0x4b, 0xae, 0xca, 0x51, 0xa3, 0x8f, 0x43, 0xe0, 0x52, 0xa5, 0x5d,
-	0xda, 0xd6, 0x89, 0x0a, 0xe9, 0x19, 0x76, 0x0d, 0x39, 0x98, 0x81, 0x59, 0xd0, 0xa4, 0x21, 0x87,
-	0x48, 0x2b, 0x4f, 0x31, 0x89, 0x52, 0x42, 0x75, 0xe8, 0x2a, 0xdb, 0x41, 0x68, 0x46, 0x4a, 0x2c,
-	0x98, 0x34, 0x33, 0xfc, 0x8e, 0xea, 0xd4, 0x7c, 0x6b, 0xa2, 0xf8, 0x32, 0x17, 0x92, 0xb7, 0x24,
-	0xd0, 0xb3, 0x6c, 0x3f, 0xd9, 0x3d, 0x8a, 0x26, 0xae, 0xab, 0x8d, 0x38, 0x05, 0x21, 0xbd, 0xb3,
-	0x52, 0x2a, 0x87, 0xed, 0x8a, 0x75, 0x10, 0xa1, 0x6c, 0x7a, 0x17, 0x3b, 0x4c, 0xf6, 0x0f, 0x81,
-	0xa8, 0x4d, 0xa4, 0x43, 0xd1, 0x16, 0x10, 0x7a, 0xca, 0x1a, 0xbb, 0x96, 0x1c, 0x1a, 0xa3, 0x88,
-	0x28, 0x96, 0x10, 0x81, 0x72, 0x39, 0xeb, 0x1c, 0x3b, 0x40, 0xf6, 0x8c, 0x58, 0xe7, 0x78, 0x2a,
-	0xb5, 0xb5, 0x1e, 0x3f, 0x3f, 0x86, 0xb7, 0xb5, 0x69, 0x89, 0x30, 0x04, 0xe5, 0xf1, 0xc1, 0x98,
-	0x11, 0x81, 0x56, 0x6d, 0x29, 0x02, 0xe7, 0xe1, 0x0b, 0xec, 0x10, 0xd9, 0x37, 0x04, 0x7b, 0xcf,
-	0xd4, 0xdc, 0x7b, 0x37, 0xbb, 0x9a, 0x1c, 0x18, 0x62, 0x08, 0xb5, 0xcc, 0xa5, 0x08, 0xd3, 0x98,
-	0x1b, 0x9e, 0x59, 0x7b, 0x71, 0x54, 0x89, 0xb6, 0x90, 0x50, 0x93, 0xb1, 0x3e, 0x66, 0x6a, 0xc0,
-	0x83, 0x2e, 0xa4, 0x6d, 0xa3, 0xa3, 0x34, 0x4e, 0xa4, 0xf4, 0x52, 0x36, 0xd8, 0x41, 0xb2, 0x77,
-	0x88, 0xd5, 0x01, 0x97, 0x86, 0xa2, 0x83, 0x91, 0x82, 0x84, 0xcd, 0xca, 0xa9, 0x06, 0x3a, 0xc2,
-	0x3a, 0xb3, 0x32, 0x4a, 0xb9, 0xa7, 0xa2, 0x14, 0x4f, 0xe8, 0xb8, 0x68, 0xa5, 0xb1, 0x4c, 0x3a,
-	0x42, 0x65, 0xaf, 0xe8, 0x15, 0xd5, 0xa5, 0x23, 0xd4, 0x31, 0x3c, 0x94, 0x80, 0x0f, 0xd7, 0x0b,
-	0x78, 0x65, 0x75, 0xab, 0x88, 0x46, 0x7c, 0x19, 0x54, 0x09, 0xde, 0xcb, 0x96, 0xc8, 0x75, 0x42,
-	0x09, 0x57, 0xde, 0x18, 0xb8, 0x13, 0xda, 0xf4, 0x52, 0x29, 0xac, 0x13, 0xaa, 0x93, 0x96, 0x49,
-	0xc3, 0xd2, 0x57, 0xb1, 0xa3, 0x64, 0x69, 0x12, 0xb7, 0x70, 0x5f, 0x95, 0x60, 0x14, 0x8f, 0x80,
-	0xbe, 0x9a, 0xdd, 0x42, 0x6e, 0x9e, 0xc4, 0xaf, 0x78, 0xa1, 0x06, 0xeb, 0xbd, 0x0a, 0x27, 0x85,
-	0x75, 0xf4, 0x35, 0xec, 0x20, 0xd9, 0x53, 0x7f, 0x89, 0x22, 0xe2, 0x1d, 0xa8, 0xfc, 0xf9, 0xd9,
-	0x69, 0x76, 0x0d, 0x39, 0x50, 0x27, 0x54, 0xa2, 0x02, 0x03, 0x1c, 0x35, 0xa6, 0x9f, 0x9b, 0x66,
-	0x57, 0x93, 0xfd, 0x75, 0x92, 0x49, 0x54, 0x8d, 0x88, 0x82, 0x3e, 0x3f, 0xcd, 0x8e, 0x90, 0x43,
-	0x93, 0x05, 0x39, 0x30, 0x91, 0x50, 0xdc, 0x41, 0x48, 0xbf, 0x30, 0xcd, 0x6e, 0x22, 0xd7, 0xd5,
-	0x69, 0xd9, 0xc3, 0xc7, 0x68, 0x4e, 0x8d, 0x96, 0x52, 0x27, 0x2e, 0x8d, 0x41, 0x85, 0x78, 0xee,
-	0x17, 0xff, 0x83, 0x4c, 0x03, 0xd6, 0x71, 0xe3, 0xd5, 0xfb, 0xdd, 0x34, 0xdb, 0x43, 0x76, 0xd6,
-	0x69, 0x89, 0xea, 0x02, 0x97, 0xae, 0xbb, 0x42, 0x7f, 0x3f, 0x26, 0x42, 0xe9, 0x10, 0xd2, 0x08,
-	0x22, 0x6d, 0x56, 0xd2, 0xd8, 0x80, 0xb5, 0x89, 0x01, 0xfa, 0xce, 0xc6, 0xa8, 0x1b, 0x3c, 0x2d,
-	0x14, 0xb6, 0x57, 0x91, 0xde, 0xd5, 0x60, 0x37, 0x92, 0x6b, 0xc7, 0x48, 0xc5, 0x1d, 0xd4, 0xd3,
-	0xc2, 0xbb, 0x1b, 0xa3, 0x1e, 0xf3, 0xd4, 0x18, 0x5f, 0x44, 0x21, 0xee, 0x3d, 0x93, 0xcf, 0x4c,
-	0x14, 0xfe, 0x2f, 0x4c, 0x32, 0x41, 0xef, 0x6d, 0xb0, 0xc3, 0x64, 0xdf, 0x04, 0x92, 0x01, 0x1e,
-	0x74, 0x3d, 0xe5, 0x7d, 0x8d, 0xd1, 0x3b, 0xce, 0xd4, 0xc2, 0xcc, 0x06, 0x3c, 0x5c, 0xa1, 0xef,
-	0x1f, 0x53, 0xa6, 0xcd, 0x85, 0x84, 0x30, 0xcd, 0x0f, 0x42, 0x1f, 0x7e, 0xa0, 0xc1, 0xae, 0x27,
-	0x57, 0xd7, 0x39, 0x79, 0xe5, 0x41, 0x97, 0x2b, 0x08, 0x9c, 0xd0, 0x59, 0xae, 0xf8, 0xd0, 0x98,
-	0xd6, 0x05, 0x11, 0x8d, 0xeb, 0x09, 0x29, 0x21, 0xa4, 0x1f, 0x1e, 0xf3, 0x54, 0x29, 0x4d, 0x0a,
-	0xbc, 0xe9, 0x36, 0xb8, 0xa0, 0xeb, 0xe5, 0x7d, 0xa4, 0x31, 0x7a, 0x41, 0xb5, 0x80, 0xa8, 0x68,
-	0x1f, 0x1d, 0xf3, 0x43, 0xac, 0xc3, 0x14, 0x9f, 0x82, 0xe0, 0x52, 0x9c, 0x42, 0x13, 0x7e, 0xd8,
-	0xc0, 0xda, 0x52, 0xbc, 0xe8, 0x2c, 0x79, 0x3f, 0xd1, 0x18, 0xad, 0x44, 0x39, 0x4e, 0x9f, 0x6c,
-	0xb0, 0xeb, 0xc8, 0xe1, 0x09, 0xc8, 0xc8, 0x05, 0x3c, 0xd5, 0x60, 0x4b, 0xe4, 0xc8, 0xe4, 0x18,
-	0x3c, 0xc1, 0x85, 0x7f, 0xd1, 0x85, 0xcc, 0xa7, 0x1b, 0xec, 0x00, 0xd9, 0x3d, 0x49, 0x26, 0x2c,
-	0x83, 0x72, 0xf4, 0xc5, 0x46, 0xad, 0xd2, 0x15, 0x9b, 0x9e, 0x69, 0x60, 0xa5, 0xb3, 0x2b, 0x2a,
-	0x28, 0x97, 0x9e, 0x6d, 0x54, 0x55, 0xb2, 0x58, 0x7b, 0xae, 0xc1, 0x76, 0x90, 0xcb, 0x43, 0x58,
-	0xf6, 0xcf, 0xbf, 0x58, 0x7d, 0xde, 0xaf, 0x06, 0x12, 0xb8, 0x4a, 0xe2, 0x72, 0xf5, 0x05, 0x2f,
-	0x72, 0x88, 0xf8, 0x52, 0x83, 0xed, 0x26, 0x3b, 0x46, 0x0a, 0x55, 0x06, 0xfd, 0xab, 0x51, 0x96,
-	0xda, 0x62, 0xe9, 0xb5, 0x33, 0x28, 0xd6, 0xeb, 0xe4, 0xa5, 0x64, 0xce, 0x7c, 0x6c, 0x86, 0x1d,
-	0x22, 0x7b, 0x0b, 0x15, 0xb2, 0xec, 0x0a, 0x26, 0x6f, 0xb2, 0x42, 0x88, 0x2d, 0xfd, 0xde, 0x2c,
-	0x86, 0xe2, 0x18, 0xc3, 0xcb, 0xf6, 0x84, 0xef, 0xcf, 0xe2, 0x35, 0x8e, 0x11, 0x72, 0x97, 0x78,
-	0xca, 0x0f, 0x66, 0x27, 0x9e, 0x82, 0x15, 0x49, 0x74, 0x90, 0x42, 0xef, 0x9b, 0x65, 0xd7, 0x92,
-	0x83, 0x95, 0x2b, 0x6c, 0x12, 0xc7, 0xda, 0x60, 0x31, 0x5c, 0xfe, 0xdf, 0x34, 0xe2, 0x4a, 0xb4,
-	0xb1, 0x05, 0xbb, 0x7f, 0x76, 0xf4, 0x59, 0xf8, 0xa2, 0x1e, 0x70, 0x15, 0x80, 0x0f, 0xd2, 0x8f,
-	0xcd, 0x8d, 0x3e, 0x8b, 0x10, 0x78, 0x28, 0x85, 0x82, 0x14, 0x4e, 0x06, 0x00, 0x21, 0x84, 0xf4,
-	0xe3, 0x73, 0xe8, 0x88, 0xcc, 0xc2, 0x6a, 0xe7, 0x27, 0xe6, 0xd8, 0x4e, 0x42, 0x73, 0xa5, 0xab,
-	0xe5, 0x4f, 0xce, 0xb1, 0xbd, 0x64, 0xd7, 0x48, 0x09, 0x2b, 0xc0, 0x4f, 0xcd, 0x61, 0x92, 0x1a,
-	0x2e, 0xd2, 0xf9, 0x71, 0xf4, 0xd3, 0x73, 0x6c, 0x3f, 0x59, 0xf4, 0xd6, 0xf8, 0x9c, 0x0b, 0xa9,
-	0xe3, 0x9d, 0x4e, 0xd9, 0x81, 0xbc, 0xbe, 0x89, 0x96, 0x78, 0xb8, 0x68, 0xec, 0xd2, 0x98, 0x27,
-	0x36, 0xab, 0xfe, 0xda, 0xd0, 0x37, 0x34, 0xd1, 0x21, 0xc3, 0x84, 0x5a, 0x63, 0x93, 0xb3, 0xde,
-	0xd8, 0xc4, 0xe8, 0xac, 0x9f, 0x52, 0x74, 0xe3, 0x19, 0xfe, 0xa6, 0xea, 0x98, 0x1c, 0x2f, 0x1b,
-	0xd5, 0x8c, 0xf0, 0xe6, 0x31, 0x42, 0x71, 0xb1, 0x39, 0xe1, 0x2d, 0x4d, 0xf4, 0x4b, 0x46, 0xf0,
-	0xb5, 0x3b, 0x5b, 0x7e, 0x6b, 0xa5, 0x5e, 0xbe, 0xef, 0x04, 0xc7, 0x77, 0xed, 0x8c, 0xa8, 0x59,
-	0xf9, 0xb6, 0x26, 0x26, 0x96, 0x3a, 0x0b, 0xd3, 0x7b, 0x9b, 0x07, 0xf5, 0x13, 0xde, 0xde, 0xc4,
-	0x3b, 0x2b, 0x3c, 0x9f, 0xf7, 0xbd, 0x23, 0x19, 0xea, 0x8f, 0x4d, 0xcc, 0x28, 0x65, 0x48, 0xb5,
-	0x92, 0x4e, 0xda, 0x05, 0x19, 0xfb, 0x9a, 0xe1, 0x8c, 0x80, 0x65, 0xaf, 0x17, 0xfd, 0x53, 0x93,
-	0x5d, 0x45, 0x58, 0x29, 0x2a, 0x7b, 0x41, 0x08, 0x3c, 0xde, 0xc4, 0xdb, 0xc8, 0x01, 0x6c, 0xcc,
-	0x53, 0x1e, 0xc7, 0x72, 0x25, 0x95, 0xbc, 0x05, 0xd2, 0xd2, 0x3f, 0x37, 0xf1, 0x25, 0xd5, 0xe1,
-	0xa2, 0x59, 0xa4, 0x7f, 0xa9, 0xef, 0x54, 0x3a, 0x8d, 0xd0, 0x4c, 0xbc, 0x00, 0xef, 0x68, 0xfa,
-	0xd7, 0x26, 0xdb, 0x47, 0xae, 0xaa, 0xef, 0x5c, 0x06, 0x63, 0x0b, 0xb5, 0xff, 0xd6, 0xcc, 0xe2,
-	0xbe, 0x42, 0x23, 0xa1, 0x86, 0x18, 0x7f, 0x6f, 0x66, 0xaf, 0xcb, 0x33, 0x8a, 0x84, 0x5a, 0x27,
-	0xfc, 0x6a, 0x3e, 0x7b, 0x18, 0x43, 0x04, 0xdd, 0x6e, 0xfb, 0x98, 0x8e, 0xb0, 0x28, 0x20, 0xeb,
-	0x1f, 0xcd, 0x1a, 0x0b, 0x4c, 0x95, 0xc6, 0xda, 0x1a, 0x63, 0x52, 0x02, 0x7a, 0x92, 0xfe, 0xb3,
-	0x6e, 0x0b, 0xd6, 0x91, 0xf2, 0x65, 0x79, 0x21, 0x4f, 0xd4, 0x85, 0x78, 0xd8, 0x40, 0xa4, 0x1d,
-	0x0c, 0xb3, 0x9e, 0xac, 0x0b, 0xc1, 0xfe, 0x67, 0x18, 0x7e, 0xaa, 0xee, 0x90, 0x42, 0xdf, 0xd2,
-	0x9b, 0x4f, 0xfb, 0x78, 0x2d, 0xd1, 0x7c, 0x2c, 0xaa, 0xf0, 0x67, 0x86, 0x35, 0x8c, 0x25, 0x0f,
-	0x20, 0x6f, 0x6f, 0x10, 0x7e, 0xb6, 0x1e, 0x2a, 0xce, 0x70, 0x65, 0xdb, 0xda, 0x44, 0xc3, 0x0a,
-	0x3c, 0x57, 0xbf, 0x4b, 0x0b, 0x2e, 0xbb, 0x63, 0x0f, 0x3d, 0x5f, 0x3f, 0xbd, 0xdc, 0x74, 0xc2,
-	0x08, 0x97, 0x89, 0x7f, 0xa1, 0x1e, 0x65, 0x31, 0x37, 0xb6, 0x66, 0xba, 0x57, 0x22, 0x6b, 0xbd,
-	0x5f, 0x6c, 0xb2, 0x1b, 0xc8, 0x35, 0xf5, 0x5b, 0xcd, 0x83, 0x5b, 0x65, 0x5d, 0x5a, 0xd5, 0x32,
-	0xbc, 0xe4, 0x75, 0xc9, 0xd2, 0xb0, 0xad, 0x12, 0x1e, 0x0a, 0x79, 0x68, 0x9e, 0xed, 0x22, 0x57,
-	0x78, 0x28, 0x28, 0x60, 0x5c, 0x7f, 0xb8, 0x5a, 0x17, 0x51, 0xa7, 0x6a, 0xea, 0x1e, 0x99, 0x47,
-	0xdd, 0x33, 0xbe, 0xf7, 0x5b, 0x1a, 0x44, 0x61, 0xae, 0x24, 0xe2, 0x3f, 0x9d, 0xc7, 0x9a, 0x36,
-	0x8a, 0x63, 0x4f, 0xa7, 0xb4, 0x4a, 0x4f, 0x81, 0xd1, 0xd8, 0x3d, 0x66, 0x2e, 0xfa, 0xd9, 0x3c,
-	0xda, 0x39, 0x89, 0xeb, 0x44, 0x04, 0x21, 0xb6, 0x6b, 0x48, 0xfb, 0xf9, 0x3c, 0x96, 0xd3, 0x49,
-	0xb4, 0x32, 0x05, 0x7a, 0xde, 0x2f, 0xb6, 0xe4, 0xc1, 0x49, 0x08, 0x92, 0xf2, 0x11, 0xff, 0x72,
-	0x1e, 0xb3, 0xc1, 0x64, 0x9e, 0x28, 0xe6, 0x9e, 0x5f, 0xcf, 0x63, 0xfc, 0x4c, 0x24, 0x19, 0x43,
-	0x7f, 0x33, 0xa6, 0x79, 0x08, 0xd8, 0x59, 0x82, 0x0a, 0x04, 0x58, 0x4f, 0x45, 0xda, 0xa3, 0xf3,
-	0xec, 0x66, 0x72, 0xfd, 0x96, 0xb4, 0x44, 0x45, 0xdc, 0xd8, 0x2e, 0xcf, 0x5d, 0xfb, 0x98, 0x3f,
-	0x32, 0xbf, 0x1b, 0x3f, 0xc1, 0xa0, 0x5b, 0xf3, 0x24, 0x4b, 0xbf, 0xb4, 0x80, 0x8e, 0xaf, 0xa3,
-	0xe5, 0x7c, 0xe3, 0xf1, 0x2f, 0x2f, 0xe0, 0xab, 0xa9, 0x4a, 0x59, 0x76, 0xcc, 0xca, 0x08, 0xeb,
-	0x2b, 0x0b, 0xd8, 0x3d, 0x15, 0xac, 0x24, 0x96, 0x22, 0xf0, 0x11, 0xc3, 0x23, 0xb0, 0xa9, 0xe5,
-	0x11, 0x64, 0xa2, 0x91, 0xfa, 0xd5, 0x05, 0x54, 0x7e, 0x0b, 0x2a, 0x0f, 0x0c, 0x4e, 0x80, 0x48,
-	0xce, 0x82, 0xf1, 0x6b, 0x0b, 0x58, 0x83, 0x72, 0x76, 0x8b, 0x87, 0x08, 0xb9, 0x3c, 0x96, 0xbe,
-	0x5e, 0xc7, 0x7c, 0x08, 0x54, 0x0a, 0x7d, 0xa3, 0x6e, 0x56, 0x96, 0x0c, 0x63, 0xa3, 0x2b, 0xb9,
-	0xdf, 0xac, 0xe3, 0x21, 0xb4, 0x79, 0x22, 0x5d, 0xba, 0xcc, 0x65, 0x92, 0xe3, 0xdf, 0x5a, 0xc0,
-	0xe6, 0x72, 0xd8, 0x69, 0xae, 0x6b, 0x53, 0x9b, 0xb4, 0xac, 0x13, 0xae, 0xba, 0xf5, 0x6f, 0x2f,
-	0xb0, 0xff, 0x21, 0x37, 0xe4, 0xc4, 0x28, 0x91, 0x4e, 0xe0, 0xac, 0xab, 0x8d, 0x2b, 0xce, 0x1b,
-	0x1e, 0x4c, 0xbf, 0xb3, 0xb0, 0xf4, 0x8e, 0xed, 0xe4, 0x32, 0xbb, 0xb9, 0xba, 0xda, 0x5f, 0xdf,
-	0x38, 0x3b, 0x38, 0xef, 0x3f, 0x41, 0x34, 0x49, 0x43, 0x09, 0x49, 0x2f, 0xc1, 0x69, 0x9e, 0x87,
-	0x61, 0xa9, 0x90, 0x81, 0x58, 0xd3, 0xd3, 0x6c, 0x17, 0x61, 0x45, 0xb5, 0xaf, 0xad, 0xf7, 0x71,
-	0xfa, 0x1c, 0x5f, 0x4f, 0x3b, 0x52, 0xb7, 0xb8, 0xcc, 0x1f, 0x23, 0x3d, 0x83, 0x93, 0x70, 0x27,
-	0x90, 0x3a, 0x29, 0x8b, 0x38, 0x0e, 0xfb, 0x39, 0x8c, 0x4d, 0xfd, 0x2a, 0xdb, 0x4d, 0x76, 0x4e,
-	0x86, 0xee, 0x60, 0x8b, 0x64, 0x47, 0x76, 0x44, 0x2e, 0x22, 0xff, 0x4e, 0x41, 0xcf, 0x56, 0x48,
-	0xbe, 0xb5, 0xf8, 0x24, 0x71, 0x27, 0xaa, 0xdb, 0x16, 0x27, 0xb3, 0x37, 0x9f, 0x75, 0x0f, 0xd9,
-	0xa7, 0x83, 0x5d, 0x84, 0xe5, 0xdc, 0x62, 0xd8, 0x75, 0x66, 0x85, 0xae, 0xe1, 0x20, 0x8e, 0xfc,
-	0xda, 0xec, 0x5c, 0x96, 0xd1, 0xdc, 0x88, 0x73, 0x05, 0xc7, 0xf6, 0x78, 0xbb, 0xad, 0x65, 0x58,
-	0xf6, 0x56, 0xe5, 0x58, 0x4e, 0xcf, 0xa3, 0xa1, 0xc8, 0xa9, 0x0d, 0xc6, 0x85, 0x25, 0xdc, 0xd7,
-	0x87, 0x01, 0x3b, 0x42, 0x0e, 0x23, 0x63, 0xcb, 0x49, 0xd4, 0x4f, 0xac, 0x17, 0x70, 0x1a, 0x1e,
-	0x32, 0x6d, 0x9c, 0x58, 0x18, 0x7b, 0x37, 0xe6, 0xf3, 0xbc, 0xc7, 0x1e, 0x2b, 0xed, 0xf4, 0x81,
-	0x29, 0x1f, 0xa0, 0x1e, 0x2e, 0xbb, 0x9c, 0xac, 0x7b, 0xcb, 0xbf, 0x16, 0x09, 0x65, 0x1d, 0xbe,
-	0x53, 0xff, 0x31, 0xed, 0x41, 0xbf, 0x94, 0xc4, 0x38, 0xba, 0x43, 0xb6, 0xf4, 0x93, 0x29, 0x76,
-	0x0b, 0xb9, 0x69, 0x92, 0xe5, 0x59, 0x95, 0x2f, 0xfc, 0xa4, 0x97, 0xc1, 0x18, 0x11, 0x82, 0xa5,
-	0x0f, 0xf9, 0x4f, 0x53, 0x75, 0x21, 0xb7, 0xfe, 0x1f, 0x7d, 0x78, 0x8a, 0x1d, 0x25, 0x37, 0x6e,
-	0x29, 0xa6, 0xc8, 0xef, 0xf8, 0x04, 0x63, 0x1e, 0x00, 0x7d, 0x64, 0x0a, 0x7b, 0xc8, 0x42, 0xb9,
-	0xe2, 0x83, 0xde, 0x6f, 0xa7, 0x30, 0xdd, 0x8f, 0x4e, 0x14, 0x52, 0x77, 0x2c, 0x8e, 0xdb, 0xa5,
-	0xa5, 0x58, 0x5a, 0x85, 0x02, 0x6b, 0x31, 0x58, 0x5a, 0x80, 0x63, 0x76, 0x89, 0x55, 0xdb, 0x7c,
-	0xc1, 0xc1, 0xd9, 0xfa, 0x30, 0xd9, 0xc7, 0xc3, 0x10, 0x27, 0xcc, 0x2d, 0xe7, 0xdc, 0x83, 0x64,
-	0xcf, 0x10, 0x65, 0x6c, 0xc6, 0x3d, 0x42, 0x0e, 0x0d, 0x11, 0xb6, 0x98, 0x6f, 0x0f, 0x90, 0xdd,
-	0x43, 0xb4, 0xd1, 0xd9, 0x76, 0xf4, 0x9c, 0xb1, 0xb9, 0x76, 0x3f, 0x59, 0x1c, 0x21, 0x0c, 0xcd,
-	0xb4, 0x7b, 0xc9, 0xae, 0x61, 0x35, 0xea, 0xf3, 0x6c, 0xed, 0xf0, 0x89, 0xb3, 0x6c, 0xe9, 0xa3,
-	0xae, 0xb6, 0xae, 0x1e, 0x45, 0x1f, 0xf4, 0x23, 0x98, 0xff, 0x74, 0x50, 0x46, 0x11, 0xce, 0x82,
-	0x3b, 0x09, 0x4d, 0x94, 0x6f, 0xaa, 0xab, 0xe5, 0xa7, 0xfc, 0x70, 0x85, 0xe5, 0x24, 0x0f, 0x5d,
-	0x2c, 0xb0, 0xf4, 0x33, 0x33, 0x7e, 0x6c, 0x00, 0xd4, 0x46, 0x61, 0xf7, 0xdc, 0x96, 0xbc, 0x53,
-	0x76, 0x59, 0x6d, 0x2e, 0x2d, 0xd0, 0x47, 0x67, 0x50, 0xfd, 0x3c, 0x2a, 0xf2, 0x91, 0xbb, 0x48,
-	0x7b, 0xf4, 0xbb, 0xb3, 0xb5, 0x6c, 0x58, 0x8e, 0xbc, 0x45, 0x29, 0x08, 0xa1, 0xed, 0xa7, 0x5a,
-	0xad, 0x70, 0x9a, 0x5a, 0x24, 0x57, 0x96, 0x44, 0xae, 0x3a, 0x79, 0x58, 0xe1, 0x18, 0x55, 0x47,
-	0x72, 0xf9, 0x0e, 0x0c, 0x4e, 0x4f, 0x55, 0x85, 0xc9, 0x07, 0xe5, 0x3c, 0x75, 0xd6, 0x24, 0xdf,
-	0x37, 0xcb, 0x8e, 0x91, 0xa5, 0xad, 0x54, 0x28, 0x73, 0xbb, 0x05, 0x99, 0xbb, 0xed, 0xfe, 0x59,
-	0x76, 0x39, 0x21, 0x3a, 0x06, 0x95, 0x0a, 0x6b, 0x13, 0xa0, 0xaf, 0x6b, 0xd6, 0xc2, 0x37, 0xaf,
-	0xbd, 0x3a, 0x8a, 0xb8, 0x0a, 0xe9, 0x1f, 0x7c, 0x9b, 0xea, 0x93, 0xd0, 0x10, 0xe0, 0x1b, 0x06,
-	0x9d, 0x38, 0x6c, 0xd0, 0x97, 0xc8, 0x91, 0x49, 0x7b, 0xc7, 0x2a, 0x34, 0x76, 0xe9, 0x58, 0xe0,
-	0xfe, 0x2b, 0xd7, 0x17, 0x14, 0xfa, 0x78, 0xb3, 0xf5, 0xb2, 0x53, 0xff, 0xbf, 0x7a, 0x76, 0xe3,
-	0x8e, 0xcd, 0xdb, 0x8f, 0xbe, 0x7c, 0x70, 0xee, 0x58, 0x67, 0x30, 0x58, 0x5d, 0xeb, 0x07, 0x83,
-	0xf3, 0x1b, 0xb7, 0x9d, 0x3d, 0xdf, 0xbf, 0xe8, 0x06, 0x83, 0xb5, 0xf5, 0x63, 0xeb, 0x77, 0xdd,
-	0x76, 0xe6, 0xcc, 0x60, 0xed, 0xf4, 0x31, 0xff, 0x37, 0x8b, 0x63, 0xfe, 0x6f, 0x16, 0xb7, 0xcf,
-	0xf9, 0xff, 0xdc, 0xfa, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x66, 0xfc, 0x10, 0xd6, 0x18,
-	0x00, 0x00,
+	// 2809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x59, 0x59, 0x94, 0x5c, 0xb5,
+	0xd1, 0xa6, 0xa7, 0x67, 0xa6, 0x7b, 0x64, 0x16, 0x21, 0x6c, 0xe3, 0x7d, 0xc1, 0x98, 0x65, 0xe0,
+	0xb7, 0xf9, 0x43, 0x4e, 0xde, 0xd5, 0xf7, 0xaa, 0xbb, 0xe5, 0xbe, 0x57, 0xba, 0x47, 0xd2, 0x9d,
+	0x71, 0xfb, 0xe5, 0x1e, 0x88, 0xdb, 0x83, 0x61, 0xec, 0x36, 0x9e, 0x19, 0x08, 0x49, 0x1e, 0x78,
+	0xc8, 0x4a, 0x92, 0x73, 0xb2, 0x41, 0xd6, 0x87, 0xec, 0x79, 0x49, 0x20, 0x40, 0xf6, 0x85, 0xc0,
+	0xc9, 0x4b, 0x12, 0xf6, 0xac, 0x90, 0x90, 0xb7, 0xe4, 0x9c, 0x90, 0x84, 0xac, 0x60, 0x76, 0xc8,
+	0x29, 0xe9, 0xae, 0xdd, 0x3d, 0xe4, 0xc9, 0x6d, 0x7d, 0xdf, 0x2d, 0x55, 0x95, 0x4a, 0x55, 0xa5,
+	0x1a, 0xb4, 0x61, 0x70, 0x72, 0xed, 0xc4, 0xca, 0x81, 0x53, 0xa7, 0x87, 0xab, 0x43, 0xb2, 0xc1,
+	0xfe, 0x73, 0xc0, 0x2e, 0xcd, 0xdf, 0x8c, 0x36, 0xb4, 0xd6, 0x8e, 0x2f, 0x1f, 0x1d, 0x9c, 0x36,
+	0xb7, 0x9c, 0x1a, 0x90, 0x2d, 0x68, 0x63, 0x2c, 0x7a, 0x42, 0x2e, 0x8a, 0xa4, 0x15, 0xf3, 0xc0,
+	0x67, 0x2a, 0x31, 0xfd, 0x88, 0xe1, 0xb3, 0x48, 0x03, 0xd5, 0x0f, 0xf1, 0x16, 0xae, 0x91, 0x39,
+	0x34, 0xd3, 0xa2, 0x47, 0x58, 0x80, 0xa7, 0xc8, 0xb9, 0x08, 0x59, 0x56, 0x44, 0xbd, 0x9e, 0xc6,
+	0x75, 0x82, 0xd0, 0xac, 0x17, 0x6b, 0x23, 0x43, 0x3c, 0x0d, 0xbf, 0x7b, 0x54, 0xf0, 0x9e, 0xc4,
+	0x33, 0xf0, 0xdb, 0x97, 0x5e, 0x8f, 0x29, 0x3c, 0x4b, 0x66, 0xd1, 0x54, 0x4f, 0xe2, 0xc6, 0xbc,
+	0x8f, 0xe6, 0xec, 0xc6, 0x76, 0xdb, 0xcd, 0x88, 0x54, 0xb6, 0xcd, 0x36, 0xdd, 0x80, 0x1a, 0x5e,
+	0x10, 0x6b, 0xc3, 0x14, 0xae, 0x81, 0x06, 0x1d, 0xaf, 0x85, 0xa7, 0x40, 0x83, 0x40, 0x7a, 0x34,
+	0xc0, 0xf5, 0xf9, 0x1e, 0x42, 0x66, 0xb0, 0xb2, 0x9a, 0x6a, 0xbf, 0x09, 0x9d, 0x9f, 0x89, 0x31,
+	0x4c, 0x9b, 0x4c, 0x4a, 0x13, 0x4d, 0xc7, 0x82, 0x1b, 0x5c, 0x23, 0x3b, 0xd0, 0x16, 0x4f, 0x0a,
+	0x43, 0xb9, 0x60, 0x2a, 0xd1, 0x46, 0xc5, 0x9e, 0x89, 0x15, 0xb3, 0x64, 0x3c, 0x35, 0x2f, 0xd1,
+	0xd9, 0xfe, 0xe0, 0xd4, 0xf2, 0xf0, 0x96, 0x54, 0xdc, 0x56, 0xb4, 0x29, 0x13, 0xe7, 0xb3, 0x28,
+	0x90, 0xfd, 0xc2, 0x1b, 0x4d, 0x34, 0xdd, 0x65, 0x41, 0x88, 0x6b, 0xe4, 0x1c, 0x34, 0xd7, 0xb3,
+	0x36, 0xf3, 0x23, 0x0c, 0x4f, 0x81, 0xc6, 0xbd, 0xb8, 0xc5, 0x3c, 0x03, 0xda, 0x71, 0xb4, 0xc1,
+	0x5b, 0x5e, 0x5b, 0x59, 0x1d, 0x77, 0x6e, 0x6a, 0x55, 0x26, 0xee, 0x6c, 0xd4, 0x0c, 0xb9, 0xe0,
+	0xf0, 0x65, 0x6a, 0x68, 0x8f, 0x39, 0x43, 0xa5, 0xe9, 0x32, 0x85, 0xeb, 0xf3, 0x87, 0x50, 0x33,
+	0x18, 0x2e, 0x05, 0x83, 0x9b, 0x06, 0xcb, 0xb0, 0xec, 0xb3, 0x56, 0xdc, 0x71, 0x7a, 0x70, 0xd1,
+	0x96, 0xb8, 0x06, 0xbf, 0x16, 0xa9, 0x12, 0xee, 0x2b, 0xa6, 0x94, 0x54, 0xb8, 0x0e, 0x3f, 0xdb,
+	0xd4, 0xd0, 0x00, 0x4f, 0xc3, 0xcf, 0x88, 0x0a, 0xee, 0xe1, 0x99, 0xf9, 0x7f, 0xed, 0x43, 0x48,
+	0xaf, 0x5e, 0xb3, 0xba, 0xb6, 0xe2, 0x0d, 0x8f, 0x0e, 0xe0, 0x44, 0x64, 0x0f, 0x9f, 0x45, 0xb6,
+	0xa0, 0x0b, 0xb4, 0xa1, 0x26, 0xd6, 0x5e, 0x97, 0x79, 0xbd, 0x44, 0xc7, 0x9e, 0xc7, 0xb4, 0xc6,
+	0x3f, 0xad, 0x11, 0x82, 0xce, 0x71, 0xc7, 0x92, 0xad, 0xfd, 0xac, 0x46, 0x2e, 0x40, 0xe7, 0x3a,
+	0xa7, 0xe4, 0x8b, 0x3f, 0xaf, 0x91, 0xf3, 0xd1, 0xd9, 0xd6, 0xf1, 0xd9, 0xd2, 0x83, 0xd6, 0xe5,
+	0xee, 0xdb, 0x28, 0xd6, 0xdd, 0x84, 0xda, 0xf5, 0xc4, 0x67, 0x82, 0x33, 0x1f, 0x0f, 0xc8, 0x76,
+	0x74, 0x61, 0x8a, 0x2a, 0x79, 0x88, 0x79, 0x26, 0x11, 0xd2, 0x24, 0x6d, 0x19, 0x0b, 0x1f, 0x1f,
+	0x23, 0xfb, 0xd0, 0x6e, 0x07, 0xba, 0xe0, 0x49, 0x7c, 0xca, 0x42, 0x29, 0x2c, 0x45, 0xc5, 0x42,
+	0x70, 0xd1, 0xc1, 0x4b, 0x64, 0x23, 0xc2, 0x8e, 0x14, 0x6b, 0xa6, 0x12, 0x67, 0xf8, 0x75, 0xc5,
+	0xae, 0xe9, 0xa7, 0xb1, 0xa0, 0x0b, 0x94, 0x07, 0xb4, 0x15, 0x30, 0x7c, 0x9c, 0xec, 0x44, 0x5b,
+	0x47, 0xd1, 0xd8, 0x74, 0xa5, 0xe2, 0x47, 0x98, 0x8f, 0xaf, 0x2f, 0x94, 0x4a, 0x61, 0xdd, 0xd7,
+	0x86, 0x85, 0x20, 0x1b, 0xdf, 0x40, 0xf6, 0xa2, 0x9d, 0x15, 0x10, 0xb4, 0x09, 0xa5, 0xcf, 0xdb,
+	0x9c, 0xf9, 0x96, 0xb2, 0x4c, 0x2e, 0x46, 0x7b, 0xc6, 0x28, 0x3c, 0x8c, 0x02, 0x16, 0x32, 0x61,
+	0x52, 0xd6, 0x09, 0xb2, 0x0b, 0x6d, 0x1b, 0xb1, 0xce, 0xd0, 0x24, 0x90, 0x5a, 0x5b, 0xfc, 0xe4,
+	0x18, 0xde, 0x96, 0xaa, 0xc5, 0x7d, 0x9f, 0x09, 0x8b, 0x0f, 0xc7, 0x8c, 0xf0, 0xa4, 0x68, 0x07,
+	0xdc, 0x33, 0x16, 0x3e, 0x45, 0xf6, 0xa0, 0x1d, 0x15, 0xd8, 0x7a, 0xa6, 0xe4, 0xde, 0x1b, 0xc9,
+	0x45, 0x68, 0x57, 0x85, 0xc1, 0xc5, 0x02, 0x0d, 0xb8, 0x9f, 0x44, 0x54, 0x51, 0x67, 0xed, 0xe9,
+	0x51, 0x25, 0xda, 0x3c, 0x60, 0x25, 0x19, 0x2b, 0x63, 0xa6, 0x7a, 0xd4, 0xeb, 0xb2, 0xa4, 0xad,
+	0x64, 0x98, 0x44, 0x71, 0x10, 0x58, 0x29, 0xab, 0x64, 0x37, 0xda, 0x5e, 0x61, 0x75, 0x98, 0x49,
+	0x7c, 0xde, 0x81, 0x48, 0x01, 0xc2, 0x5a, 0xe1, 0x54, 0xc5, 0x3a, 0x5c, 0x1b, 0xd5, 0x1f, 0xa5,
+	0xdc, 0x54, 0x50, 0xb2, 0x2b, 0x74, 0x88, 0xb7, 0x92, 0x28, 0x88, 0x3b, 0x5c, 0xb8, 0x5b, 0x74,
+	0x73, 0x71, 0xe8, 0x00, 0x75, 0x14, 0xf5, 0x03, 0x06, 0x17, 0xd7, 0x0a, 0x78, 0x5b, 0x71, 0xaa,
+	0x80, 0x86, 0x74, 0x81, 0x89, 0x1c, 0xbc, 0x85, 0xcc, 0xa3, 0x4b, 0xb8, 0xe0, 0x26, 0x3f, 0x31,
+	0x66, 0x16, 0xa5, 0xea, 0x25, 0x01, 0xd7, 0x86, 0x8b, 0x4e, 0x92, 0x27, 0x0d, 0x8d, 0xdf, 0x4e,
+	0x0e, 0xa0, 0xf9, 0x49, 0xdc, 0xcc, 0x7d, 0x39, 0x37, 0x11, 0x34, 0x64, 0xf8, 0x1d, 0xe4, 0x2a,
+	0x74, 0xe5, 0x24, 0x7e, 0xc1, 0xf3, 0x25, 0xd3, 0xd6, 0xab, 0xec, 0x30, 0xd7, 0x06, 0xbf, 0x93,
+	0xec, 0x46, 0xdb, 0xca, 0x37, 0x91, 0x87, 0xb4, 0xc3, 0x0a, 0x7f, 0x7e, 0x6d, 0x8a, 0xec, 0x43,
+	0xbb, 0xca, 0x84, 0x42, 0x94, 0xa7, 0x18, 0x05, 0x8d, 0xf1, 0xd7, 0xa7, 0xc8, 0x45, 0x68, 0x67,
+	0x99, 0xa4, 0x62, 0x51, 0x22, 0x82, 0xa0, 0x3b, 0xa7, 0xc8, 0x7e, 0xb4, 0x67, 0xb2, 0x20, 0xc3,
+	0x54, 0xc8, 0x05, 0x35, 0xcc, 0xc7, 0x77, 0x4d, 0x91, 0x2b, 0xd0, 0x25, 0x65, 0x9a, 0xbb, 0xf8,
+	0x10, 0xcd, 0x89, 0x92, 0x41, 0x20, 0x63, 0x93, 0x44, 0x4c, 0xf8, 0xb0, 0xef, 0x37, 0xa6, 0xc8,
+	0x76, 0xb4, 0xb9, 0x4c, 0x3e, 0x24, 0x5b, 0x49, 0x9b, 0xf2, 0x80, 0xf9, 0xf8, 0xee, 0x31, 0xcd,
+	0x15, 0xd3, 0x32, 0x56, 0x9e, 0x0b, 0x2a, 0xc5, 0xa8, 0xdf, 0xc7, 0xf7, 0x4c, 0x91, 0x3d, 0x68,
+	0xfb, 0x44, 0x52, 0x2a, 0xe6, 0xde, 0x37, 0xd0, 0x5b, 0x31, 0x6d, 0xa8, 0xb2, 0x2e, 0xf8, 0xe3,
+	0x14, 0xd9, 0x86, 0x36, 0x95, 0x69, 0xb1, 0xe8, 0x32, 0x1a, 0x98, 0x6e, 0x1f, 0xff, 0x69, 0x4c,
+	0x84, 0x90, 0x3e, 0x4b, 0x42, 0x16, 0x4a, 0xd5, 0x4f, 0x22, 0xc5, 0xb4, 0x8e, 0x15, 0xc3, 0x1f,
+	0xae, 0x8f, 0x2a, 0x6c, 0x69, 0x3e, 0xd7, 0xbd, 0x82, 0xf4, 0x91, 0x3a, 0xb9, 0x1c, 0x5d, 0x3c,
+	0x46, 0xca, 0xce, 0xb9, 0x9c, 0x7a, 0x3e, 0x5a, 0x1f, 0x3d, 0x15, 0x4b, 0x8d, 0xb8, 0x5f, 0x88,
+	0xfb, 0xd8, 0xe4, 0x3d, 0x63, 0x01, 0xff, 0xf3, 0x63, 0x27, 0xe8, 0xe3, 0x75, 0xb2, 0x17, 0xed,
+	0x98, 0x40, 0x52, 0x8c, 0x7a, 0x5d, 0x4b, 0xb9, 0xbd, 0x3e, 0x1a, 0x47, 0x4e, 0xad, 0xdc, 0xd1,
+	0x77, 0x8c, 0x29, 0xe3, 0xfc, 0x9b, 0xa4, 0x1b, 0x81, 0x0f, 0x3f, 0x51, 0x27, 0x97, 0xa2, 0x8b,
+	0xca, 0x9c, 0xb4, 0xba, 0x81, 0xcb, 0x05, 0xf3, 0x0c, 0x97, 0x2e, 0x1f, 0x7d, 0x6a, 0x4c, 0xeb,
+	0x8c, 0x08, 0xc6, 0xf5, 0x78, 0x00, 0x07, 0xf7, 0xe9, 0x31, 0x4f, 0xe5, 0xd2, 0x02, 0x0e, 0xd1,
+	0xd4, 0x66, 0xc6, 0xeb, 0x5a, 0x79, 0x9f, 0xa9, 0x8f, 0x1e, 0x50, 0x29, 0xe8, 0x0a, 0xda, 0x67,
+	0xc7, 0xfc, 0x10, 0x49, 0x3f, 0x81, 0xeb, 0xc6, 0x69, 0xc0, 0x8f, 0x80, 0x09, 0x3f, 0xa9, 0x43,
+	0xfd, 0xca, 0xb2, 0x86, 0x2b, 0x10, 0xcf, 0xd6, 0x47, 0xab, 0x5d, 0x8a, 0xe3, 0xe7, 0xea, 0xe4,
+	0x12, 0xb4, 0x77, 0x02, 0x32, 0x72, 0x00, 0x67, 0xea, 0x64, 0x1e, 0xed, 0x9f, 0x1c, 0x83, 0x8b,
+	0x94, 0xdb, 0xac, 0x91, 0xc9, 0x7c, 0xbe, 0x4e, 0x76, 0xa1, 0xad, 0x93, 0x64, 0xb2, 0x05, 0x26,
+	0x0c, 0x7e, 0xb5, 0x5e, 0xaa, 0xa6, 0xd9, 0x47, 0x2f, 0xd4, 0xa1, 0x9a, 0xea, 0xbe, 0xf0, 0xf2,
+	0xa5, 0x17, 0xeb, 0x45, 0x25, 0xce, 0xd6, 0x5e, 0xaa, 0x93, 0x8d, 0xe8, 0x3c, 0x9f, 0x2d, 0xd8,
+	0x14, 0x93, 0xad, 0xbe, 0x6c, 0x57, 0xbd, 0x80, 0x51, 0x11, 0x47, 0xf9, 0xea, 0x2b, 0x56, 0x64,
+	0x85, 0xf8, 0x5a, 0x9d, 0x6c, 0x45, 0x1b, 0x47, 0x8a, 0xa1, 0x83, 0x5e, 0xaf, 0xe7, 0xe5, 0x3c,
+	0x5b, 0xba, 0x75, 0x1a, 0xc4, 0x5a, 0x9d, 0xac, 0x14, 0xe7, 0xcc, 0x27, 0xa7, 0xe1, 0xc2, 0x66,
+	0x2a, 0xb8, 0x0c, 0xce, 0x54, 0xda, 0xc8, 0xf9, 0x2c, 0xd2, 0xf8, 0x47, 0x33, 0x10, 0x8a, 0x63,
+	0x0c, 0x2b, 0xdb, 0x12, 0xee, 0x9b, 0x81, 0x63, 0x1c, 0x23, 0xa4, 0x2e, 0xb1, 0x94, 0x1f, 0xcf,
+	0x4c, 0xdc, 0x05, 0xaa, 0x1e, 0xef, 0x00, 0x05, 0xdf, 0x3f, 0x43, 0x2e, 0x46, 0xbb, 0x0b, 0x57,
+	0xe8, 0x38, 0x8a, 0xa4, 0x82, 0x82, 0xbb, 0xf0, 0xff, 0x49, 0x48, 0x05, 0x6f, 0x43, 0x9b, 0xf7,
+	0xc0, 0xcc, 0xe8, 0xb5, 0xb0, 0x8d, 0x83, 0x47, 0x85, 0xc7, 0x6c, 0x90, 0x7e, 0x6e, 0x76, 0xf4,
+	0x5a, 0xf8, 0x8c, 0xfa, 0x01, 0x17, 0x2c, 0x61, 0x87, 0x3d, 0xc6, 0x7c, 0xe6, 0xe3, 0xcf, 0xcf,
+	0x82, 0x23, 0x9c, 0x85, 0xc5, 0x97, 0x5f, 0x98, 0x25, 0x9b, 0x10, 0x4e, 0x95, 0x2e, 0x96, 0xbf,
+	0x38, 0x0b, 0x29, 0x71, 0xa4, 0x4c, 0x66, 0xe0, 0x97, 0x66, 0x21, 0x49, 0x55, 0xc0, 0x6c, 0x3b,
+	0xfc, 0xe5, 0xd2, 0x87, 0x90, 0xd3, 0xda, 0xd4, 0x33, 0x89, 0xe1, 0x21, 0x93, 0xb1, 0xc1, 0x5f,
+	0x99, 0x25, 0x3b, 0xd1, 0x16, 0x6b, 0xaa, 0x4d, 0xfa, 0x2c, 0x31, 0xb4, 0xd3, 0xc9, 0x5b, 0xa0,
+	0x77, 0x37, 0xc0, 0x4c, 0x0b, 0x67, 0x9d, 0x65, 0x12, 0xd1, 0x58, 0xbb, 0xf6, 0x43, 0x2a, 0xfc,
+	0x9e, 0x06, 0x78, 0xab, 0x4a, 0x28, 0x75, 0x56, 0x29, 0xeb, 0xbd, 0x0d, 0x08, 0xdd, 0xf2, 0x2e,
+	0xd9, 0xb3, 0xc0, 0xe1, 0xef, 0x2b, 0xb6, 0x49, 0xf1, 0xbc, 0x53, 0x76, 0x84, 0xf7, 0x8f, 0x11,
+	0xb2, 0x53, 0x4f, 0x09, 0xb7, 0x35, 0xc0, 0x69, 0x8e, 0x60, 0x9b, 0x07, 0xb7, 0xfc, 0x81, 0x42,
+	0xbd, 0xf4, 0xbb, 0x45, 0x0a, 0x97, 0xde, 0x28, 0x5e, 0xb2, 0xf2, 0x83, 0x0d, 0xc8, 0x3a, 0x65,
+	0x56, 0xee, 0xa7, 0x62, 0x87, 0x0f, 0x35, 0xe0, 0x40, 0xb3, 0x63, 0x49, 0x1b, 0xef, 0x91, 0xf4,
+	0xf5, 0x74, 0x03, 0xd2, 0x4d, 0x1e, 0x6f, 0xad, 0xb8, 0x93, 0x74, 0x59, 0x10, 0xd9, 0x82, 0x62,
+	0x14, 0x67, 0x0b, 0x56, 0x2f, 0xfc, 0x97, 0x06, 0xb9, 0x10, 0x91, 0x5c, 0x94, 0xbb, 0x5e, 0x00,
+	0xfc, 0xb5, 0x01, 0xa7, 0x91, 0x02, 0xf0, 0x32, 0x48, 0x68, 0x14, 0x05, 0xfd, 0x24, 0xa0, 0x2d,
+	0x16, 0x68, 0xfc, 0xb7, 0x06, 0x5c, 0xb3, 0x32, 0x9c, 0x75, 0xab, 0xf8, 0x99, 0xf2, 0x97, 0x42,
+	0x26, 0x21, 0x98, 0x09, 0x07, 0x60, 0x1d, 0x8d, 0xff, 0xde, 0x20, 0x3b, 0xd0, 0x85, 0xe5, 0x2f,
+	0x17, 0x98, 0xd2, 0x99, 0xda, 0xff, 0x68, 0xb8, 0x4b, 0x51, 0xa0, 0x21, 0x17, 0x15, 0xc6, 0x3f,
+	0x1b, 0xee, 0xea, 0x59, 0x46, 0x96, 0x6d, 0xcb, 0x84, 0x5f, 0x37, 0xdd, 0xad, 0xa9, 0x10, 0x64,
+	0xbb, 0x6d, 0x03, 0x3e, 0x84, 0x8a, 0x01, 0xac, 0x7f, 0x37, 0x4a, 0x2c, 0xa6, 0x8a, 0x1c, 0xd7,
+	0x96, 0x10, 0xb0, 0x01, 0x03, 0x4f, 0xe2, 0xff, 0x94, 0x6d, 0x81, 0x22, 0x93, 0x5f, 0x3b, 0x2b,
+	0xe4, 0xd9, 0xb2, 0x10, 0x0b, 0x2b, 0x16, 0x4a, 0xc3, 0xaa, 0xac, 0xe7, 0xca, 0x42, 0xa0, 0x01,
+	0xab, 0xc2, 0x67, 0xca, 0x0e, 0xc9, 0xf4, 0xcd, 0xbd, 0xf9, 0xbc, 0x8d, 0xd7, 0x1c, 0x4d, 0xdf,
+	0x65, 0x05, 0xfe, 0x42, 0x55, 0xc3, 0x28, 0xa0, 0x1e, 0x4b, 0xfb, 0x2b, 0x80, 0x5f, 0x2c, 0x87,
+	0x8a, 0x51, 0x54, 0xe8, 0xb6, 0x54, 0x61, 0x55, 0x81, 0x97, 0xca, 0x67, 0xa9, 0x99, 0x71, 0x67,
+	0x6c, 0xa1, 0x97, 0xcb, 0xbb, 0xe7, 0x1f, 0x2d, 0x2a, 0x6e, 0x9c, 0xf8, 0x57, 0xca, 0x51, 0x16,
+	0x51, 0xa5, 0x4b, 0xa6, 0x5b, 0x25, 0x5c, 0xef, 0xff, 0x6a, 0x83, 0x5c, 0x86, 0xf6, 0x95, 0x4f,
+	0x35, 0x0d, 0x6e, 0xe1, 0xda, 0xc4, 0xa2, 0x9f, 0x78, 0xcd, 0xc6, 0x7f, 0xca, 0x74, 0x66, 0x68,
+	0xde, 0x11, 0xd4, 0xbe, 0x6a, 0x43, 0xae, 0x35, 0x14, 0xc0, 0xd7, 0xdf, 0x88, 0x94, 0x76, 0xad,
+	0xf8, 0xd6, 0x26, 0x58, 0xe5, 0xb2, 0xbd, 0x2e, 0xf2, 0x2a, 0xa8, 0xf3, 0x70, 0x93, 0x6c, 0x46,
+	0xe7, 0x5b, 0xc8, 0xcb, 0x60, 0x58, 0x7f, 0xa4, 0x58, 0xe7, 0x61, 0xa7, 0xe8, 0x4f, 0x1f, 0x6d,
+	0x82, 0x17, 0x1c, 0xdf, 0x9e, 0x40, 0xe2, 0x85, 0x7e, 0x6a, 0x2e, 0xe0, 0x8f, 0x35, 0xa1, 0x74,
+	0x8e, 0xe2, 0x2a, 0x16, 0x89, 0x90, 0x22, 0x39, 0xc2, 0x94, 0x84, 0x46, 0xd8, 0x39, 0xfb, 0xf1,
+	0x26, 0x78, 0x6c, 0x12, 0x17, 0xf2, 0xa0, 0x2f, 0x63, 0x47, 0xfb, 0x45, 0x13, 0xaa, 0xf6, 0x24,
+	0x5a, 0x9e, 0x69, 0x2d, 0xef, 0x97, 0xeb, 0xf2, 0xd8, 0x61, 0xe6, 0xc5, 0x79, 0x3a, 0xf8, 0x55,
+	0x13, 0x5c, 0x36, 0x99, 0xc7, 0xb3, 0x27, 0xdc, 0x6f, 0x9a, 0x10, 0x89, 0x13, 0x49, 0x4a, 0xe1,
+	0xdf, 0x8e, 0x69, 0xee, 0x33, 0x68, 0x92, 0x99, 0xf0, 0x38, 0xd3, 0x96, 0x0a, 0xb4, 0x27, 0x9a,
+	0xe4, 0x4a, 0x74, 0xe9, 0xba, 0xb4, 0x58, 0x84, 0x54, 0xe9, 0x2e, 0x4d, 0x5d, 0xfb, 0x64, 0x13,
+	0xfa, 0x16, 0xcb, 0x86, 0xb6, 0x3a, 0x0d, 0x0b, 0x40, 0x7e, 0x57, 0x45, 0xd2, 0x4e, 0x0e, 0x90,
+	0xdf, 0x37, 0xa1, 0xc2, 0xe4, 0x48, 0xc5, 0x6f, 0x4f, 0x15, 0x26, 0x00, 0x36, 0xf2, 0xd0, 0xf8,
+	0x83, 0x45, 0xd3, 0x48, 0xb0, 0x4f, 0x3f, 0x38, 0xc4, 0xb4, 0x38, 0xe0, 0xbb, 0xe7, 0xe0, 0x98,
+	0xcb, 0x68, 0xfe, 0x30, 0xb4, 0xf8, 0x3d, 0x73, 0x70, 0xdb, 0x8b, 0xfa, 0xec, 0x8c, 0xea, 0x8f,
+	0xb0, 0xee, 0x9d, 0x83, 0x96, 0x30, 0x63, 0xc5, 0x51, 0xc0, 0x3d, 0x1b, 0xe9, 0x34, 0x64, 0x3a,
+	0xd1, 0x34, 0x64, 0x4e, 0x34, 0x50, 0xbf, 0x39, 0x07, 0xae, 0x5a, 0x87, 0x4a, 0x3d, 0x05, 0x4f,
+	0x67, 0x20, 0xbb, 0x4b, 0xf4, 0xad, 0x39, 0x30, 0x3b, 0x65, 0xb7, 0xa8, 0x0f, 0x90, 0x49, 0x23,
+	0xf7, 0xdb, 0x65, 0xcc, 0x06, 0x5c, 0xa1, 0xd0, 0x77, 0xca, 0x66, 0xb9, 0x24, 0x1e, 0x29, 0x59,
+	0xc8, 0xfd, 0x6e, 0x19, 0xf7, 0x59, 0x9b, 0xc6, 0x81, 0x49, 0x16, 0x68, 0x10, 0xa7, 0xf8, 0xf7,
+	0xe6, 0xa0, 0x63, 0xae, 0x3a, 0xcd, 0x74, 0x75, 0xa2, 0xe3, 0x96, 0x36, 0xdc, 0x14, 0x31, 0xf6,
+	0xfd, 0x39, 0xf2, 0x7f, 0xe8, 0xb2, 0x94, 0x18, 0xc6, 0x81, 0xe1, 0x30, 0x24, 0x90, 0xca, 0x64,
+	0xfb, 0x55, 0x5f, 0xf4, 0x3f, 0x98, 0x83, 0xc3, 0x58, 0x60, 0x8a, 0xb7, 0xfb, 0x89, 0xa5, 0xb5,
+	0xa5, 0x5a, 0xa4, 0xca, 0x69, 0x7d, 0x3b, 0x2a, 0xa1, 0x95, 0x66, 0x1e, 0xd0, 0x3b, 0xd0, 0xfc,
+	0x6d, 0xe7, 0xa0, 0x73, 0xf5, 0xda, 0xd2, 0xd2, 0x60, 0x65, 0xf5, 0xf8, 0xf0, 0xa4, 0x9d, 0xfb,
+	0x34, 0x50, 0x5d, 0xf0, 0x00, 0x9f, 0x05, 0x23, 0x14, 0xea, 0xfb, 0xb9, 0x31, 0x8a, 0x45, 0x12,
+	0x1f, 0x85, 0x99, 0x5c, 0xd6, 0xfe, 0x94, 0xd6, 0x07, 0xf0, 0xe4, 0x1f, 0x5f, 0x4f, 0x3a, 0x81,
+	0x6c, 0xd1, 0x20, 0x4d, 0x1b, 0xf8, 0x18, 0x8c, 0x1f, 0x3a, 0x5e, 0x20, 0xe3, 0xbc, 0xab, 0x81,
+	0x09, 0x4b, 0x0a, 0xc3, 0x2b, 0x67, 0x09, 0xa6, 0x6b, 0x93, 0xa1, 0xeb, 0x60, 0x50, 0xe6, 0xb6,
+	0x48, 0x45, 0xa4, 0xc3, 0x21, 0x7c, 0xbc, 0x40, 0xd2, 0x4f, 0xb3, 0x39, 0xd0, 0xf5, 0xa0, 0x6e,
+	0x9b, 0x1f, 0x76, 0xd9, 0xc9, 0xb5, 0x4c, 0x6e, 0x5e, 0xb3, 0x19, 0x91, 0x94, 0xeb, 0x56, 0x15,
+	0x33, 0xaa, 0x8f, 0x97, 0x61, 0xfa, 0x01, 0xfc, 0xd2, 0xc0, 0x22, 0x6f, 0x1d, 0x52, 0x23, 0x4e,
+	0x64, 0x1c, 0xdd, 0xa3, 0xed, 0xb6, 0x0c, 0xfc, 0xbc, 0xd9, 0xcc, 0x67, 0x21, 0xf8, 0x24, 0x18,
+	0x0a, 0x9c, 0xd2, 0x34, 0x22, 0xb3, 0x84, 0xda, 0x9a, 0x38, 0x24, 0xfb, 0xd1, 0x5e, 0x60, 0xac,
+	0xfb, 0xfc, 0xb7, 0x63, 0x82, 0x53, 0x30, 0x82, 0xa8, 0x98, 0x36, 0x4e, 0xcc, 0x8c, 0xbd, 0x11,
+	0x6a, 0x98, 0xe3, 0x8e, 0xb7, 0x33, 0x30, 0x9b, 0x83, 0xe0, 0xb6, 0x70, 0xde, 0xd9, 0xb9, 0x76,
+	0x36, 0x1d, 0xd1, 0x71, 0xa1, 0x0d, 0x64, 0x14, 0x3b, 0xc1, 0x7c, 0xd0, 0x2e, 0xc5, 0x11, 0xcc,
+	0x4b, 0x98, 0x5b, 0x7a, 0xa8, 0x46, 0xae, 0x42, 0x57, 0x4c, 0xb2, 0xdc, 0x75, 0x36, 0x99, 0x9f,
+	0xe4, 0x02, 0x53, 0x8a, 0xfb, 0x4c, 0xe3, 0x87, 0xed, 0x3c, 0xb0, 0x2c, 0xe4, 0xea, 0x37, 0xe1,
+	0x47, 0x6a, 0xe4, 0x00, 0xba, 0x7c, 0x5d, 0x31, 0x59, 0x4d, 0x83, 0xeb, 0x1b, 0x51, 0x8f, 0xe1,
+	0x47, 0x6b, 0xe4, 0x3c, 0x84, 0xa0, 0x34, 0xb9, 0x44, 0x84, 0x1f, 0xab, 0x41, 0xff, 0xeb, 0x2c,
+	0xb1, 0xa1, 0x0d, 0x17, 0x1e, 0xae, 0x4f, 0x8f, 0xf5, 0xf1, 0xe3, 0x35, 0x68, 0xc1, 0x33, 0x53,
+	0xb2, 0x99, 0xeb, 0x53, 0x35, 0x28, 0x63, 0xa3, 0x0f, 0xb2, 0x40, 0x76, 0x34, 0x4c, 0x44, 0x72,
+	0xbf, 0x40, 0xf3, 0xc1, 0x05, 0xd3, 0x1a, 0x42, 0xab, 0xc5, 0xf0, 0x9d, 0x25, 0xac, 0xf8, 0xcc,
+	0x69, 0x71, 0xd7, 0x14, 0xbc, 0x4d, 0xa8, 0xef, 0xc3, 0x03, 0x7d, 0xdd, 0x31, 0xc1, 0x6e, 0xb4,
+	0xad, 0x42, 0x19, 0x1b, 0x11, 0xec, 0x47, 0x7b, 0x2a, 0x84, 0x75, 0xc6, 0x03, 0xbb, 0xd0, 0xd6,
+	0x0a, 0x6d, 0x74, 0x34, 0x30, 0xba, 0xcf, 0xd8, 0x58, 0x60, 0x27, 0xda, 0x32, 0x42, 0xa8, 0x8c,
+	0x04, 0xb6, 0xa3, 0xcd, 0x55, 0x35, 0xca, 0xe3, 0x80, 0xd2, 0xe6, 0x13, 0x47, 0x01, 0xb9, 0x8f,
+	0xba, 0x52, 0x9b, 0x72, 0xcc, 0x7d, 0xd2, 0xbe, 0x60, 0xed, 0xe4, 0x25, 0x8f, 0x39, 0x78, 0x4a,
+	0x6f, 0x42, 0x38, 0x16, 0xf6, 0xd9, 0x51, 0x2c, 0x9f, 0xb1, 0x6f, 0x53, 0x28, 0x93, 0x69, 0xa0,
+	0x43, 0xbd, 0xc1, 0x5f, 0x9d, 0xb6, 0xaf, 0x2e, 0x06, 0xda, 0x08, 0x9f, 0xa9, 0xa4, 0x1d, 0xd0,
+	0x4e, 0xde, 0x87, 0xb6, 0x69, 0xa0, 0x19, 0x7e, 0x62, 0xda, 0xc6, 0x83, 0x8b, 0xa1, 0x34, 0xc9,
+	0x65, 0x09, 0x16, 0xff, 0x70, 0xa6, 0x94, 0x77, 0xf3, 0x89, 0x41, 0x56, 0x74, 0x7c, 0xd6, 0xb6,
+	0x43, 0x01, 0x29, 0xe0, 0x31, 0xba, 0x05, 0x5d, 0x90, 0x13, 0xa9, 0xe8, 0xa4, 0x41, 0x88, 0xef,
+	0xab, 0x22, 0xa9, 0x7c, 0xc3, 0x14, 0x3c, 0x3e, 0x8b, 0x5a, 0xe6, 0x90, 0x2c, 0x49, 0x97, 0x24,
+	0xdf, 0x3f, 0x43, 0x0e, 0xa2, 0xf9, 0xf5, 0x54, 0xc8, 0xab, 0x88, 0x66, 0x41, 0xea, 0xb6, 0x07,
+	0x66, 0x20, 0xe2, 0x65, 0xc4, 0x44, 0xc2, 0xb5, 0x8e, 0x19, 0x7e, 0x57, 0xa3, 0x14, 0xbe, 0x69,
+	0x4f, 0x21, 0xc3, 0x90, 0x0a, 0x1f, 0xff, 0xd9, 0x36, 0xf2, 0x36, 0x65, 0x55, 0x80, 0xfc, 0x41,
+	0xf8, 0x74, 0x03, 0xda, 0xaa, 0x49, 0xdf, 0x8e, 0x75, 0x1e, 0xf0, 0x8e, 0x81, 0x52, 0xfa, 0x3f,
+	0xb9, 0xb6, 0x74, 0xc1, 0xe3, 0x06, 0xfc, 0x62, 0xd9, 0x79, 0xaf, 0x60, 0xef, 0x94, 0x7b, 0xd7,
+	0x80, 0x52, 0x95, 0xfe, 0x02, 0xd4, 0x79, 0xc6, 0x3e, 0x95, 0x4a, 0x97, 0x37, 0xad, 0x4b, 0xf8,
+	0xa1, 0x66, 0xeb, 0x2d, 0x47, 0xde, 0xbc, 0x74, 0x7c, 0xf5, 0xba, 0xb5, 0x6b, 0x0f, 0xbc, 0x75,
+	0x78, 0xe2, 0x60, 0x67, 0x38, 0x5c, 0x5a, 0x1e, 0x78, 0xc3, 0x93, 0xab, 0xd7, 0x1c, 0x3f, 0x39,
+	0x38, 0x6d, 0x86, 0xc3, 0xe5, 0x95, 0x83, 0x2b, 0x37, 0x5c, 0x73, 0xec, 0xd8, 0x70, 0xf9, 0xe8,
+	0x41, 0xfb, 0xc7, 0xaa, 0x83, 0xf6, 0x8f, 0x55, 0xd7, 0xce, 0xda, 0xff, 0x5c, 0xfd, 0xdf, 0x01,
+	0x00, 0xd1, 0x5e, 0x9a, 0x03, 0xcf, 0x1a, 0x00, 0x00,
 }
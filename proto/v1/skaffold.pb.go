@@ -41,6 +41,7 @@ const BuilderType_BUILDPACKS = BuilderType(enums.BuilderType_BUILDPACKS)
 const BuilderType_CUSTOM = BuilderType(enums.BuilderType_CUSTOM)
 const BuilderType_KANIKO = BuilderType(enums.BuilderType_KANIKO)
 const BuilderType_DOCKER = BuilderType(enums.BuilderType_DOCKER)
+const BuilderType_KO = BuilderType(enums.BuilderType_KO)
 
 // BuildType from public import enums/enums.proto
 type BuildType = enums.BuildType
@@ -138,6 +139,9 @@ const StatusCode_STATUSCHECK_CONTAINER_CREATING = StatusCode(enums.StatusCode_ST
 const StatusCode_STATUSCHECK_RUN_CONTAINER_ERR = StatusCode(enums.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_TERMINATED = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
 const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING)
+const StatusCode_STATUSCHECK_JOB_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_JOB_FAILED)
+const StatusCode_STATUSCHECK_RESOURCE_NOT_READY = StatusCode(enums.StatusCode_STATUSCHECK_RESOURCE_NOT_READY)
+const StatusCode_STATUSCHECK_RESOURCE_FAILED = StatusCode(enums.StatusCode_STATUSCHECK_RESOURCE_FAILED)
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE = StatusCode(enums.StatusCode_STATUSCHECK_NODE_MEMORY_PRESSURE)
@@ -178,6 +182,7 @@ const StatusCode_BUILD_CANCELLED = StatusCode(enums.StatusCode_BUILD_CANCELLED)
 const StatusCode_DEPLOY_CANCELLED = StatusCode(enums.StatusCode_DEPLOY_CANCELLED)
 const StatusCode_BUILD_DOCKER_CANCELLED = StatusCode(enums.StatusCode_BUILD_DOCKER_CANCELLED)
 const StatusCode_BUILD_DOCKER_DEADLINE = StatusCode(enums.StatusCode_BUILD_DOCKER_DEADLINE)
+const StatusCode_BUILD_ARTIFACT_TIMEOUT = StatusCode(enums.StatusCode_BUILD_ARTIFACT_TIMEOUT)
 const StatusCode_INIT_CREATE_TAGGER_ERROR = StatusCode(enums.StatusCode_INIT_CREATE_TAGGER_ERROR)
 const StatusCode_INIT_MINIKUBE_PAUSED_ERROR = StatusCode(enums.StatusCode_INIT_MINIKUBE_PAUSED_ERROR)
 const StatusCode_INIT_MINIKUBE_NOT_RUNNING_ERROR = StatusCode(enums.StatusCode_INIT_MINIKUBE_NOT_RUNNING_ERROR)
@@ -209,6 +214,8 @@ const StatusCode_DEPLOY_SET_LABEL_ERR = StatusCode(enums.StatusCode_DEPLOY_SET_L
 const StatusCode_DEPLOY_MANIFEST_WRITE_ERR = StatusCode(enums.StatusCode_DEPLOY_MANIFEST_WRITE_ERR)
 const StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR = StatusCode(enums.StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR)
 const StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE = StatusCode(enums.StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE)
+const StatusCode_DEPLOY_IMAGE_SIGNATURE_MISSING = StatusCode(enums.StatusCode_DEPLOY_IMAGE_SIGNATURE_MISSING)
+const StatusCode_DEPLOY_IMAGE_SIGNATURE_INVALID = StatusCode(enums.StatusCode_DEPLOY_IMAGE_SIGNATURE_INVALID)
 const StatusCode_TEST_USER_CONFIG_ERR = StatusCode(enums.StatusCode_TEST_USER_CONFIG_ERR)
 const StatusCode_TEST_CST_USER_ERR = StatusCode(enums.StatusCode_TEST_CST_USER_ERR)
 const StatusCode_TEST_IMG_PULL_ERR = StatusCode(enums.StatusCode_TEST_IMG_PULL_ERR)
@@ -221,6 +228,10 @@ const StatusCode_TEST_CUSTOM_CMD_RUN_EXITED_ERR = StatusCode(enums.StatusCode_TE
 const StatusCode_TEST_CUSTOM_CMD_RUN_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_CMD_RUN_ERR)
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR)
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR = StatusCode(enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR)
+const StatusCode_TEST_JOB_CREATE_ERR = StatusCode(enums.StatusCode_TEST_JOB_CREATE_ERR)
+const StatusCode_TEST_JOB_FAILED_ERR = StatusCode(enums.StatusCode_TEST_JOB_FAILED_ERR)
+const StatusCode_TEST_JOB_TIMEDOUT_ERR = StatusCode(enums.StatusCode_TEST_JOB_TIMEDOUT_ERR)
+const StatusCode_TEST_JOB_IMAGE_PULL_ERR = StatusCode(enums.StatusCode_TEST_JOB_IMAGE_PULL_ERR)
 const StatusCode_CONFIG_FILE_PARSING_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PARSING_ERR)
 const StatusCode_CONFIG_FILE_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_NOT_FOUND_ERR)
 const StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR)
@@ -232,6 +243,8 @@ const StatusCode_CONFIG_APPLY_PROFILES_ERR = StatusCode(enums.StatusCode_CONFIG_
 const StatusCode_CONFIG_DEFAULT_VALUES_ERR = StatusCode(enums.StatusCode_CONFIG_DEFAULT_VALUES_ERR)
 const StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PATHS_SUBSTITUTION_ERR)
 const StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR = StatusCode(enums.StatusCode_CONFIG_MULTI_IMPORT_PROFILE_CONFLICT_ERR)
+const StatusCode_VERIFY_PORT_FORWARD_ERR = StatusCode(enums.StatusCode_VERIFY_PORT_FORWARD_ERR)
+const StatusCode_VERIFY_CHECK_FAILED_ERR = StatusCode(enums.StatusCode_VERIFY_CHECK_FAILED_ERR)
 
 // SuggestionCode from public import enums/enums.proto
 type SuggestionCode = enums.SuggestionCode
@@ -261,6 +274,8 @@ const SuggestionCode_UPGRADE_HELM = SuggestionCode(enums.SuggestionCode_UPGRADE_
 const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES)
 const SuggestionCode_UPGRADE_HELM32 = SuggestionCode(enums.SuggestionCode_UPGRADE_HELM32)
 const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE)
+const SuggestionCode_SIGN_IMAGE = SuggestionCode(enums.SuggestionCode_SIGN_IMAGE)
+const SuggestionCode_CHECK_VERIFICATION_KEY = SuggestionCode(enums.SuggestionCode_CHECK_VERIFICATION_KEY)
 const SuggestionCode_INSTALL_KUBECTL = SuggestionCode(enums.SuggestionCode_INSTALL_KUBECTL)
 const SuggestionCode_CHECK_CONTAINER_LOGS = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_LOGS)
 const SuggestionCode_CHECK_READINESS_PROBE = SuggestionCode(enums.SuggestionCode_CHECK_READINESS_PROBE)
@@ -289,6 +304,9 @@ const SuggestionCode_CHECK_CUSTOM_COMMAND = SuggestionCode(enums.SuggestionCode_
 const SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT = SuggestionCode(enums.SuggestionCode_FIX_CUSTOM_COMMAND_TIMEOUT)
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_CMD)
 const SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS = SuggestionCode(enums.SuggestionCode_CHECK_CUSTOM_COMMAND_DEPENDENCIES_PATHS)
+const SuggestionCode_CHECK_TEST_JOB_LOGS = SuggestionCode(enums.SuggestionCode_CHECK_TEST_JOB_LOGS)
+const SuggestionCode_FIX_TEST_JOB_TIMEOUT = SuggestionCode(enums.SuggestionCode_FIX_TEST_JOB_TIMEOUT)
+const SuggestionCode_CHECK_VERIFY_CHECK = SuggestionCode(enums.SuggestionCode_CHECK_VERIFY_CHECK)
 
 type StateResponse struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
@@ -1644,6 +1662,11 @@ func (m *BuildEvent) GetActionableErr() *ActionableErr {
 type TestEvent struct {
 	Status               string         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	ActionableErr        *ActionableErr `protobuf:"bytes,2,opt,name=actionableErr,proto3" json:"actionableErr,omitempty"`
+	Name                 string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string         `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Image                string         `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	DurationMillis       int64          `protobuf:"varint,6,opt,name=durationMillis,proto3" json:"durationMillis,omitempty"`
+	Output               string         `protobuf:"bytes,7,opt,name=output,proto3" json:"output,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -1688,6 +1711,41 @@ func (m *TestEvent) GetActionableErr() *ActionableErr {
 	return nil
 }
 
+func (m *TestEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TestEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TestEvent) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *TestEvent) GetDurationMillis() int64 {
+	if m != nil {
+		return m.DurationMillis
+	}
+	return 0
+}
+
+func (m *TestEvent) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
 // `DeployEvent` represents the status of a deployment, and is emitted by Skaffold
 // anytime a deployment starts or completes, successfully or not.
 type DeployEvent struct {
@@ -2605,137 +2663,140 @@ func init() {
 func init() { proto.RegisterFile("v1/skaffold.proto", fileDescriptor_9ef8072bea85606e) }
 
 var fileDescriptor_9ef8072bea85606e = []byte{
-	// 2066 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x48, 0x1a, 0x49, 0xf3, 0x24, 0xd9, 0x72, 0x27, 0xb6, 0xc5, 0x24, 0x24, 0x66, 0x2a,
-	0x1b, 0x42, 0x76, 0x57, 0x8a, 0x9d, 0x2d, 0x58, 0x4c, 0x02, 0x95, 0xd8, 0xde, 0x38, 0x4b, 0x48,
-	0x42, 0xcb, 0x50, 0x14, 0x05, 0x95, 0x1a, 0x4b, 0xed, 0xd9, 0x29, 0x4b, 0x33, 0x62, 0x66, 0xe4,
-	0x45, 0x17, 0x0a, 0xf8, 0x00, 0x54, 0x6d, 0xf1, 0x1d, 0xf8, 0x0e, 0x1c, 0x39, 0xec, 0x27, 0xd8,
-	0x13, 0x14, 0x47, 0x28, 0x8e, 0x5c, 0xf8, 0x00, 0x54, 0xff, 0x9b, 0xe9, 0x96, 0x66, 0x2c, 0x3b,
-	0x29, 0x8a, 0x4b, 0xa2, 0x7e, 0xfd, 0x7b, 0x7f, 0xfb, 0xf5, 0x7b, 0x6f, 0xda, 0xb0, 0x7e, 0xbe,
-	0xd3, 0x8b, 0xcf, 0xdc, 0xd3, 0xd3, 0x70, 0x34, 0xec, 0x4e, 0xa2, 0x30, 0x09, 0x91, 0xc9, 0xfe,
-	0xb3, 0x6f, 0x7a, 0x61, 0xe8, 0x8d, 0x48, 0xcf, 0x9d, 0xf8, 0x3d, 0x37, 0x08, 0xc2, 0xc4, 0x4d,
-	0xfc, 0x30, 0x88, 0x39, 0xc8, 0xbe, 0x2d, 0x76, 0xd9, 0xea, 0x64, 0x7a, 0xda, 0x4b, 0xfc, 0x31,
-	0x89, 0x13, 0x77, 0x3c, 0x11, 0x80, 0x1b, 0xf3, 0x00, 0x32, 0x9e, 0x24, 0x33, 0xb1, 0xb9, 0x4e,
-	0x82, 0xe9, 0x38, 0xee, 0xb1, 0x7f, 0x39, 0xc9, 0x79, 0x08, 0xad, 0x7e, 0xe2, 0x26, 0x04, 0x93,
-	0x78, 0x12, 0x06, 0x31, 0x41, 0x0e, 0x98, 0x31, 0x25, 0x74, 0x8c, 0x6d, 0xe3, 0x5e, 0x63, 0xb7,
-	0xc9, 0x71, 0x5d, 0x0e, 0xe2, 0x5b, 0xce, 0x4d, 0xa8, 0xa7, 0xf8, 0x36, 0x94, 0xc7, 0xb1, 0xc7,
-	0xd0, 0x16, 0xa6, 0x3f, 0x9d, 0xaf, 0x43, 0x0d, 0x93, 0x5f, 0x4d, 0x49, 0x9c, 0x20, 0x04, 0x95,
-	0xc0, 0x1d, 0x13, 0xb1, 0xcb, 0x7e, 0x3b, 0x5f, 0x56, 0xc0, 0x64, 0xd2, 0xd0, 0x0e, 0xc0, 0xc9,
-	0xd4, 0x1f, 0x0d, 0xfb, 0x8a, 0xbe, 0x75, 0xa1, 0xef, 0x69, 0xba, 0x81, 0x15, 0x10, 0xfa, 0x08,
-	0x1a, 0x43, 0x32, 0x19, 0x85, 0x33, 0xce, 0x53, 0x62, 0x3c, 0x48, 0xf0, 0x1c, 0x64, 0x3b, 0x58,
-	0x85, 0xa1, 0x23, 0x58, 0x3d, 0x0d, 0xa3, 0xcf, 0xdd, 0x68, 0x48, 0x86, 0xaf, 0xc3, 0x28, 0x89,
-	0x3b, 0x95, 0xed, 0xf2, 0xbd, 0xc6, 0xee, 0xb6, 0xea, 0x5c, 0xf7, 0x13, 0x0d, 0x72, 0x18, 0x24,
-	0xd1, 0x0c, 0xcf, 0xf1, 0xa1, 0x7d, 0x68, 0xd3, 0x10, 0x4c, 0xe3, 0xfd, 0xcf, 0xc8, 0xe0, 0x8c,
-	0x1b, 0x61, 0x32, 0x23, 0xb6, 0x14, 0x59, 0xea, 0x36, 0x5e, 0x60, 0x40, 0x7b, 0xd0, 0x3a, 0xf5,
-	0x47, 0xa4, 0x3f, 0x0b, 0x06, 0x5c, 0x42, 0x95, 0x49, 0xb8, 0x2e, 0x24, 0x7c, 0xa2, 0xee, 0x61,
-	0x1d, 0x8a, 0x5e, 0xc3, 0xb5, 0x21, 0x39, 0x99, 0x7a, 0x9e, 0x1f, 0x78, 0xfb, 0x61, 0x90, 0xb8,
-	0x7e, 0x40, 0xa2, 0xb8, 0x53, 0x63, 0xfe, 0xdc, 0x4a, 0x03, 0x31, 0x8f, 0x38, 0x3c, 0x27, 0x41,
-	0x82, 0xf3, 0x58, 0xd1, 0xfb, 0x50, 0x1f, 0x93, 0xc4, 0x1d, 0xba, 0x89, 0xdb, 0xa9, 0x33, 0x43,
-	0xd6, 0x84, 0x98, 0x1f, 0x09, 0x32, 0x4e, 0x01, 0xa8, 0x0b, 0x56, 0x42, 0xe2, 0x84, 0x9b, 0x6d,
-	0x31, 0x74, 0x5b, 0xa0, 0x8f, 0x25, 0x1d, 0x67, 0x10, 0xbb, 0x0f, 0xd7, 0x72, 0xc2, 0x4a, 0x93,
-	0xe6, 0x8c, 0xcc, 0xd8, 0x91, 0x9b, 0x98, 0xfe, 0x44, 0x77, 0xc1, 0x3c, 0x77, 0x47, 0x53, 0x79,
-	0xa4, 0x52, 0x28, 0xe5, 0xe1, 0xb6, 0xf3, 0xed, 0xbd, 0xd2, 0xc7, 0xc6, 0xa7, 0x95, 0x7a, 0xb9,
-	0x5d, 0x71, 0xfe, 0x50, 0x82, 0xba, 0xb4, 0x10, 0xdd, 0x07, 0x93, 0x65, 0x89, 0xc8, 0xa2, 0xeb,
-	0x6a, 0x16, 0xa5, 0x6e, 0x70, 0x08, 0xfa, 0x10, 0xaa, 0x3c, 0x39, 0x84, 0xae, 0x0d, 0x2d, 0x7d,
-	0x52, 0xb4, 0x00, 0xa1, 0x6f, 0x42, 0x85, 0xfa, 0xd3, 0x29, 0x33, 0xf0, 0x35, 0xc5, 0xdb, 0x14,
-	0xca, 0x00, 0xe8, 0x07, 0x00, 0xee, 0x70, 0xe8, 0xd3, 0xeb, 0xea, 0x8e, 0x3a, 0x03, 0x76, 0x22,
-	0xb7, 0xe7, 0x42, 0xd9, 0x7d, 0x92, 0x22, 0x78, 0x82, 0x29, 0x2c, 0xf6, 0x63, 0x58, 0x9b, 0xdb,
-	0x56, 0x03, 0x65, 0xf1, 0x40, 0x5d, 0x57, 0x03, 0x65, 0x29, 0x61, 0x71, 0x7e, 0x57, 0x86, 0x96,
-	0xe6, 0x30, 0xfa, 0x00, 0xd6, 0x83, 0xe9, 0xf8, 0x84, 0x44, 0xaf, 0x4e, 0x9f, 0x44, 0x89, 0x7f,
-	0xea, 0x0e, 0x92, 0x58, 0x04, 0x7d, 0x71, 0x03, 0x3d, 0x86, 0x3a, 0x0b, 0x10, 0xcd, 0xa7, 0x12,
-	0xb3, 0xfe, 0x1b, 0x79, 0x61, 0xec, 0x3e, 0x1f, 0xbb, 0x1e, 0x79, 0xca, 0x91, 0x38, 0x65, 0x41,
-	0xf7, 0xa1, 0x92, 0xcc, 0x26, 0x84, 0xc5, 0x69, 0x75, 0x77, 0x53, 0xb0, 0xf2, 0x5a, 0xc3, 0xd0,
-	0xc7, 0xb3, 0x09, 0xc1, 0x0c, 0x83, 0x0e, 0x72, 0x42, 0x75, 0x27, 0x57, 0xd9, 0x45, 0xf1, 0xc2,
-	0xd0, 0x54, 0x6d, 0x41, 0x1f, 0x08, 0x0b, 0x0c, 0x66, 0x41, 0x67, 0xd1, 0x02, 0x12, 0x29, 0x36,
-	0x5c, 0x07, 0x73, 0x10, 0x4e, 0x83, 0x84, 0x05, 0xd2, 0xc4, 0x7c, 0xf1, 0xae, 0x67, 0xf0, 0x85,
-	0x01, 0x4d, 0x35, 0x35, 0xd0, 0x47, 0x50, 0xa3, 0x6b, 0x1a, 0x53, 0x83, 0xb9, 0x69, 0xe7, 0x24,
-	0x50, 0x97, 0x43, 0xb0, 0x84, 0xda, 0x3f, 0x84, 0x2a, 0xff, 0x89, 0xde, 0xd7, 0x7c, 0xda, 0xd2,
-	0x7c, 0xe2, 0x90, 0x65, 0x2e, 0x39, 0x5f, 0x19, 0xb0, 0xaa, 0xe7, 0x36, 0x7a, 0x04, 0x16, 0xcf,
-	0xee, 0xcc, 0xae, 0x5b, 0xb9, 0xb7, 0x40, 0x2c, 0x49, 0x84, 0x33, 0x06, 0xb4, 0x0b, 0xb5, 0xc1,
-	0x68, 0x4a, 0x75, 0x33, 0x45, 0xf3, 0xa1, 0xde, 0xe7, 0x7b, 0xcc, 0x2e, 0x09, 0xb4, 0x5f, 0x41,
-	0x5d, 0x8a, 0x42, 0x1f, 0x6a, 0x3e, 0x7d, 0x4d, 0x63, 0x96, 0xa0, 0xa5, 0x5e, 0xfd, 0xd3, 0x00,
-	0xc8, 0x9a, 0x04, 0xfa, 0x3e, 0x58, 0xae, 0x92, 0xe2, 0x6a, 0x75, 0xcf, 0x50, 0xdd, 0x34, 0xd9,
-	0x79, 0x32, 0x65, 0x2c, 0x68, 0x1b, 0x1a, 0xee, 0x34, 0x09, 0x8f, 0x23, 0xdf, 0xf3, 0x84, 0x5f,
-	0x75, 0xac, 0x92, 0xd0, 0x77, 0x00, 0x44, 0x25, 0x0f, 0x87, 0x32, 0xcb, 0xf5, 0xf3, 0xe8, 0xa7,
-	0xdb, 0x58, 0x81, 0xda, 0x8f, 0x60, 0x55, 0xd7, 0x7b, 0xa5, 0x8c, 0xfa, 0x05, 0x58, 0x69, 0x65,
-	0x45, 0x9b, 0x50, 0xe5, 0x82, 0x05, 0xaf, 0x58, 0xcd, 0xd9, 0x56, 0xba, 0xb4, 0x6d, 0xce, 0x6f,
-	0x0d, 0x68, 0x28, 0x6d, 0xb3, 0x50, 0xc1, 0xff, 0x2e, 0x3c, 0xce, 0xbf, 0x0c, 0x68, 0xcf, 0x37,
-	0xcd, 0x42, 0x3b, 0x0e, 0xc0, 0x8a, 0x48, 0x1c, 0x4e, 0xa3, 0x01, 0x91, 0x45, 0xea, 0x6e, 0x41,
-	0xe3, 0xed, 0x62, 0x09, 0x14, 0x87, 0x9d, 0x32, 0xbe, 0xd3, 0x51, 0xea, 0x52, 0xaf, 0x74, 0x94,
-	0xcf, 0xa1, 0xa5, 0xf5, 0xf6, 0xb7, 0x8f, 0xb6, 0xf3, 0x37, 0x13, 0x4c, 0xd6, 0x17, 0xd1, 0x03,
-	0xb0, 0x68, 0x77, 0x66, 0x0b, 0xd1, 0xfd, 0xda, 0x4a, 0xd3, 0x61, 0xf4, 0xa3, 0x15, 0x9c, 0x81,
-	0xd0, 0x43, 0x31, 0x76, 0x71, 0x96, 0xd2, 0xe2, 0xd8, 0x25, 0x79, 0x14, 0x18, 0xfa, 0xb6, 0x1c,
-	0xbc, 0x38, 0x57, 0x39, 0x67, 0xf0, 0x92, 0x6c, 0x2a, 0x90, 0x9a, 0x37, 0x91, 0x3d, 0xbc, 0x53,
-	0xc9, 0xef, 0xed, 0xd4, 0xbc, 0x14, 0x84, 0x0e, 0xb5, 0x11, 0x8b, 0x33, 0x16, 0x8e, 0x58, 0x92,
-	0x7f, 0x81, 0x05, 0xfd, 0x12, 0x3a, 0xf2, 0xc0, 0xe7, 0xf1, 0x62, 0xde, 0x92, 0xbd, 0x19, 0x17,
-	0xc0, 0x8e, 0x56, 0x70, 0xa1, 0x08, 0xf4, 0x28, 0x9b, 0xe1, 0xb8, 0xcc, 0x5a, 0xee, 0x0c, 0x27,
-	0x05, 0xe9, 0x60, 0xf4, 0x73, 0xd8, 0x1a, 0xe6, 0xcf, 0x68, 0x62, 0x04, 0x5b, 0x32, 0xc9, 0x1d,
-	0xad, 0xe0, 0x22, 0x01, 0xe8, 0xbb, 0xd0, 0x1c, 0x92, 0xf3, 0x17, 0x61, 0x38, 0xe1, 0x02, 0x2d,
-	0x6d, 0x6e, 0x39, 0x50, 0xb6, 0x8e, 0x56, 0xb0, 0x06, 0xa5, 0xa1, 0x4f, 0x48, 0x34, 0xf6, 0x03,
-	0xf6, 0xcd, 0xc1, 0xd9, 0x41, 0x0b, 0xfd, 0xf1, 0xdc, 0x36, 0x0d, 0xfd, 0x3c, 0x0b, 0x3d, 0x73,
-	0x5a, 0xb2, 0x38, 0x7f, 0x63, 0x61, 0x48, 0x4c, 0xcf, 0x3c, 0x5d, 0x3c, 0x6d, 0x02, 0x10, 0xfa,
-	0xe3, 0x0d, 0x2d, 0xf8, 0x0e, 0x86, 0xf6, 0xbc, 0x9e, 0xc2, 0xab, 0x72, 0x17, 0xca, 0x24, 0x8a,
-	0x44, 0x16, 0xcb, 0xe8, 0x3f, 0x19, 0xb0, 0xfe, 0x7d, 0x32, 0x22, 0x87, 0x51, 0x84, 0x29, 0xc0,
-	0x19, 0x41, 0x53, 0x75, 0x1d, 0xdd, 0x04, 0xcb, 0x4f, 0x48, 0xc4, 0x34, 0x88, 0x91, 0x28, 0x23,
-	0x28, 0xda, 0x4a, 0x79, 0xda, 0xca, 0xcb, 0xb4, 0x7d, 0x61, 0x40, 0x4b, 0x23, 0xa3, 0x1d, 0xa8,
-	0x91, 0x28, 0x62, 0xf5, 0xc6, 0xb8, 0xb8, 0xde, 0x48, 0x1c, 0xea, 0x40, 0x6d, 0x4c, 0xe2, 0xd8,
-	0xf5, 0x64, 0x29, 0x91, 0x4b, 0xf4, 0x10, 0x1a, 0xf1, 0xd4, 0xf3, 0x48, 0xcc, 0x3e, 0x0d, 0x3b,
-	0x65, 0x56, 0x07, 0xe5, 0x15, 0xee, 0xa7, 0x3b, 0x58, 0x45, 0x39, 0x2f, 0xc1, 0x4a, 0x0b, 0x02,
-	0x2d, 0x52, 0x84, 0xd6, 0x2f, 0x11, 0x4d, 0xbe, 0xd0, 0x3e, 0x05, 0x4a, 0x4b, 0x3e, 0x05, 0x9c,
-	0xbf, 0xc8, 0x06, 0xcc, 0x25, 0xda, 0x50, 0x97, 0xdd, 0x54, 0x08, 0x4d, 0xd7, 0x85, 0xe1, 0x6c,
-	0x67, 0xe1, 0xb4, 0x58, 0xe0, 0xd4, 0x30, 0x55, 0x2e, 0x19, 0xa6, 0x3d, 0x68, 0xb9, 0x6a, 0xa8,
-	0x45, 0xb1, 0xc8, 0x3f, 0x1d, 0x1d, 0xea, 0xbc, 0x51, 0x32, 0xb5, 0x30, 0xc5, 0x16, 0x14, 0x94,
-	0x2e, 0xaf, 0xe0, 0x4f, 0x69, 0x7f, 0xbd, 0x58, 0x47, 0x3b, 0x4b, 0xe3, 0xc5, 0x48, 0x94, 0xdf,
-	0x36, 0x12, 0x95, 0xcb, 0x1b, 0xfa, 0xa5, 0xde, 0x85, 0x2f, 0xb6, 0xb6, 0x38, 0x33, 0xff, 0xef,
-	0x27, 0xfa, 0x6f, 0x03, 0x3a, 0x45, 0x05, 0x9d, 0xe6, 0xa8, 0x2c, 0xe8, 0x32, 0x47, 0xe5, 0xba,
-	0x30, 0x47, 0x15, 0x5f, 0xcb, 0xb9, 0xbe, 0x56, 0x32, 0x5f, 0xf5, 0xb9, 0xc2, 0xbc, 0xf4, 0x5c,
-	0xb1, 0xe8, 0x71, 0xf5, 0xf2, 0x1e, 0xff, 0xb5, 0x04, 0x56, 0xda, 0x4a, 0x69, 0x5d, 0x1b, 0x85,
-	0x03, 0x77, 0x44, 0x29, 0xb2, 0xae, 0xa5, 0x04, 0x74, 0x0b, 0x20, 0x22, 0xe3, 0x30, 0x21, 0x6c,
-	0x9b, 0xcf, 0xd3, 0x0a, 0x85, 0x3a, 0x3b, 0x09, 0x87, 0x2f, 0xdd, 0x71, 0xea, 0xac, 0x58, 0xa2,
-	0x3b, 0xd0, 0x1a, 0xc8, 0x3e, 0xc3, 0xf6, 0xb9, 0xdb, 0x3a, 0x91, 0x6a, 0x0f, 0xdc, 0x31, 0x89,
-	0x27, 0xee, 0x80, 0xfb, 0x6f, 0xe1, 0x8c, 0x40, 0xc3, 0x4f, 0xdb, 0x3c, 0x63, 0xaf, 0xf2, 0xf0,
-	0xcb, 0x35, 0x72, 0xa0, 0x29, 0x8f, 0x82, 0x8e, 0xfe, 0xac, 0x9d, 0x5a, 0x58, 0xa3, 0xa9, 0x18,
-	0x26, 0xa3, 0xae, 0x63, 0x98, 0x9c, 0x0e, 0xd4, 0xdc, 0xe1, 0x30, 0x22, 0x71, 0xcc, 0x1a, 0x9f,
-	0x85, 0xe5, 0x12, 0xed, 0x02, 0x24, 0x6e, 0xe4, 0x91, 0x84, 0xf9, 0x0e, 0xda, 0x00, 0xf3, 0x3c,
-	0x48, 0x5e, 0x45, 0xfd, 0x24, 0xf2, 0x03, 0x0f, 0x2b, 0x28, 0xe7, 0xef, 0x46, 0x36, 0xb2, 0xa5,
-	0xf1, 0xa5, 0xad, 0x7c, 0x9f, 0x7d, 0x90, 0x88, 0xf8, 0xa6, 0x04, 0x5a, 0x56, 0xfd, 0x71, 0x76,
-	0x2d, 0xf8, 0x42, 0x49, 0xad, 0x72, 0xde, 0xa5, 0xaf, 0xe4, 0x5e, 0x16, 0xf3, 0x6d, 0x2f, 0xcb,
-	0x15, 0x52, 0xe7, 0x3f, 0x25, 0xd8, 0x2a, 0x98, 0x30, 0x2e, 0xba, 0xfb, 0x32, 0x45, 0x4a, 0x4b,
-	0x52, 0xa4, 0xbc, 0x34, 0x45, 0x2a, 0x39, 0x29, 0x92, 0x76, 0x11, 0x73, 0xae, 0x8b, 0x74, 0xa0,
-	0x16, 0x4d, 0x83, 0xc4, 0x4f, 0xb3, 0x47, 0x2e, 0x69, 0x5a, 0x7f, 0x1e, 0x46, 0x67, 0x7e, 0xe0,
-	0x1d, 0xf8, 0x91, 0x48, 0x1d, 0x85, 0x82, 0x5e, 0x02, 0xb0, 0x69, 0x89, 0xbf, 0xfd, 0xd5, 0x59,
-	0xbb, 0xec, 0x5e, 0x3c, 0x61, 0x71, 0xba, 0xf2, 0x12, 0xa8, 0x48, 0xb0, 0x1f, 0xc3, 0xda, 0xdc,
-	0xf6, 0xb2, 0xef, 0x80, 0x96, 0xfa, 0x1d, 0xf0, 0x1b, 0xa8, 0xbf, 0x08, 0x3d, 0xce, 0xf7, 0x31,
-	0x58, 0xe9, 0x13, 0xae, 0x18, 0xdf, 0xed, 0x2e, 0x7f, 0xc3, 0xed, 0xca, 0x37, 0xdc, 0xee, 0xb1,
-	0x44, 0xe0, 0x0c, 0x8c, 0x1c, 0x30, 0x89, 0x32, 0xc1, 0xcb, 0x87, 0x5a, 0xf1, 0x5a, 0x46, 0xf4,
-	0x36, 0x5f, 0x56, 0xda, 0xbc, 0xb3, 0x07, 0xeb, 0x3f, 0x89, 0x49, 0xf4, 0x3c, 0x48, 0x28, 0x54,
-	0x3c, 0xd5, 0xbe, 0x07, 0x55, 0x9f, 0x11, 0x84, 0x15, 0xad, 0xec, 0x6a, 0x50, 0x94, 0xd8, 0x74,
-	0xbe, 0x07, 0xab, 0xe2, 0x1b, 0x44, 0x32, 0x7e, 0x4b, 0x7f, 0x30, 0x4e, 0x1f, 0xc8, 0x38, 0x4a,
-	0x7b, 0x37, 0xde, 0x81, 0xa6, 0x4a, 0x46, 0x36, 0xd4, 0x08, 0x4b, 0x46, 0xfe, 0x6e, 0x57, 0x3f,
-	0x5a, 0xc1, 0x92, 0xf0, 0xd4, 0x84, 0xf2, 0xb9, 0x3b, 0x72, 0x3e, 0x85, 0x2a, 0xb7, 0x80, 0xfa,
-	0x92, 0x3d, 0xf1, 0xd5, 0xe5, 0x63, 0x1e, 0x82, 0x4a, 0x3c, 0x0b, 0x06, 0xe2, 0x1b, 0x89, 0xfd,
-	0xa6, 0xa9, 0x2b, 0x1e, 0xf8, 0xca, 0x8c, 0x2a, 0x56, 0x8e, 0x0f, 0x90, 0x0d, 0x47, 0x68, 0x1f,
-	0x56, 0xb3, 0xf1, 0x48, 0x19, 0xcc, 0x6e, 0xe8, 0x57, 0x4e, 0x83, 0xe0, 0x39, 0x16, 0xaa, 0x8a,
-	0x5f, 0x29, 0xd9, 0x35, 0xf8, 0xca, 0xf9, 0x31, 0x34, 0x94, 0x9a, 0x42, 0xad, 0x4c, 0x5f, 0x3c,
-	0x4c, 0xf1, 0xac, 0xb1, 0xc9, 0x02, 0xfe, 0x53, 0x77, 0x24, 0xea, 0xb0, 0x58, 0xf1, 0x8b, 0x17,
-	0x51, 0x7a, 0x5a, 0x2d, 0xe8, 0x6a, 0xf7, 0xcf, 0x26, 0xac, 0xf5, 0xc5, 0x9f, 0x0c, 0xfa, 0x24,
-	0x3a, 0xf7, 0x07, 0x04, 0xed, 0x43, 0xfd, 0x19, 0x91, 0x6f, 0x03, 0x0b, 0x69, 0x73, 0x38, 0x9e,
-	0x24, 0x33, 0x5b, 0x7b, 0xc1, 0x77, 0xd6, 0x7f, 0xff, 0xd5, 0x3f, 0xfe, 0x58, 0x6a, 0x20, 0xab,
-	0x77, 0xbe, 0xd3, 0x63, 0xa7, 0x82, 0x9e, 0x41, 0x9d, 0x25, 0xcd, 0x8b, 0xd0, 0x43, 0x72, 0xde,
-	0x93, 0xf9, 0x69, 0xcf, 0x13, 0x9c, 0x0d, 0x26, 0x60, 0x0d, 0xb5, 0xa8, 0x00, 0x3e, 0xb4, 0x8f,
-	0x42, 0xef, 0x9e, 0xf1, 0xc0, 0x40, 0xcf, 0xa0, 0xca, 0x04, 0xc5, 0x85, 0xb6, 0x2c, 0x48, 0x43,
-	0x4c, 0x5a, 0x13, 0x41, 0x2a, 0x2d, 0x7e, 0x60, 0xa0, 0x9f, 0x41, 0xed, 0xf0, 0xd7, 0x64, 0x30,
-	0x4d, 0x08, 0x92, 0x4f, 0x4b, 0x0b, 0x09, 0x6b, 0x17, 0xe8, 0x70, 0x6e, 0x30, 0x91, 0x1b, 0x4e,
-	0x83, 0x89, 0xe4, 0x62, 0xf6, 0x44, 0xfa, 0x22, 0x17, 0xac, 0x27, 0xd3, 0x24, 0x64, 0x73, 0x2b,
-	0xda, 0xd0, 0x53, 0x75, 0x99, 0xe0, 0xf7, 0x98, 0xe0, 0xdb, 0xf6, 0x26, 0x15, 0xcc, 0xb2, 0xaf,
-	0x47, 0x3f, 0xc9, 0xdf, 0x48, 0x1d, 0x3c, 0xc9, 0xd1, 0x1b, 0xa8, 0x53, 0x15, 0xb4, 0x65, 0x5c,
-	0x55, 0xc3, 0x1d, 0xa6, 0xe1, 0x96, 0xbd, 0xc1, 0x0e, 0x67, 0x16, 0x0c, 0x72, 0x15, 0x0c, 0x00,
-	0xa8, 0x02, 0x3e, 0x56, 0x5e, 0x55, 0xc5, 0x5d, 0xa6, 0x62, 0xdb, 0xde, 0xa2, 0x2a, 0xf8, 0xbd,
-	0xc8, 0x55, 0xf2, 0x02, 0xaa, 0x47, 0x6e, 0x30, 0x1c, 0x11, 0xa4, 0x15, 0x96, 0x42, 0xb9, 0x37,
-	0x99, 0xdc, 0x4d, 0x67, 0x3d, 0x3b, 0xc8, 0xde, 0x67, 0x4c, 0xc0, 0x9e, 0x71, 0xff, 0x75, 0xf9,
-	0xa4, 0xca, 0xf0, 0x0f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x04, 0x2e, 0xed, 0x6c, 0xf9, 0x1a,
-	0x00, 0x00,
+	// 2113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x72, 0xdc, 0x48,
+	0x15, 0x8e, 0x46, 0xf3, 0xa7, 0x33, 0x1e, 0xc7, 0xee, 0xc4, 0xb1, 0x98, 0x84, 0xc4, 0xa8, 0xb2,
+	0x26, 0x64, 0x77, 0xc7, 0x89, 0xb3, 0x05, 0x8b, 0xc9, 0x42, 0x25, 0xb6, 0x37, 0xce, 0x92, 0x3f,
+	0xda, 0x86, 0xa2, 0x28, 0xa8, 0x94, 0x3c, 0xd3, 0xd6, 0xaa, 0xa2, 0x91, 0x06, 0xa9, 0xe5, 0x65,
+	0x6e, 0x28, 0xe0, 0x01, 0xa8, 0xda, 0xe2, 0x1d, 0x78, 0x07, 0x2e, 0xb9, 0xd8, 0x27, 0xd8, 0x2b,
+	0x28, 0xb8, 0x83, 0xe2, 0x92, 0x1b, 0x1e, 0x80, 0xea, 0x3f, 0xa9, 0x7b, 0x46, 0xe3, 0xb1, 0xb3,
+	0x45, 0x71, 0x63, 0xab, 0x4f, 0x7f, 0xe7, 0xa7, 0x4f, 0x9f, 0x3e, 0xe7, 0x74, 0x0f, 0xac, 0x9e,
+	0xde, 0xdf, 0xca, 0xde, 0xf8, 0x27, 0x27, 0x49, 0x34, 0xec, 0x8f, 0xd3, 0x84, 0x26, 0xa8, 0xc1,
+	0xff, 0xf5, 0x6e, 0x04, 0x49, 0x12, 0x44, 0x64, 0xcb, 0x1f, 0x87, 0x5b, 0x7e, 0x1c, 0x27, 0xd4,
+	0xa7, 0x61, 0x12, 0x67, 0x02, 0xd4, 0xbb, 0x25, 0x67, 0xf9, 0xe8, 0x38, 0x3f, 0xd9, 0xa2, 0xe1,
+	0x88, 0x64, 0xd4, 0x1f, 0x8d, 0x25, 0xe0, 0xfa, 0x34, 0x80, 0x8c, 0xc6, 0x74, 0x22, 0x27, 0x57,
+	0x49, 0x9c, 0x8f, 0xb2, 0x2d, 0xfe, 0x57, 0x90, 0xbc, 0x07, 0xd0, 0x3d, 0xa4, 0x3e, 0x25, 0x98,
+	0x64, 0xe3, 0x24, 0xce, 0x08, 0xf2, 0xa0, 0x91, 0x31, 0x82, 0x6b, 0x6d, 0x58, 0x77, 0x3a, 0xdb,
+	0x4b, 0x02, 0xd7, 0x17, 0x20, 0x31, 0xe5, 0xdd, 0x80, 0x76, 0x81, 0x5f, 0x01, 0x7b, 0x94, 0x05,
+	0x1c, 0xed, 0x60, 0xf6, 0xe9, 0x7d, 0x1d, 0x5a, 0x98, 0xfc, 0x32, 0x27, 0x19, 0x45, 0x08, 0xea,
+	0xb1, 0x3f, 0x22, 0x72, 0x96, 0x7f, 0x7b, 0x5f, 0xd4, 0xa1, 0xc1, 0xa5, 0xa1, 0xfb, 0x00, 0xc7,
+	0x79, 0x18, 0x0d, 0x0f, 0x35, 0x7d, 0xab, 0x52, 0xdf, 0xe3, 0x62, 0x02, 0x6b, 0x20, 0xf4, 0x01,
+	0x74, 0x86, 0x64, 0x1c, 0x25, 0x13, 0xc1, 0x53, 0xe3, 0x3c, 0x48, 0xf2, 0xec, 0x95, 0x33, 0x58,
+	0x87, 0xa1, 0x03, 0x58, 0x3e, 0x49, 0xd2, 0xcf, 0xfc, 0x74, 0x48, 0x86, 0xaf, 0x92, 0x94, 0x66,
+	0x6e, 0x7d, 0xc3, 0xbe, 0xd3, 0xd9, 0xde, 0xd0, 0x17, 0xd7, 0xff, 0xd8, 0x80, 0xec, 0xc7, 0x34,
+	0x9d, 0xe0, 0x29, 0x3e, 0xb4, 0x0b, 0x2b, 0xcc, 0x05, 0x79, 0xb6, 0xfb, 0x29, 0x19, 0xbc, 0x11,
+	0x46, 0x34, 0xb8, 0x11, 0xeb, 0x9a, 0x2c, 0x7d, 0x1a, 0xcf, 0x30, 0xa0, 0x1d, 0xe8, 0x9e, 0x84,
+	0x11, 0x39, 0x9c, 0xc4, 0x03, 0x21, 0xa1, 0xc9, 0x25, 0x5c, 0x95, 0x12, 0x3e, 0xd6, 0xe7, 0xb0,
+	0x09, 0x45, 0xaf, 0xe0, 0xca, 0x90, 0x1c, 0xe7, 0x41, 0x10, 0xc6, 0xc1, 0x6e, 0x12, 0x53, 0x3f,
+	0x8c, 0x49, 0x9a, 0xb9, 0x2d, 0xbe, 0x9e, 0x9b, 0x85, 0x23, 0xa6, 0x11, 0xfb, 0xa7, 0x24, 0xa6,
+	0xb8, 0x8a, 0x15, 0xbd, 0x0b, 0xed, 0x11, 0xa1, 0xfe, 0xd0, 0xa7, 0xbe, 0xdb, 0xe6, 0x86, 0x5c,
+	0x96, 0x62, 0x9e, 0x4b, 0x32, 0x2e, 0x00, 0xa8, 0x0f, 0x0e, 0x25, 0x19, 0x15, 0x66, 0x3b, 0x1c,
+	0xbd, 0x22, 0xd1, 0x47, 0x8a, 0x8e, 0x4b, 0x48, 0xef, 0x10, 0xae, 0x54, 0xb8, 0x95, 0x05, 0xcd,
+	0x1b, 0x32, 0xe1, 0x5b, 0xde, 0xc0, 0xec, 0x13, 0x6d, 0x42, 0xe3, 0xd4, 0x8f, 0x72, 0xb5, 0xa5,
+	0x4a, 0x28, 0xe3, 0x11, 0xb6, 0x8b, 0xe9, 0x9d, 0xda, 0x87, 0xd6, 0x27, 0xf5, 0xb6, 0xbd, 0x52,
+	0xf7, 0x7e, 0x5f, 0x83, 0xb6, 0xb2, 0x10, 0xdd, 0x85, 0x06, 0x8f, 0x12, 0xd7, 0x32, 0x5c, 0xc9,
+	0xa3, 0xa8, 0x58, 0x86, 0x80, 0xa0, 0xf7, 0xa1, 0x29, 0x82, 0x43, 0xea, 0x5a, 0x33, 0xc2, 0xa7,
+	0x40, 0x4b, 0x10, 0xfa, 0x26, 0xd4, 0xd9, 0x7a, 0x5c, 0x9b, 0x83, 0xaf, 0x68, 0xab, 0x2d, 0xa0,
+	0x1c, 0x80, 0x7e, 0x00, 0xe0, 0x0f, 0x87, 0x21, 0x3b, 0xae, 0x7e, 0xe4, 0x0e, 0xf8, 0x8e, 0xdc,
+	0x9a, 0x72, 0x65, 0xff, 0x51, 0x81, 0x10, 0x01, 0xa6, 0xb1, 0xf4, 0x3e, 0x82, 0xcb, 0x53, 0xd3,
+	0xba, 0xa3, 0x1c, 0xe1, 0xa8, 0xab, 0xba, 0xa3, 0x1c, 0xcd, 0x2d, 0xde, 0x6f, 0x6d, 0xe8, 0x1a,
+	0x0b, 0x46, 0xef, 0xc1, 0x6a, 0x9c, 0x8f, 0x8e, 0x49, 0xfa, 0xf2, 0xe4, 0x51, 0x4a, 0xc3, 0x13,
+	0x7f, 0x40, 0x33, 0xe9, 0xf4, 0xd9, 0x09, 0xf4, 0x11, 0xb4, 0xb9, 0x83, 0x58, 0x3c, 0xd5, 0xb8,
+	0xf5, 0xdf, 0xa8, 0x72, 0x63, 0xff, 0xe9, 0xc8, 0x0f, 0xc8, 0x63, 0x81, 0xc4, 0x05, 0x0b, 0xba,
+	0x0b, 0x75, 0x3a, 0x19, 0x13, 0xee, 0xa7, 0xe5, 0xed, 0x6b, 0x92, 0x55, 0xe4, 0x1a, 0x8e, 0x3e,
+	0x9a, 0x8c, 0x09, 0xe6, 0x18, 0xb4, 0x57, 0xe1, 0xaa, 0xdb, 0x95, 0xca, 0xce, 0xf2, 0x17, 0x86,
+	0x25, 0xdd, 0x16, 0xf4, 0x9e, 0xb4, 0xc0, 0xe2, 0x16, 0xb8, 0xb3, 0x16, 0x90, 0x54, 0xb3, 0xe1,
+	0x2a, 0x34, 0x06, 0x49, 0x1e, 0x53, 0xee, 0xc8, 0x06, 0x16, 0x83, 0xaf, 0xba, 0x07, 0x9f, 0x5b,
+	0xb0, 0xa4, 0x87, 0x06, 0xfa, 0x00, 0x5a, 0x6c, 0xcc, 0x7c, 0x6a, 0xf1, 0x65, 0xf6, 0x2a, 0x02,
+	0xa8, 0x2f, 0x20, 0x58, 0x41, 0x7b, 0x3f, 0x84, 0xa6, 0xf8, 0x44, 0xef, 0x1a, 0x6b, 0x5a, 0x37,
+	0xd6, 0x24, 0x20, 0x8b, 0x96, 0xe4, 0x7d, 0x69, 0xc1, 0xb2, 0x19, 0xdb, 0xe8, 0x21, 0x38, 0x22,
+	0xba, 0x4b, 0xbb, 0x6e, 0x56, 0x9e, 0x02, 0x39, 0x24, 0x29, 0x2e, 0x19, 0xd0, 0x36, 0xb4, 0x06,
+	0x51, 0xce, 0x74, 0xbb, 0xb5, 0x0a, 0x57, 0xef, 0x46, 0x79, 0x61, 0x97, 0x02, 0xf6, 0x5e, 0x42,
+	0x5b, 0x89, 0x42, 0xef, 0x1b, 0x6b, 0xfa, 0x9a, 0xc1, 0xac, 0x40, 0x0b, 0x57, 0xf5, 0x4f, 0x0b,
+	0xa0, 0x2c, 0x12, 0xe8, 0xfb, 0xe0, 0xf8, 0x5a, 0x88, 0xeb, 0xd9, 0xbd, 0x44, 0xf5, 0x8b, 0x60,
+	0x17, 0xc1, 0x54, 0xb2, 0xa0, 0x0d, 0xe8, 0xf8, 0x39, 0x4d, 0x8e, 0xd2, 0x30, 0x08, 0xe4, 0xba,
+	0xda, 0x58, 0x27, 0xa1, 0xef, 0x00, 0xc8, 0x4c, 0x9e, 0x0c, 0x55, 0x94, 0x9b, 0xfb, 0x71, 0x58,
+	0x4c, 0x63, 0x0d, 0xda, 0x7b, 0x08, 0xcb, 0xa6, 0xde, 0x0b, 0x45, 0xd4, 0xcf, 0xc1, 0x29, 0x32,
+	0x2b, 0xba, 0x06, 0x4d, 0x21, 0x58, 0xf2, 0xca, 0xd1, 0x94, 0x6d, 0xb5, 0x73, 0xdb, 0xe6, 0xfd,
+	0xc6, 0x82, 0x8e, 0x56, 0x36, 0xe7, 0x2a, 0xf8, 0xdf, 0xb9, 0xc7, 0xfb, 0x97, 0x05, 0x2b, 0xd3,
+	0x45, 0x73, 0xae, 0x1d, 0x7b, 0xe0, 0xa4, 0x24, 0x4b, 0xf2, 0x74, 0x40, 0x54, 0x92, 0xda, 0x9c,
+	0x53, 0x78, 0xfb, 0x58, 0x01, 0xe5, 0x66, 0x17, 0x8c, 0x5f, 0x69, 0x2b, 0x4d, 0xa9, 0x17, 0xda,
+	0xca, 0xa7, 0xd0, 0x35, 0x6a, 0xfb, 0xdb, 0x7b, 0xdb, 0xfb, 0x6b, 0x03, 0x1a, 0xbc, 0x2e, 0xa2,
+	0x7b, 0xe0, 0xb0, 0xea, 0xcc, 0x07, 0xae, 0x65, 0x14, 0xcf, 0xe7, 0x8a, 0x7e, 0x70, 0x09, 0x97,
+	0x20, 0xf4, 0x40, 0xb6, 0x5d, 0x82, 0xa5, 0x36, 0xdb, 0x76, 0x29, 0x1e, 0x0d, 0x86, 0xbe, 0xad,
+	0x1a, 0x2f, 0xc1, 0x65, 0x57, 0x34, 0x5e, 0x8a, 0x4d, 0x07, 0x32, 0xf3, 0xc6, 0xaa, 0x86, 0xbb,
+	0xf5, 0xea, 0xda, 0xce, 0xcc, 0x2b, 0x40, 0x68, 0xdf, 0x68, 0xb1, 0x04, 0xe3, 0xdc, 0x16, 0x4b,
+	0xf1, 0xcf, 0xb0, 0xa0, 0x5f, 0x80, 0xab, 0x36, 0x7c, 0x1a, 0x2f, 0xfb, 0x2d, 0x55, 0x9b, 0xf1,
+	0x1c, 0xd8, 0xc1, 0x25, 0x3c, 0x57, 0x04, 0x7a, 0x58, 0xf6, 0x70, 0x42, 0x66, 0xab, 0xb2, 0x87,
+	0x53, 0x82, 0x4c, 0x30, 0xfa, 0x19, 0xac, 0x0f, 0xab, 0x7b, 0x34, 0xd9, 0x82, 0x2d, 0xe8, 0xe4,
+	0x0e, 0x2e, 0xe1, 0x79, 0x02, 0xd0, 0x77, 0x61, 0x69, 0x48, 0x4e, 0x9f, 0x25, 0xc9, 0x58, 0x08,
+	0x74, 0x8c, 0xbe, 0x65, 0x4f, 0x9b, 0x3a, 0xb8, 0x84, 0x0d, 0x28, 0x73, 0x3d, 0x25, 0xe9, 0x28,
+	0x8c, 0xf9, 0x9d, 0x43, 0xb0, 0x83, 0xe1, 0xfa, 0xa3, 0xa9, 0x69, 0xe6, 0xfa, 0x69, 0x16, 0x74,
+	0x4f, 0xa4, 0x2c, 0xc1, 0xdf, 0x99, 0x69, 0x12, 0x8b, 0x3d, 0x2f, 0x06, 0x8f, 0x97, 0x00, 0x08,
+	0xfb, 0x78, 0xcd, 0x12, 0xbe, 0x87, 0x61, 0x65, 0x5a, 0xcf, 0xdc, 0xa3, 0xb2, 0x09, 0x36, 0x49,
+	0x53, 0xb7, 0x66, 0x78, 0xff, 0xd1, 0x80, 0x31, 0xfa, 0xc7, 0x11, 0xd9, 0x4f, 0x53, 0xcc, 0x00,
+	0x5e, 0x04, 0x4b, 0xfa, 0xd2, 0xd1, 0x0d, 0x70, 0x42, 0x4a, 0x52, 0xae, 0x41, 0xb6, 0x44, 0x25,
+	0x41, 0xd3, 0x56, 0xab, 0xd2, 0x66, 0x2f, 0xd2, 0xf6, 0xb9, 0x05, 0x5d, 0x83, 0x8c, 0xee, 0x43,
+	0x8b, 0xa4, 0x29, 0xcf, 0x37, 0xd6, 0xd9, 0xf9, 0x46, 0xe1, 0x90, 0x0b, 0xad, 0x11, 0xc9, 0x32,
+	0x3f, 0x50, 0xa9, 0x44, 0x0d, 0xd1, 0x03, 0xe8, 0x64, 0x79, 0x10, 0x90, 0x8c, 0x69, 0xc8, 0x5c,
+	0x7b, 0xc3, 0xd6, 0x8e, 0xf0, 0x61, 0x31, 0x83, 0x75, 0x94, 0xf7, 0x02, 0x9c, 0x22, 0x21, 0xb0,
+	0x24, 0x45, 0x58, 0xfe, 0x92, 0xde, 0x14, 0x03, 0xe3, 0x2a, 0x50, 0x5b, 0x70, 0x15, 0xf0, 0xfe,
+	0xac, 0x0a, 0xb0, 0x90, 0xd8, 0x83, 0xb6, 0xaa, 0xa6, 0x52, 0x68, 0x31, 0x9e, 0xeb, 0xce, 0x95,
+	0xd2, 0x9d, 0x0e, 0x77, 0x9c, 0xee, 0xa6, 0xfa, 0x39, 0xdd, 0xb4, 0x03, 0x5d, 0x5f, 0x77, 0xb5,
+	0xdb, 0x38, 0x63, 0x77, 0x4c, 0xa8, 0xf7, 0x77, 0x4b, 0x0b, 0xd5, 0xb9, 0x31, 0x36, 0xa3, 0xa1,
+	0x76, 0x6e, 0x0d, 0xc5, 0x0d, 0xd8, 0x2e, 0x6f, 0xc0, 0x8c, 0xc6, 0xfb, 0x9f, 0xba, 0xa0, 0xa9,
+	0x26, 0x27, 0x64, 0xbd, 0x2c, 0xb7, 0xde, 0xc1, 0x62, 0x80, 0x36, 0x61, 0x79, 0x98, 0x8b, 0x98,
+	0x7c, 0x1e, 0x46, 0x51, 0x98, 0xf1, 0xd4, 0x65, 0xe3, 0x29, 0x2a, 0xb3, 0x3c, 0xc9, 0xe9, 0x38,
+	0x17, 0x69, 0xc8, 0xc1, 0x72, 0xe4, 0xfd, 0xb1, 0x28, 0xef, 0x67, 0xaf, 0x70, 0xa5, 0x3c, 0x45,
+	0xb3, 0x1b, 0x61, 0xbf, 0xed, 0x46, 0xd4, 0xcf, 0xbf, 0x11, 0x5f, 0x98, 0x4d, 0xc0, 0xd9, 0xd6,
+	0xce, 0x3f, 0x18, 0xff, 0xf7, 0x80, 0xfa, 0xb7, 0x05, 0xee, 0xbc, 0x7a, 0xc2, 0x8e, 0x88, 0xaa,
+	0x27, 0xea, 0x88, 0xa8, 0xf1, 0xdc, 0x23, 0xa2, 0xad, 0xd5, 0xae, 0x5c, 0x6b, 0xbd, 0x5c, 0xab,
+	0xd9, 0xd6, 0x34, 0xce, 0xdd, 0xd6, 0xcc, 0xae, 0xb8, 0x79, 0xfe, 0x15, 0xff, 0xa5, 0x06, 0x4e,
+	0x51, 0xc9, 0x59, 0x5a, 0x8d, 0x92, 0x81, 0x1f, 0x31, 0x8a, 0x4a, 0xab, 0x05, 0x01, 0xdd, 0x04,
+	0x48, 0xc9, 0x28, 0xa1, 0x84, 0x4f, 0x8b, 0x76, 0x5e, 0xa3, 0xb0, 0xc5, 0x8e, 0x93, 0xe1, 0x8b,
+	0xf2, 0xbc, 0xa8, 0x21, 0xba, 0x0d, 0xdd, 0x81, 0x2a, 0x73, 0x7c, 0x5e, 0x2c, 0xdb, 0x24, 0x32,
+	0xed, 0xec, 0x80, 0x65, 0x63, 0x7f, 0xa0, 0x0e, 0x52, 0x49, 0x60, 0xee, 0x1f, 0x27, 0x29, 0xe5,
+	0xec, 0x4d, 0xe1, 0x7e, 0x35, 0x46, 0x1e, 0x2c, 0xa9, 0xad, 0x60, 0x37, 0x0f, 0x79, 0x8c, 0x0c,
+	0x9a, 0x8e, 0xe1, 0x32, 0xda, 0x26, 0x86, 0xcb, 0x71, 0xa1, 0xe5, 0x0f, 0x87, 0x29, 0xc9, 0x32,
+	0x5e, 0x77, 0x1d, 0xac, 0x86, 0x68, 0x1b, 0x80, 0xfa, 0x69, 0x40, 0x28, 0x5f, 0x3b, 0x18, 0xfd,
+	0xd3, 0xd3, 0x98, 0xbe, 0x4c, 0x0f, 0x69, 0x1a, 0xc6, 0x01, 0xd6, 0x50, 0xde, 0xdf, 0xac, 0xb2,
+	0x63, 0x2c, 0xfc, 0xcb, 0x3a, 0x89, 0x5d, 0x7e, 0x1f, 0x92, 0xfe, 0x2d, 0x08, 0x65, 0x12, 0xa9,
+	0xe9, 0x49, 0xa4, 0x0c, 0x2d, 0xbb, 0xea, 0xd0, 0xd7, 0x2b, 0x0f, 0x4b, 0xe3, 0x6d, 0x0f, 0xcb,
+	0x05, 0x42, 0xe7, 0x3f, 0x35, 0x58, 0x9f, 0xd3, 0xe0, 0x9c, 0x75, 0xf6, 0x55, 0x88, 0xd4, 0x16,
+	0x84, 0x88, 0xbd, 0x30, 0x44, 0xea, 0x15, 0x21, 0x52, 0x14, 0xb1, 0xc6, 0x54, 0x11, 0x73, 0xa1,
+	0x95, 0xe6, 0x31, 0x7b, 0x6f, 0x95, 0xd1, 0xa3, 0x86, 0x2c, 0xac, 0x3f, 0x4b, 0xd2, 0x37, 0x61,
+	0x1c, 0xec, 0x85, 0xa9, 0x0c, 0x1d, 0x8d, 0x82, 0x5e, 0x00, 0xf0, 0x66, 0x4d, 0x3c, 0x3d, 0xb6,
+	0x79, 0xb5, 0xee, 0x9f, 0xdd, 0xe0, 0xf5, 0xf7, 0x0a, 0x06, 0xf9, 0xee, 0x51, 0x4a, 0x60, 0x6f,
+	0x14, 0x53, 0xd3, 0x8b, 0xae, 0x21, 0x5d, 0xfd, 0x1a, 0xf2, 0x6b, 0x68, 0x3f, 0x4b, 0x02, 0xc1,
+	0xf7, 0x21, 0x38, 0xc5, 0x0b, 0xb2, 0xbc, 0x3d, 0xf4, 0xfa, 0xe2, 0x09, 0xb9, 0xaf, 0x9e, 0x90,
+	0xfb, 0x47, 0x0a, 0x81, 0x4b, 0x30, 0x7b, 0x27, 0x26, 0xda, 0x05, 0x42, 0xbd, 0x13, 0xcb, 0xc7,
+	0x3a, 0x62, 0x76, 0x19, 0xb6, 0xd6, 0x65, 0x78, 0x3b, 0xb0, 0xfa, 0xe3, 0x8c, 0xa4, 0x4f, 0x63,
+	0xca, 0xa0, 0xf2, 0xa5, 0xf8, 0x1d, 0x68, 0x86, 0x9c, 0x20, 0xad, 0xe8, 0x96, 0x47, 0x83, 0xa1,
+	0xe4, 0xa4, 0xf7, 0x3d, 0x58, 0x96, 0x57, 0x20, 0xc5, 0xf8, 0x2d, 0xf3, 0xbd, 0xba, 0x78, 0x9f,
+	0x13, 0x28, 0xe3, 0xd9, 0xfa, 0x3e, 0x2c, 0xe9, 0x64, 0xd4, 0x83, 0x16, 0xe1, 0xc1, 0x28, 0x9e,
+	0x0d, 0xdb, 0x07, 0x97, 0xb0, 0x22, 0x3c, 0x6e, 0x80, 0x7d, 0xea, 0x47, 0xde, 0x27, 0xd0, 0x14,
+	0x16, 0xb0, 0xb5, 0x94, 0x2f, 0x8c, 0x6d, 0xf5, 0x96, 0x88, 0xa0, 0x9e, 0x4d, 0xe2, 0x81, 0xbc,
+	0xa2, 0xf1, 0x6f, 0x16, 0xba, 0xf2, 0x7d, 0xd1, 0xe6, 0x54, 0x39, 0xf2, 0x42, 0x80, 0xb2, 0x37,
+	0x43, 0xbb, 0xb0, 0x5c, 0x76, 0x67, 0x5a, 0x5f, 0x78, 0xdd, 0x3c, 0x72, 0x06, 0x04, 0x4f, 0xb1,
+	0x30, 0x55, 0xe2, 0x48, 0xa9, 0xaa, 0x21, 0x46, 0xde, 0x8f, 0xa0, 0xa3, 0xe5, 0x94, 0xa2, 0xe1,
+	0x10, 0x09, 0x83, 0x7f, 0x33, 0xd6, 0x30, 0xa6, 0x3f, 0xf1, 0x23, 0x99, 0x87, 0xe5, 0x48, 0x1c,
+	0xbc, 0x94, 0xd1, 0x8b, 0x6c, 0xc1, 0x46, 0xdb, 0x7f, 0x6a, 0xc0, 0xe5, 0x43, 0xf9, 0x8b, 0xc5,
+	0x21, 0x49, 0x4f, 0xc3, 0x01, 0x41, 0xbb, 0xd0, 0x7e, 0x42, 0xd4, 0xd3, 0xc4, 0x4c, 0xd8, 0xec,
+	0xb3, 0x5f, 0x1e, 0x7a, 0xc6, 0x0f, 0x08, 0xde, 0xea, 0xef, 0xbe, 0xfc, 0xc7, 0x1f, 0x6a, 0x1d,
+	0xe4, 0x6c, 0xb1, 0x1f, 0x40, 0x38, 0xe3, 0x13, 0x68, 0xf3, 0xa0, 0x79, 0x96, 0x04, 0x48, 0xb5,
+	0x9b, 0x2a, 0x3e, 0x7b, 0xd3, 0x04, 0x6f, 0x8d, 0x0b, 0xb8, 0x8c, 0xba, 0x4c, 0x80, 0xb8, 0x33,
+	0x44, 0x49, 0x70, 0xc7, 0xba, 0x67, 0xa1, 0x27, 0xd0, 0xe4, 0x82, 0xb2, 0xb9, 0xb6, 0xcc, 0x48,
+	0x43, 0x5c, 0xda, 0x12, 0x82, 0x42, 0x5a, 0x76, 0xcf, 0x42, 0x3f, 0x85, 0xd6, 0xfe, 0xaf, 0xc8,
+	0x20, 0xa7, 0x04, 0xa9, 0x97, 0xad, 0x99, 0x80, 0xed, 0xcd, 0xd1, 0xe1, 0x5d, 0xe7, 0x22, 0xd7,
+	0xbc, 0x0e, 0x17, 0x29, 0xc4, 0xec, 0xc8, 0xf0, 0x45, 0x3e, 0x38, 0x8f, 0x72, 0x9a, 0xf0, 0xb6,
+	0x19, 0xad, 0x99, 0xa1, 0xba, 0x48, 0xf0, 0x3b, 0x5c, 0xf0, 0xad, 0xde, 0x35, 0x26, 0x98, 0x47,
+	0xdf, 0x96, 0x9f, 0xd3, 0xe4, 0xb5, 0xd2, 0x21, 0x82, 0x1c, 0xbd, 0x86, 0x36, 0x53, 0xc1, 0x4a,
+	0xc6, 0x45, 0x35, 0xdc, 0xe6, 0x1a, 0x6e, 0xf6, 0xd6, 0xf8, 0xe6, 0x4c, 0xe2, 0x41, 0xa5, 0x82,
+	0x01, 0x00, 0x53, 0x20, 0xda, 0xca, 0x8b, 0xaa, 0xd8, 0xe4, 0x2a, 0x36, 0x7a, 0xeb, 0x4c, 0x85,
+	0x38, 0x17, 0x95, 0x4a, 0x9e, 0x41, 0xf3, 0xc0, 0x8f, 0x87, 0x11, 0x41, 0x46, 0x62, 0x99, 0x2b,
+	0xf7, 0x06, 0x97, 0x7b, 0xcd, 0x5b, 0x2d, 0x37, 0x72, 0xeb, 0x53, 0x2e, 0x60, 0xc7, 0xba, 0xfb,
+	0xca, 0x3e, 0x6e, 0x72, 0xfc, 0x83, 0xff, 0x0e, 0x00, 0xf9, 0xc8, 0xa2, 0xfd, 0x78, 0x1b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
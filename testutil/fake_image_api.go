@@ -70,6 +70,8 @@ type FakeAPIClient struct {
 	Built []types.ImageBuildOptions
 	// ref -> [id]
 	LocalImages map[string][]string
+	// images listed when filtering by label
+	LabeledImages []types.ImageSummary
 }
 
 func (f *FakeAPIClient) ServerVersion(ctx context.Context) (types.Version, error) {
@@ -277,6 +279,15 @@ func (f *FakeAPIClient) ImageList(ctx context.Context, ops types.ImageListOption
 		return []types.ImageSummary{}, fmt.Errorf("test error")
 	}
 	var rt []types.ImageSummary
+	if labels := ops.Filters.Get("label"); len(labels) > 0 {
+		for _, img := range f.LabeledImages {
+			if _, found := img.Labels[labels[0]]; found {
+				rt = append(rt, img)
+			}
+		}
+		return rt, nil
+	}
+
 	ref := ops.Filters.Get("reference")[0]
 
 	for i, tag := range f.LocalImages[ref] {